@@ -0,0 +1,63 @@
+package accounttype
+
+import "github.com/openbankit/go-base/xdr"
+
+// GetDefaultPaymentRestrictions returns account types each account type is allowed to send payments to.
+// Used while bank admin has not set own restrictions.
+func GetDefaultPaymentRestrictions() map[xdr.AccountType][]xdr.AccountType {
+	return map[xdr.AccountType][]xdr.AccountType{
+
+		xdr.AccountTypeAccountBank: []xdr.AccountType{
+			xdr.AccountTypeAccountDistributionAgent,
+			xdr.AccountTypeAccountExchangeAgent,
+		},
+
+		xdr.AccountTypeAccountCommission: []xdr.AccountType{
+			xdr.AccountTypeAccountDistributionAgent,
+		},
+
+		xdr.AccountTypeAccountDistributionAgent: []xdr.AccountType{
+			xdr.AccountTypeAccountAnonymousUser,
+			xdr.AccountTypeAccountRegisteredUser,
+			xdr.AccountTypeAccountMerchant,
+			xdr.AccountTypeAccountSettlementAgent,
+			xdr.AccountTypeAccountScratchCard,
+		},
+
+		xdr.AccountTypeAccountSettlementAgent: []xdr.AccountType{
+			xdr.AccountTypeAccountBank,
+		},
+
+		xdr.AccountTypeAccountExchangeAgent: []xdr.AccountType{
+			xdr.AccountTypeAccountBank,
+			xdr.AccountTypeAccountAnonymousUser,
+		},
+
+		xdr.AccountTypeAccountAnonymousUser: []xdr.AccountType{
+			xdr.AccountTypeAccountAnonymousUser,
+			xdr.AccountTypeAccountRegisteredUser,
+			xdr.AccountTypeAccountMerchant,
+			xdr.AccountTypeAccountSettlementAgent,
+			xdr.AccountTypeAccountExchangeAgent,
+		},
+
+		xdr.AccountTypeAccountRegisteredUser: []xdr.AccountType{
+			xdr.AccountTypeAccountAnonymousUser,
+			xdr.AccountTypeAccountRegisteredUser,
+			xdr.AccountTypeAccountMerchant,
+			xdr.AccountTypeAccountSettlementAgent,
+		},
+
+		xdr.AccountTypeAccountMerchant: []xdr.AccountType{
+			xdr.AccountTypeAccountAnonymousUser,
+			xdr.AccountTypeAccountRegisteredUser,
+			xdr.AccountTypeAccountMerchant,
+			xdr.AccountTypeAccountSettlementAgent,
+		},
+
+		xdr.AccountTypeAccountScratchCard: []xdr.AccountType{
+			xdr.AccountTypeAccountAnonymousUser,
+			xdr.AccountTypeAccountRegisteredUser,
+		},
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/openbankit/horizon/httpx"
 	"github.com/openbankit/horizon/log"
 	"github.com/openbankit/horizon/toid"
+	"github.com/openbankit/horizon/webhook"
 	"github.com/zenazn/goji/web"
 )
 
@@ -119,6 +120,12 @@ func (action *Action) HistoryQ() *history.Q {
 	return action.hq
 }
 
+// WebhookQ provides access to webhook subscriptions, deliveries and dead letters stored in
+// horizon's database.
+func (action *Action) WebhookQ() *webhook.Q {
+	return &webhook.Q{Repo: action.App.HorizonRepo(action.Ctx)}
+}
+
 // Prepare sets the action's App field based upon the goji context
 func (action *Action) Prepare(c web.C, w http.ResponseWriter, r *http.Request) {
 	base := &action.Base
@@ -0,0 +1,95 @@
+package horizon
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/openbankit/go-base/keypair"
+	"github.com/openbankit/go-base/xdr"
+	"github.com/openbankit/horizon/admin"
+	"github.com/openbankit/horizon/db2/core"
+	"github.com/openbankit/horizon/render/problem"
+)
+
+// Requests to admin endpoints must be signed by the bank's master key or one of its signers. Signer's public key
+// is passed in X-AuthPublicKey header, base64 encoded xdr.DecoratedSignature of contents hash - in X-AuthSignature,
+// unix time of signing - in X-AuthTimestamp. Signature is valid for config.AdminSignatureValid.
+
+// RequireAdmin checks that request is signed by admin of the bank. Sets action.Err otherwise.
+func (action *Action) RequireAdmin() {
+	publicKey := action.R.Header.Get("X-AuthPublicKey")
+	rawSignature := action.R.Header.Get("X-AuthSignature")
+	rawTimestamp := action.R.Header.Get("X-AuthTimestamp")
+	if publicKey == "" || rawSignature == "" || rawTimestamp == "" {
+		action.Err = &problem.NotAuthorized
+		return
+	}
+
+	timestamp, err := strconv.ParseInt(rawTimestamp, 10, 64)
+	if err != nil {
+		action.Err = &problem.NotAuthorized
+		return
+	}
+
+	signatureValid := action.App.Config().AdminSignatureValid
+	signedAt := time.Unix(timestamp, 0)
+	now := time.Now()
+	if signedAt.Before(now.Add(-signatureValid)) || signedAt.After(now.Add(signatureValid)) {
+		action.Log.WithField("signed_at", signedAt).Debug("Admin signature expired")
+		action.Err = &problem.NotAuthorized
+		return
+	}
+
+	signer, err := keypair.Parse(publicKey)
+	if err != nil {
+		action.Err = &problem.NotAuthorized
+		return
+	}
+
+	var signature xdr.DecoratedSignature
+	err = xdr.SafeUnmarshalBase64(rawSignature, &signature)
+	if err != nil {
+		action.Err = &problem.NotAuthorized
+		return
+	}
+
+	contentsHash := admin.GetContentsHash(action.R, rawTimestamp)
+	err = signer.Verify(contentsHash[:], signature.Signature)
+	if err != nil {
+		action.Log.WithField("signer", publicKey).Debug("Invalid admin signature")
+		action.Err = &problem.NotAuthorized
+		return
+	}
+
+	isAdmin, err := action.isBankSigner(publicKey)
+	if err != nil {
+		action.Log.WithError(err).Error("Failed to get signers of bank's master account")
+		action.Err = &problem.ServerError
+		return
+	}
+
+	if !isAdmin {
+		action.Err = &problem.Forbidden
+	}
+}
+
+// isBankSigner returns true, if publicKey is the bank's master key or its signer
+func (action *Action) isBankSigner(publicKey string) (bool, error) {
+	bankMasterKey := action.App.Config().BankMasterKey
+	if publicKey == bankMasterKey {
+		return true, nil
+	}
+
+	var signers []core.Signer
+	err := action.SignersProvider().SignersByAddress(&signers, bankMasterKey)
+	if err != nil {
+		return false, err
+	}
+
+	for _, signer := range signers {
+		if signer.Publickey == publicKey && signer.Weight > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
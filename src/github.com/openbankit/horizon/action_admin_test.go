@@ -0,0 +1,61 @@
+package horizon
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/openbankit/go-base/keypair"
+	"github.com/openbankit/go-base/xdr"
+	"github.com/openbankit/horizon/admin"
+	"github.com/openbankit/horizon/test"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestActionRequireAdmin(t *testing.T) {
+	test.LoadScenario("base")
+	app := NewTestApp()
+	defer app.Close()
+	rh := NewRequestHelper(app)
+	adminKP := test.AdminSeed()
+
+	Convey("RequireAdmin", t, func() {
+		Convey("not signed", func() {
+			w := rh.Get("/audit_log", test.RequestHelperNoop)
+			So(w.Code, ShouldEqual, http.StatusUnauthorized)
+		})
+		Convey("signed by admin", func() {
+			w := rh.SignedGet(adminKP, "/audit_log", test.RequestHelperNoop)
+			So(w.Code, ShouldEqual, http.StatusOK)
+		})
+		Convey("signed by not admin", func() {
+			signer, err := keypair.Random()
+			So(err, ShouldBeNil)
+			w := rh.SignedGet(signer, "/audit_log", test.RequestHelperNoop)
+			So(w.Code, ShouldEqual, http.StatusForbidden)
+		})
+		Convey("signature of another request", func() {
+			requestData := test.NewSignedRequestData(adminKP, "GET", "/audit_log?actor="+adminKP.Address())
+			request := requestData.CreateRequest()
+			request.URL.RawQuery = ""
+			w := rh.Execute(request, test.RequestHelperNoop)
+			So(w.Code, ShouldEqual, http.StatusUnauthorized)
+		})
+		Convey("expired signature", func() {
+			request, err := http.NewRequest("GET", "/audit_log", nil)
+			So(err, ShouldBeNil)
+			timestamp := strconv.FormatInt(time.Now().Add(-2*test.NewTestConfig().AdminSignatureValid).Unix(), 10)
+			contentsHash := admin.GetContentsHash(request, timestamp)
+			signature, err := adminKP.SignDecorated(contentsHash[:])
+			So(err, ShouldBeNil)
+			rawSignature, err := xdr.MarshalBase64(signature)
+			So(err, ShouldBeNil)
+			request.Header.Set("X-AuthPublicKey", adminKP.Address())
+			request.Header.Set("X-AuthSignature", rawSignature)
+			request.Header.Set("X-AuthTimestamp", timestamp)
+			w := rh.Execute(request, test.RequestHelperNoop)
+			So(w.Code, ShouldEqual, http.StatusUnauthorized)
+		})
+	})
+}
@@ -94,6 +94,19 @@ func (base *Base) Execute(action interface{}) {
 
 		action.Raw()
 
+		if base.Err != nil {
+			problem.Render(base.Ctx, base.W, base.Err)
+			return
+		}
+	case render.MimeCSV:
+		action, ok := action.(CSV)
+
+		if !ok {
+			goto NotAcceptable
+		}
+
+		action.CSV()
+
 		if base.Err != nil {
 			problem.Render(base.Ctx, base.W, base.Err)
 			return
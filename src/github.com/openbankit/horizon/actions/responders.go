@@ -14,6 +14,12 @@ type Raw interface {
 	Raw()
 }
 
+// CSV implementors can respond to a request whose response type was negotiated
+// to be MimeCSV.
+type CSV interface {
+	CSV()
+}
+
 // SSE implementors can respond to a request whose response type was negotiated
 // to be MimeEventStream.
 type SSE interface {
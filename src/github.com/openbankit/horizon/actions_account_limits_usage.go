@@ -0,0 +1,85 @@
+package horizon
+
+import (
+	"time"
+
+	"github.com/openbankit/horizon/accounttypes"
+	"github.com/openbankit/horizon/db2/core"
+	"github.com/openbankit/horizon/db2/history"
+	"github.com/openbankit/horizon/render/hal"
+	"github.com/openbankit/horizon/resource"
+	"github.com/openbankit/horizon/txsub/transactions/statistics"
+	"github.com/openbankit/horizon/txsub/transactions/validators"
+)
+
+// AccountLimitsUsageAction renders limits applied to account's payments in each asset it trusts, amounts used
+// and remaining headroom in both directions
+type AccountLimitsUsageAction struct {
+	Action
+	Address       string
+	HistoryRecord history.Account
+	TrustLines    []core.Trustline
+	Usage         []validators.LimitsUsage
+	Resource      resource.AccountLimitsUsage
+}
+
+// JSON is a method for actions.JSON
+func (action *AccountLimitsUsageAction) JSON() {
+	action.Do(
+		action.loadParams,
+		action.loadRecords,
+		action.loadUsage,
+		func() {
+			action.Resource.Populate(action.Ctx, action.Address, action.Usage)
+			hal.Render(action.W, action.Resource)
+		},
+	)
+}
+
+func (action *AccountLimitsUsageAction) loadParams() {
+	action.Address = action.GetAddress("account_id")
+}
+
+func (action *AccountLimitsUsageAction) loadRecords() {
+	action.Err = action.HistoryQ().AccountByAddress(&action.HistoryRecord, action.Address)
+	if action.Err != nil {
+		return
+	}
+
+	action.Err = action.CoreQ().TrustlinesByAddress(&action.TrustLines, action.Address)
+}
+
+func (action *AccountLimitsUsageAction) loadUsage() {
+	now := time.Now()
+	config := action.App.Config()
+	statsManager := statistics.NewManager(action.HistoryQ(), accounttype.GetAll(), &config)
+	action.Usage = make([]validators.LimitsUsage, 0, len(action.TrustLines))
+	for i := range action.TrustLines {
+		trustLine := &action.TrustLines[i]
+		var asset history.Asset
+		err := action.HistoryQ().AssetByParams(&asset, int(trustLine.Assettype), trustLine.Assetcode, trustLine.Issuer)
+		if err != nil {
+			if !action.HistoryQ().NoRows(err) {
+				action.Err = err
+				return
+			}
+
+			// asset is not registered in history, so it can't be anonymous
+			asset = history.Asset{Type: int(trustLine.Assettype), Code: trustLine.Assetcode, Issuer: trustLine.Issuer}
+		}
+
+		stats, err := statsManager.Get(action.Address, asset, trustLine, now)
+		if err != nil {
+			action.Err = err
+			return
+		}
+
+		usage, err := validators.GetLimitsUsage(action.HistoryQ(), &action.HistoryRecord, asset, stats,
+			config.AnonymousUserRestrictions, now)
+		if err != nil {
+			action.Err = err
+			return
+		}
+		action.Usage = append(action.Usage, *usage)
+	}
+}
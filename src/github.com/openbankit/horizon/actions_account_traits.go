@@ -6,6 +6,7 @@ import (
 	"github.com/openbankit/horizon/render/hal"
 	"github.com/openbankit/horizon/render/sse"
 	"github.com/openbankit/horizon/resource"
+	"time"
 )
 
 // This file contains the actions:
@@ -50,7 +51,7 @@ func (action *AccountTraitsIndexAction) loadParams() {
 }
 
 func (action *AccountTraitsIndexAction) loadRecords() {
-	action.Err = action.HistoryQ().Accounts().Blocked().Page(action.PagingParams).Select(&action.Records)
+	action.Err = action.HistoryQ().Accounts().BlockedAt(time.Now()).Page(action.PagingParams).Select(&action.Records)
 }
 
 // LoadPage populates action.Page
@@ -0,0 +1,120 @@
+package horizon
+
+import (
+	"encoding/csv"
+	"strconv"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/openbankit/horizon/db2/history"
+	"github.com/openbankit/horizon/ingest/statistics"
+	"github.com/openbankit/horizon/render"
+	"github.com/openbankit/horizon/render/hal"
+	"github.com/openbankit/horizon/resource"
+)
+
+// AccountTurnoverAction renders income/outcome of an account for [from, to) computed from ingested payments,
+// grouped by day or month, asset and counterparty type. Supports JSON and CSV.
+type AccountTurnoverAction struct {
+	Action
+	Address       string
+	AssetCode     string
+	GroupBy       string
+	From          time.Time
+	To            time.Time
+	HistoryRecord history.Account
+	Records       []statistics.TurnoverEntry
+	Resource      resource.AccountTurnover
+}
+
+// JSON is a method for actions.JSON
+func (action *AccountTurnoverAction) JSON() {
+	action.Do(
+		action.loadParams,
+		action.loadRecords,
+		action.loadResource,
+		func() {
+			hal.Render(action.W, action.Resource)
+		},
+	)
+}
+
+// CSV is a method for actions.CSV
+func (action *AccountTurnoverAction) CSV() {
+	action.Do(
+		action.loadParams,
+		action.loadRecords,
+		action.loadResource,
+		func() {
+			action.W.Header().Set("Content-Type", render.MimeCSV)
+			writer := csv.NewWriter(action.W)
+			writer.Write([]string{"period_start", "asset_code", "counterparty_type", "counterparty_type_name", "income", "outcome"})
+			for _, entry := range action.Resource.Entries {
+				writer.Write([]string{
+					entry.PeriodStart.Format(time.RFC3339),
+					entry.AssetCode,
+					strconv.Itoa(int(entry.CounterpartyType)),
+					entry.CounterpartyTypeName,
+					entry.Income,
+					entry.Outcome,
+				})
+			}
+			writer.Flush()
+			if err := writer.Error(); err != nil {
+				action.Log.WithError(err).Error("Failed to write turnover csv")
+			}
+		},
+	)
+}
+
+func (action *AccountTurnoverAction) loadParams() {
+	action.Address = action.GetAddress("account_id")
+	action.AssetCode = action.GetString("asset_code")
+	action.GroupBy = action.GetString("group_by")
+	if action.GroupBy == "" {
+		action.GroupBy = statistics.TurnoverByDay
+	}
+
+	from := action.GetOptionalTime("from")
+	to := action.GetOptionalTime("to")
+	if action.Err != nil {
+		return
+	}
+
+	if from == nil {
+		action.SetInvalidField("from", errors.New("Can not be empty"))
+		return
+	}
+
+	if to == nil {
+		action.SetInvalidField("to", errors.New("Can not be empty"))
+		return
+	}
+
+	if !to.After(*from) {
+		action.SetInvalidField("to", errors.New("Must be after from"))
+		return
+	}
+
+	action.From = *from
+	action.To = *to
+}
+
+func (action *AccountTurnoverAction) loadRecords() {
+	action.Err = action.HistoryQ().AccountByAddress(&action.HistoryRecord, action.Address)
+	if action.Err != nil {
+		return
+	}
+
+	builder, err := statistics.NewTurnoverBuilder(action.HistoryQ(), action.Address, action.AssetCode, action.GroupBy)
+	if err != nil {
+		action.SetInvalidField("group_by", err)
+		return
+	}
+
+	action.Records, action.Err = builder.Build(action.From, action.To)
+}
+
+func (action *AccountTurnoverAction) loadResource() {
+	action.Resource.Populate(action.Ctx, action.Address, action.From, action.To, action.GroupBy, action.Records)
+}
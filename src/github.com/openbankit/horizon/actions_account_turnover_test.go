@@ -0,0 +1,68 @@
+package horizon
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/openbankit/horizon/resource"
+	"github.com/openbankit/horizon/test"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestAccountTurnoverActions(t *testing.T) {
+	tt := test.Start(t).Scenario("base")
+	defer tt.Finish()
+
+	account := "GAWIB7ETYGSWULO4VB7D6S42YLPGIC7TY7Y2SSJKVOTMQXV5TILYWBUA"
+	other := "GCO5BZT5V3N3SK2CD5UKDSEQJBYFSIMYDV2B75SLKWEXLRYF5GNORYCG"
+	// ledgers 2 and 3 are closed on 2016-07-11
+	tt.InsertPayment(2, 1, account, other, "100.0000000", "USD")
+	tt.InsertPayment(3, 1, other, account, "30.0000000", "USD")
+
+	app := NewTestApp()
+	defer app.Close()
+	rh := NewRequestHelper(app)
+	path := "/accounts/" + account + "/turnover?from=2016-07-01T00:00:00Z&to=2016-08-01T00:00:00Z"
+
+	Convey("GET /accounts/:account_id/turnover", t, func() {
+		Convey("JSON", func() {
+			w := rh.Get(path+"&group_by=month", test.RequestHelperNoop)
+			So(w.Code, ShouldEqual, 200)
+
+			var turnover resource.AccountTurnover
+			err := json.Unmarshal(w.Body.Bytes(), &turnover)
+			So(err, ShouldBeNil)
+			So(turnover.Account, ShouldEqual, account)
+			So(turnover.GroupBy, ShouldEqual, "month")
+			So(turnover.Entries, ShouldHaveLength, 1)
+			So(turnover.Entries[0].AssetCode, ShouldEqual, "USD")
+			So(turnover.Entries[0].CounterpartyType, ShouldEqual, 6)
+			So(turnover.Entries[0].Income, ShouldEqual, "30.0000000")
+			So(turnover.Entries[0].Outcome, ShouldEqual, "100.0000000")
+		})
+
+		Convey("CSV", func() {
+			w := rh.Get(path, func(r *http.Request) {
+				r.Header.Set("Accept", "text/csv")
+			})
+			So(w.Code, ShouldEqual, 200)
+
+			records, err := csv.NewReader(w.Body).ReadAll()
+			So(err, ShouldBeNil)
+			So(records, ShouldHaveLength, 2)
+			So(records[0], ShouldResemble, []string{"period_start", "asset_code", "counterparty_type", "counterparty_type_name", "income", "outcome"})
+			So(records[1][0], ShouldEqual, "2016-07-11T00:00:00Z")
+			So(records[1][1], ShouldEqual, "USD")
+			So(records[1][2], ShouldEqual, "6")
+			So(records[1][4], ShouldEqual, "30.0000000")
+			So(records[1][5], ShouldEqual, "100.0000000")
+		})
+
+		Convey("missing range", func() {
+			w := rh.Get("/accounts/"+account+"/turnover", test.RequestHelperNoop)
+			So(w.Code, ShouldEqual, 400)
+		})
+	})
+}
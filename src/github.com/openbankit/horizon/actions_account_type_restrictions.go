@@ -0,0 +1,48 @@
+package horizon
+
+import (
+	"github.com/openbankit/go-base/xdr"
+	"github.com/openbankit/horizon/db2/history"
+	"github.com/openbankit/horizon/render/hal"
+	"github.com/openbankit/horizon/render/problem"
+	"github.com/openbankit/horizon/resource"
+)
+
+// AccountTypeRestrictionsAction renders which account types are allowed to send payments to each other
+type AccountTypeRestrictionsAction struct {
+	Action
+	Restrictions map[xdr.AccountType][]xdr.AccountType
+	Resource     resource.AccountTypeRestrictions
+}
+
+// JSON is a method for actions.JSON
+func (action *AccountTypeRestrictionsAction) JSON() {
+	action.Do(
+		action.loadRecord,
+		func() {
+			action.Resource.Populate(action.Ctx, action.Restrictions)
+			hal.Render(action.W, action.Resource)
+		},
+	)
+}
+
+func (action *AccountTypeRestrictionsAction) loadRecord() {
+	stored, err := action.HistoryQ().OptionsByName(history.OPTIONS_ACCOUNT_TYPE_RESTRICTIONS)
+	if err != nil {
+		action.Log.WithError(err).Error("Failed to get account type restrictions")
+		action.Err = &problem.ServerError
+		return
+	}
+
+	restrictions := history.NewAccountTypeRestrictions()
+	if stored != nil {
+		restrictions = stored.AccountTypeRestrictions()
+	}
+
+	action.Restrictions, err = restrictions.GetRestrictions()
+	if err != nil {
+		action.Log.WithError(err).Error("Failed to unmarshal account type restrictions")
+		action.Err = &problem.ServerError
+		return
+	}
+}
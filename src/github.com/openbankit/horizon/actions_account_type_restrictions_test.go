@@ -0,0 +1,29 @@
+package horizon
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/openbankit/horizon/resource"
+	"github.com/openbankit/horizon/test"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestAccountTypeRestrictionsAction(t *testing.T) {
+	test.LoadScenario("base")
+	app := NewTestApp()
+	defer app.Close()
+	rh := NewRequestHelper(app)
+
+	Convey("GET /account_type_restrictions", t, func() {
+		w := rh.Get("/account_type_restrictions", test.RequestHelperNoop)
+		So(w.Code, ShouldEqual, 200)
+
+		var result resource.AccountTypeRestrictions
+		err := json.Unmarshal(w.Body.Bytes(), &result)
+		So(err, ShouldBeNil)
+		// default restrictions are used, while admin have not set own
+		So(len(result.Restrictions), ShouldEqual, 9)
+		So(result.Restrictions[0].AccountType, ShouldEqual, "anonymous_user")
+	})
+}
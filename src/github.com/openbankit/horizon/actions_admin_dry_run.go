@@ -0,0 +1,110 @@
+package horizon
+
+import (
+	"encoding/json"
+
+	"github.com/openbankit/horizon/admin"
+	"github.com/openbankit/horizon/render/hal"
+	"github.com/openbankit/horizon/render/problem"
+	"github.com/openbankit/horizon/resource"
+)
+
+// AdminActionDryRunAction validates op data of administrative operation the same way
+// it is validated on submission and renders changes it would make, without applying them.
+type AdminActionDryRunAction struct {
+	Action
+	OpData   string
+	Resource resource.AdminActionDryRun
+}
+
+// JSON is a method for actions.JSON
+func (action *AdminActionDryRunAction) JSON() {
+	action.Do(
+		action.loadParams,
+		action.loadResource,
+		func() {
+			hal.Render(action.W, action.Resource)
+		},
+	)
+}
+
+func (action *AdminActionDryRunAction) loadParams() {
+	action.ValidateBodyType()
+	action.OpData = action.GetString("op_data")
+}
+
+func (action *AdminActionDryRunAction) loadResource() {
+	action.Resource.Changes = []resource.AdminActionChange{}
+
+	var opData map[string]interface{}
+	err := json.Unmarshal([]byte(action.OpData), &opData)
+	if err != nil {
+		action.setInvalid("", err.Error())
+		return
+	}
+
+	adminAction, err := admin.NewAdminActionProvider(action.HistoryQ()).CreateNewParser(opData)
+	if err != nil {
+		action.setInvalid("", err.Error())
+		return
+	}
+
+	adminAction.Validate()
+	err = adminAction.GetError()
+	if err != nil {
+		action.Resource.Error = action.toResourceError(err)
+		if action.Err != nil {
+			return
+		}
+
+		batch, ok := adminAction.(*admin.BatchAction)
+		if ok {
+			for _, itemErr := range batch.Errors {
+				index := itemErr.Index
+				itemResource := action.toResourceError(itemErr.Err)
+				if action.Err != nil {
+					return
+				}
+				itemResource.Index = &index
+				action.Resource.Errors = append(action.Resource.Errors, *itemResource)
+			}
+		}
+		return
+	}
+
+	action.Resource.Valid = true
+	for _, preview := range admin.Previews(adminAction) {
+		action.Resource.Changes = append(action.Resource.Changes, resource.AdminActionChange{
+			Subject: string(preview.Subject),
+			Action:  string(preview.Action),
+			Before:  preview.Before,
+			After:   preview.After,
+		})
+	}
+}
+
+func (action *AdminActionDryRunAction) setInvalid(field, reason string) {
+	action.Resource.Valid = false
+	action.Resource.Error = &resource.AdminActionError{
+		Field:  field,
+		Reason: reason,
+	}
+}
+
+// toResourceError converts validation error of admin action into resource. Sets action.Err on server error
+func (action *AdminActionDryRunAction) toResourceError(err error) *resource.AdminActionError {
+	switch err := err.(type) {
+	case *admin.InvalidFieldError:
+		return &resource.AdminActionError{Field: err.FieldName, Reason: err.Reason.Error()}
+	case *problem.P:
+		if err.Type == problem.ServerError.Type {
+			action.Err = err
+			return nil
+		}
+		return &resource.AdminActionError{Reason: err.Type}
+	default:
+		action.Log.WithError(err).Error("Failed to validate admin action")
+		action.Err = &problem.ServerError
+		return nil
+	}
+}
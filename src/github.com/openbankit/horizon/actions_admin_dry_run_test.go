@@ -0,0 +1,80 @@
+package horizon
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+
+	"github.com/openbankit/horizon/db2/history"
+	"github.com/openbankit/horizon/resource"
+	"github.com/openbankit/horizon/test"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestAdminActionDryRunAction(t *testing.T) {
+	test.LoadScenario("base")
+	app := NewTestApp()
+	defer app.Close()
+	rh := NewRequestHelper(app)
+
+	dryRun := func(opData string) resource.AdminActionDryRun {
+		w := rh.Post("/admin/dry_run", url.Values{"op_data": []string{opData}}, test.RequestHelperNoop)
+		So(w.Code, ShouldEqual, 200)
+		var result resource.AdminActionDryRun
+		err := json.Unmarshal(w.Body.Bytes(), &result)
+		So(err, ShouldBeNil)
+		return result
+	}
+
+	Convey("POST /admin/dry_run", t, func() {
+		Convey("malformed json", func() {
+			result := dryRun("{")
+			So(result.Valid, ShouldBeFalse)
+			So(result.Error, ShouldNotBeNil)
+			So(result.Changes, ShouldBeEmpty)
+		})
+		Convey("unknown subject", func() {
+			result := dryRun(`{"random_subject": {}}`)
+			So(result.Valid, ShouldBeFalse)
+			So(result.Error.Reason, ShouldEqual, "unknown admin action")
+		})
+		Convey("invalid field", func() {
+			result := dryRun(`{"commission": {"flat_fee": -1}}`)
+			So(result.Valid, ShouldBeFalse)
+			So(result.Error.Field, ShouldEqual, "flat_fee")
+			So(result.Error.Reason, ShouldEqual, "flat_fee can not be negative")
+		})
+		Convey("batch with invalid items", func() {
+			result := dryRun(`{"batch": [{"commission": {"flat_fee": 10}}, {"commission": {"flat_fee": -1}}]}`)
+			So(result.Valid, ShouldBeFalse)
+			So(result.Error.Field, ShouldEqual, "batch[1].flat_fee")
+			So(len(result.Errors), ShouldEqual, 1)
+			So(*result.Errors[0].Index, ShouldEqual, 1)
+			So(result.Errors[0].Field, ShouldEqual, "flat_fee")
+		})
+		Convey("valid batch", func() {
+			result := dryRun(`{"batch": [{"commission": {"flat_fee": 10}}, {"max_reversal_duration": {"max_reversal_duration": 60}}]}`)
+			So(result.Valid, ShouldBeTrue)
+			So(len(result.Changes), ShouldEqual, 2)
+			So(result.Changes[0].Subject, ShouldEqual, "commission")
+			So(result.Changes[1].Subject, ShouldEqual, "max_reversal_duration")
+		})
+		Convey("valid commission is not applied", func() {
+			result := dryRun(`{"commission": {"flat_fee": 10, "percent_fee": 5}}`)
+			So(result.Valid, ShouldBeTrue)
+			So(result.Error, ShouldBeNil)
+			So(len(result.Changes), ShouldEqual, 1)
+			So(result.Changes[0].Subject, ShouldEqual, "commission")
+			So(result.Changes[0].Action, ShouldEqual, "insert")
+			So(result.Changes[0].Before, ShouldBeNil)
+			So(result.Changes[0].After, ShouldNotBeNil)
+
+			var commissions []history.Commission
+			err := app.HistoryQ().Commissions().Select(&commissions)
+			So(err, ShouldBeNil)
+			for _, commission := range commissions {
+				So(commission.FlatFee, ShouldNotEqual, 10)
+			}
+		})
+	})
+}
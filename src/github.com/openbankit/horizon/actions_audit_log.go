@@ -0,0 +1,86 @@
+package horizon
+
+import (
+	"github.com/openbankit/horizon/db2"
+	"github.com/openbankit/horizon/db2/history"
+	"github.com/openbankit/horizon/render/hal"
+	"github.com/openbankit/horizon/resource"
+)
+
+// AuditLogIndexAction renders a page of admin actions performed, filtered by actor, subject, action,
+// transaction hash and creation time. Time must be passed as 2006-01-02T15:04:05Z. Request must be signed by admin.
+type AuditLogIndexAction struct {
+	Action
+	ActorFilter   string
+	SubjectFilter string
+	ActionFilter  string
+	TxHashFilter  string
+	PagingParams  db2.PageQuery
+	CreatedAt     db2.CloseAtQuery
+	Records       []history.AuditLog
+	Page          hal.Page
+}
+
+// JSON is a method for actions.JSON
+func (action *AuditLogIndexAction) JSON() {
+	action.Do(
+		action.RequireAdmin,
+		action.loadParams,
+		action.loadRecords,
+		action.loadPage,
+		func() {
+			hal.Render(action.W, action.Page)
+		},
+	)
+}
+
+func (action *AuditLogIndexAction) loadParams() {
+	action.ActorFilter = action.GetOptionalAddress("actor")
+	action.SubjectFilter = action.GetString("subject")
+	action.ActionFilter = action.GetString("action")
+	action.TxHashFilter = action.GetString("tx_hash")
+	action.PagingParams = action.GetPageQuery()
+	action.CreatedAt = action.GetCloseAtQuery()
+}
+
+func (action *AuditLogIndexAction) loadRecords() {
+	logs := action.HistoryQ().AuditLogs()
+
+	if action.ActorFilter != "" {
+		logs.ForActor(action.ActorFilter)
+	}
+
+	if action.SubjectFilter != "" {
+		logs.ForSubject(action.SubjectFilter)
+	}
+
+	if action.ActionFilter != "" {
+		logs.ForAction(action.ActionFilter)
+	}
+
+	if action.TxHashFilter != "" {
+		logs.ForTransaction(action.TxHashFilter)
+	}
+
+	action.Err = logs.Page(action.PagingParams).CreatedAt(action.CreatedAt).Select(&action.Records)
+}
+
+func (action *AuditLogIndexAction) loadPage() {
+	for _, record := range action.Records {
+		var res resource.AuditLogEntry
+		err := res.Populate(record)
+		if err != nil {
+			action.Log.WithError(err).WithField("id", record.Id).Error("Failed to populate audit log entry")
+			action.Err = err
+			return
+		}
+		action.Page.Add(res)
+	}
+
+	action.Page.BaseURL = action.BaseURL()
+	action.Page.BasePath = action.Path()
+	action.Page.Limit = action.PagingParams.Limit
+	action.Page.Cursor = action.PagingParams.Cursor
+	action.Page.Order = action.PagingParams.Order
+	action.Page.PopulateLinks()
+}
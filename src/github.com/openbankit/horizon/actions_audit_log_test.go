@@ -0,0 +1,66 @@
+package horizon
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/openbankit/go-base/keypair"
+	"github.com/openbankit/horizon/db2/history"
+	"github.com/openbankit/horizon/test"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestAuditLogActions(t *testing.T) {
+	test.LoadScenario("base")
+	app := NewTestApp()
+	defer app.Close()
+	rh := NewRequestHelper(app)
+	admin := test.AdminSeed()
+
+	Convey("GET /audit_log", t, func() {
+		actor, err := keypair.Random()
+		So(err, ShouldBeNil)
+		entries := []history.AuditLog{
+			{Actor: actor.Address(), Subject: "commission", Action: "insert", Meta: `{"flat_fee": 10}`},
+			{Actor: actor.Address(), Subject: "traits", Action: "update", Meta: `{}`},
+			{Actor: test.NewTestConfig().BankMasterKey, Subject: "commission", Action: "delete", Meta: `{}`},
+		}
+		for i := range entries {
+			err = app.HistoryQ().CreateAuditLogEntry(&entries[i])
+			So(err, ShouldBeNil)
+		}
+
+		w := rh.Get("/audit_log", test.RequestHelperNoop)
+		So(w.Code, ShouldEqual, 401)
+
+		w = rh.SignedGet(admin, "/audit_log", test.RequestHelperNoop)
+		So(w.Code, ShouldEqual, 200)
+		So(w.Body, ShouldBePageOf, 3)
+
+		w = rh.SignedGet(admin, "/audit_log?actor="+actor.Address(), test.RequestHelperNoop)
+		So(w.Code, ShouldEqual, 200)
+		So(w.Body, ShouldBePageOf, 2)
+
+		w = rh.SignedGet(admin, "/audit_log?subject=commission&action=insert", test.RequestHelperNoop)
+		So(w.Code, ShouldEqual, 200)
+		So(w.Body, ShouldBePageOf, 1)
+
+		var page struct {
+			Embedded struct {
+				Records []struct {
+					Meta map[string]interface{} `json:"meta"`
+				} `json:"records"`
+			} `json:"_embedded"`
+		}
+		err = json.Unmarshal(w.Body.Bytes(), &page)
+		So(err, ShouldBeNil)
+		So(page.Embedded.Records[0].Meta["flat_fee"], ShouldEqual, 10)
+
+		w = rh.SignedGet(admin, "/audit_log?after=2100-01-01T00:00:00Z", test.RequestHelperNoop)
+		So(w.Code, ShouldEqual, 200)
+		So(w.Body, ShouldBePageOf, 0)
+
+		w = rh.SignedGet(admin, "/audit_log?actor=invalid", test.RequestHelperNoop)
+		So(w.Code, ShouldEqual, 400)
+	})
+}
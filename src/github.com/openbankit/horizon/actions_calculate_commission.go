@@ -1,21 +1,28 @@
 package horizon
 
 import (
+	"database/sql"
+	"errors"
+
+	"github.com/openbankit/go-base/amount"
 	"github.com/openbankit/go-base/xdr"
 	"github.com/openbankit/horizon/commissions"
 	"github.com/openbankit/horizon/db2/history/details"
 	"github.com/openbankit/horizon/log"
 	"github.com/openbankit/horizon/render/hal"
 	"github.com/openbankit/horizon/render/problem"
-	"database/sql"
 )
 
+// CalculateCommissionAction renders commission of payment. If commission is sponsored by the account designated
+// by destination or by the optional sponsor, fee is rendered as it will be ingested: sender is not charged and
+// the sponsor pays commission amount.
 type CalculateCommissionAction struct {
 	Action
 	source      xdr.AccountId
 	destination xdr.AccountId
 	amount      xdr.Int64
 	asset       xdr.Asset
+	sponsor     string
 	Resource    details.Fee
 }
 
@@ -34,6 +41,7 @@ func (action *CalculateCommissionAction) loadParams() {
 	action.destination = action.GetAccountID("to")
 	action.asset = action.GetAsset("")
 	action.amount = action.GetPositiveAmount("amount")
+	action.sponsor = action.GetOptionalAddress("sponsor")
 }
 
 func (action *CalculateCommissionAction) calculate() {
@@ -58,4 +66,34 @@ func (action *CalculateCommissionAction) calculate() {
 		return
 	}
 	action.Resource.Populate(*fee)
+	if fee.Type != xdr.OperationFeeTypeOpFeeCharged {
+		return
+	}
+
+	payer, err := cm.CommissionPayer(action.source, action.destination)
+	if err != nil {
+		log.WithError(err).Error("Failed to get commission payer")
+		action.Err = &problem.ServerError
+		return
+	}
+
+	sponsor := action.sponsor
+	if payer != "" {
+		if sponsor != "" && sponsor != payer {
+			action.SetInvalidField("sponsor", errors.New("commission must be sponsored by "+payer))
+			return
+		}
+		sponsor = payer
+	}
+
+	if sponsor == "" || sponsor == action.source.Address() {
+		return
+	}
+
+	action.Resource = details.Fee{}
+	action.Resource.Populate(xdr.OperationFee{Type: xdr.OperationFeeTypeOpFeeNone})
+	action.Resource.Sponsor = &details.FeeSponsor{
+		Account: sponsor,
+		Amount:  amount.String(fee.MustFee().AmountToCharge),
+	}
 }
@@ -1,9 +1,12 @@
 package horizon
 
 import (
+	"encoding/json"
+	"github.com/openbankit/go-base/amount"
 	"github.com/openbankit/go-base/keypair"
 	"github.com/openbankit/go-base/xdr"
 	"github.com/openbankit/horizon/assets"
+	"github.com/openbankit/horizon/db2/history"
 	"github.com/openbankit/horizon/db2/history/details"
 	"github.com/openbankit/horizon/log"
 	"github.com/openbankit/horizon/render/problem"
@@ -18,11 +21,17 @@ type RequestBuilder struct {
 	To     string
 	Asset  details.Asset
 	Amount string
+	// Sponsor is optional
+	Sponsor string
 }
 
 func (b *RequestBuilder) Build() string {
-	return fmt.Sprintf("/commission/calculate?amount=%s&from=%s&to=%s&asset_type=%s&asset_code=%s&asset_issuer=%s",
+	url := fmt.Sprintf("/commission/calculate?amount=%s&from=%s&to=%s&asset_type=%s&asset_code=%s&asset_issuer=%s",
 		b.Amount, b.From, b.To, b.Asset.Type, b.Asset.Code, b.Asset.Issuer)
+	if b.Sponsor != "" {
+		url += "&sponsor=" + b.Sponsor
+	}
+	return url
 }
 
 func TestActionsCalculateCommission(t *testing.T) {
@@ -68,5 +77,90 @@ func TestActionsCalculateCommission(t *testing.T) {
 			So(w.Code, ShouldEqual, 400)
 			So(w.Body, ShouldBeProblem, problem.BadRequest, "asset_issuer")
 		})
+		Convey("Invalid sponsor", func() {
+			request.Sponsor = "not_an_account"
+			w := rh.Get(request.Build(), test.RequestHelperNoop)
+			So(w.Code, ShouldEqual, 400)
+			So(w.Body, ShouldBeProblem, problem.BadRequest, "sponsor")
+		})
+	})
+}
+
+func TestActionsCalculateSponsoredCommission(t *testing.T) {
+	tt := test.Start(t).Scenario("base")
+	defer tt.Finish()
+
+	sender := "GAWIB7ETYGSWULO4VB7D6S42YLPGIC7TY7Y2SSJKVOTMQXV5TILYWBUA"
+	merchant := "GCO5BZT5V3N3SK2CD5UKDSEQJBYFSIMYDV2B75SLKWEXLRYF5GNORYCG"
+	payer, err := keypair.Random()
+	tt.Require.NoError(err)
+
+	commission, err := history.NewCommission(history.CommissionKey{From: sender}, amount.One, 0)
+	tt.Require.NoError(err)
+	q := history.Q{Repo: tt.HorizonRepo()}
+	tt.Require.NoError(q.InsertCommission(commission))
+	_, err = tt.HorizonRepo().ExecRaw("UPDATE history_accounts SET commission_payer = ? WHERE address = ?",
+		payer.Address(), merchant)
+	tt.Require.NoError(err)
+
+	app := NewTestApp()
+	defer app.Close()
+	rh := NewRequestHelper(app)
+
+	Convey("Calculate sponsored commission:", t, func() {
+		request := RequestBuilder{
+			From:   sender,
+			To:     merchant,
+			Amount: "100.00",
+			Asset: details.Asset{
+				Type:   assets.MustString(xdr.AssetTypeAssetTypeCreditAlphanum4),
+				Code:   "EUR",
+				Issuer: payer.Address(),
+			},
+		}
+		Convey("sponsored by designated payer", func() {
+			w := rh.Get(request.Build(), test.RequestHelperNoop)
+			So(w.Code, ShouldEqual, 200)
+
+			var fee details.Fee
+			So(json.Unmarshal(w.Body.Bytes(), &fee), ShouldBeNil)
+			So(fee.TypeI, ShouldEqual, int32(xdr.OperationFeeTypeOpFeeNone))
+			So(fee.Sponsor, ShouldNotBeNil)
+			So(fee.Sponsor.Account, ShouldEqual, payer.Address())
+			So(fee.Sponsor.Amount, ShouldEqual, "1.0000000")
+		})
+		Convey("sponsor differs from designated payer", func() {
+			request.Sponsor = sender
+			w := rh.Get(request.Build(), test.RequestHelperNoop)
+			So(w.Code, ShouldEqual, 400)
+			So(w.Body, ShouldBeProblem, problem.BadRequest, "sponsor")
+		})
+		Convey("sponsored by requested sponsor", func() {
+			destination, err := keypair.Random()
+			So(err, ShouldBeNil)
+			request.To = destination.Address()
+			request.Sponsor = merchant
+			w := rh.Get(request.Build(), test.RequestHelperNoop)
+			So(w.Code, ShouldEqual, 200)
+
+			var fee details.Fee
+			So(json.Unmarshal(w.Body.Bytes(), &fee), ShouldBeNil)
+			So(fee.TypeI, ShouldEqual, int32(xdr.OperationFeeTypeOpFeeNone))
+			So(fee.Sponsor, ShouldNotBeNil)
+			So(fee.Sponsor.Account, ShouldEqual, merchant)
+			So(fee.Sponsor.Amount, ShouldEqual, "1.0000000")
+		})
+		Convey("charged without sponsor", func() {
+			destination, err := keypair.Random()
+			So(err, ShouldBeNil)
+			request.To = destination.Address()
+			w := rh.Get(request.Build(), test.RequestHelperNoop)
+			So(w.Code, ShouldEqual, 200)
+
+			var fee details.Fee
+			So(json.Unmarshal(w.Body.Bytes(), &fee), ShouldBeNil)
+			So(fee.TypeI, ShouldEqual, int32(xdr.OperationFeeTypeOpFeeCharged))
+			So(fee.Sponsor, ShouldBeNil)
+		})
 	})
 }
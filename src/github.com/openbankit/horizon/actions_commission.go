@@ -8,6 +8,7 @@ import (
 	"github.com/openbankit/horizon/log"
 	"github.com/openbankit/horizon/render/hal"
 	"github.com/openbankit/horizon/resource"
+	"time"
 )
 
 // CommissionIndexAction returns a paged slice of commissions based upon the provided
@@ -17,6 +18,7 @@ type CommissionIndexAction struct {
 	AccountFilter     string
 	AccountTypeFilter *int32
 	Asset             *details.Asset
+	ActiveAt          *time.Time
 	PagingParams      db2.PageQuery
 	Records           []history.Commission
 	Page              hal.Page
@@ -33,6 +35,7 @@ func (action *CommissionIndexAction) JSON() {
 func (action *CommissionIndexAction) loadParams() {
 	action.AccountFilter = action.GetString("account_id")
 	action.AccountTypeFilter = action.GetInt32Pointer("account_type")
+	action.ActiveAt = action.GetOptionalTime("active_at")
 	action.PagingParams = action.GetPageQuery()
 	if action.GetString("asset_type") != "" {
 		xdrAsset := action.GetAsset("")
@@ -54,6 +57,10 @@ func (action *CommissionIndexAction) loadRecords() {
 		comms.ForAsset(*action.Asset)
 	}
 
+	if action.ActiveAt != nil {
+		comms.ActiveAt(*action.ActiveAt)
+	}
+
 	log.WithField("paging", action.PagingParams).Error("Selecting commission")
 	action.Err = comms.Page(action.PagingParams).Select(&action.Records)
 }
@@ -0,0 +1,28 @@
+package horizon
+
+import (
+	"github.com/openbankit/horizon/render/hal"
+	"github.com/openbankit/horizon/resource"
+)
+
+// ConfigShowAction renders the effective config of horizon, including reloaded settings,
+// with passwords, tokens and secret seeds redacted. Request must be signed by admin.
+type ConfigShowAction struct {
+	Action
+	Resource resource.Config
+}
+
+// JSON is a method for actions.JSON
+func (action *ConfigShowAction) JSON() {
+	action.Do(
+		action.RequireAdmin,
+		action.loadResource,
+		func() {
+			hal.Render(action.W, action.Resource)
+		},
+	)
+}
+
+func (action *ConfigShowAction) loadResource() {
+	action.Resource.Populate(action.App.Config())
+}
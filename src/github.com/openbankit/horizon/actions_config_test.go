@@ -0,0 +1,65 @@
+package horizon
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/openbankit/horizon/resource"
+	"github.com/openbankit/horizon/test"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestConfigShowAction(t *testing.T) {
+	test.LoadScenario("base")
+	app := NewTestApp()
+	defer app.Close()
+	rh := NewRequestHelper(app)
+	admin := test.AdminSeed()
+
+	getConfig := func() resource.Config {
+		w := rh.SignedGet(admin, "/admin/config", test.RequestHelperNoop)
+		So(w.Code, ShouldEqual, 200)
+
+		var result resource.Config
+		err := json.Unmarshal(w.Body.Bytes(), &result)
+		So(err, ShouldBeNil)
+		return result
+	}
+
+	Convey("GET /admin/config", t, func() {
+		Convey("not signed", func() {
+			w := rh.Get("/admin/config", test.RequestHelperNoop)
+			So(w.Code, ShouldEqual, http.StatusUnauthorized)
+		})
+
+		app.config.RedisURL = "redis://:secret@localhost:6379/0"
+		app.config.FriendbotSecret = "SSECRET"
+		app.config.LogglyToken = "token"
+
+		result := getConfig()
+		So(result.RedisURL, ShouldEqual, "redis://:"+resource.RedactedValue+"@localhost:6379/0")
+		So(result.FriendbotSecret, ShouldEqual, resource.RedactedValue)
+		So(result.LogglyToken, ShouldEqual, resource.RedactedValue)
+		So(result.StellarCoreURL, ShouldEqual, app.config.StellarCoreURL)
+
+		Convey("shows reloaded settings", func() {
+			original := app.Config()
+			defer app.ReloadConfig(original)
+
+			next := app.Config()
+			next.LogLevel = logrus.DebugLevel
+			next.AnonymousUserRestrictions.MaxBalance = 10 * 10000000
+			next.Port = app.config.Port + 1
+
+			restartRequired := app.ReloadConfig(next)
+			So(restartRequired, ShouldResemble, []string{"Port"})
+
+			result := getConfig()
+			So(result.LogLevel, ShouldEqual, "debug")
+			So(result.AnonymousUserRestrictions.MaxBalance, ShouldEqual, "10.0000000")
+			So(result.Port, ShouldNotEqual, next.Port)
+		})
+	})
+}
@@ -0,0 +1,29 @@
+package horizon
+
+import (
+	"github.com/openbankit/horizon/db2/history"
+	"github.com/openbankit/horizon/render/hal"
+	"github.com/openbankit/horizon/resource"
+)
+
+// DefaultLimitsAction renders limits applied to accounts of each type, if account does not have own limits
+type DefaultLimitsAction struct {
+	Action
+	Limits   []history.AccountTypeLimits
+	Resource resource.DefaultLimits
+}
+
+// JSON is a method for actions.JSON
+func (action *DefaultLimitsAction) JSON() {
+	action.Do(
+		action.loadRecord,
+		func() {
+			action.Resource.Populate(action.Ctx, action.Limits)
+			hal.Render(action.W, action.Resource)
+		},
+	)
+}
+
+func (action *DefaultLimitsAction) loadRecord() {
+	action.Err = action.HistoryQ().GetAllAccountTypeLimits(&action.Limits)
+}
@@ -0,0 +1,49 @@
+package horizon
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/openbankit/go-base/xdr"
+	"github.com/openbankit/horizon/db2/history"
+	"github.com/openbankit/horizon/resource"
+	"github.com/openbankit/horizon/test"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDefaultLimitsAction(t *testing.T) {
+	test.LoadScenario("base")
+	app := NewTestApp()
+	defer app.Close()
+	rh := NewRequestHelper(app)
+
+	Convey("GET /limits/defaults", t, func() {
+		err := app.HistoryQ().CreateAccountTypeLimits(history.AccountTypeLimits{
+			AccountType:      xdr.AccountTypeAccountRegisteredUser,
+			AssetCode:        "EUAH",
+			CounterpartyType: history.AnyCounterpartyType,
+			MaxOperationOut:  -1,
+			DailyMaxOut:      10000 * 10000000,
+			WeeklyMaxOut:     -1,
+			MonthlyMaxOut:    -1,
+			AnnualMaxOut:     -1,
+			MaxOperationIn:   -1,
+			DailyMaxIn:       -1,
+			WeeklyMaxIn:      -1,
+			MonthlyMaxIn:     -1,
+			AnnualMaxIn:      -1,
+		})
+		So(err, ShouldBeNil)
+
+		w := rh.Get("/limits/defaults", test.RequestHelperNoop)
+		So(w.Code, ShouldEqual, 200)
+
+		var result resource.DefaultLimits
+		err = json.Unmarshal(w.Body.Bytes(), &result)
+		So(err, ShouldBeNil)
+		So(len(result.Limits), ShouldEqual, 1)
+		So(result.Limits[0].AccountType, ShouldEqual, "registered_user")
+		So(result.Limits[0].AssetCode, ShouldEqual, "EUAH")
+		So(result.Limits[0].DailyMaxOut, ShouldEqual, "10000.0000000")
+	})
+}
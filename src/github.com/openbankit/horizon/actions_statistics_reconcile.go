@@ -0,0 +1,48 @@
+package horizon
+
+import (
+	"github.com/openbankit/horizon/render/hal"
+	"github.com/openbankit/horizon/render/problem"
+	"github.com/openbankit/horizon/resource"
+	"time"
+)
+
+// StatisticsReconcileAction compares account statistics cached in redis with statistics stored in history db
+// and optionally drops mismatching ones from redis. Request must be signed by admin.
+type StatisticsReconcileAction struct {
+	Action
+	Address   string
+	AssetCode string
+	Heal      bool
+	Resource  resource.StatisticsReconciliation
+}
+
+// JSON is a method for actions.JSON
+func (action *StatisticsReconcileAction) JSON() {
+	action.Do(
+		action.RequireAdmin,
+		action.loadParams,
+		action.loadResource,
+		func() {
+			hal.Render(action.W, action.Resource)
+		},
+	)
+}
+
+func (action *StatisticsReconcileAction) loadParams() {
+	action.ValidateBodyType()
+	action.Address = action.GetOptionalAddress("account_id")
+	action.AssetCode = action.GetString("asset_code")
+	action.Heal = action.GetBool("heal")
+}
+
+func (action *StatisticsReconcileAction) loadResource() {
+	result, err := action.App.statsReconciler.Reconcile(action.Address, action.AssetCode, action.Heal, time.Now())
+	if err != nil {
+		action.Log.WithError(err).Error("Failed to reconcile statistics")
+		action.Err = &problem.ServerError
+		return
+	}
+
+	action.Resource.Populate(result)
+}
@@ -0,0 +1,31 @@
+package horizon
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/openbankit/go-base/keypair"
+	"github.com/openbankit/horizon/test"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestStatisticsReconcileAction(t *testing.T) {
+	test.LoadScenario("base")
+	app := NewTestApp()
+	defer app.Close()
+	rh := NewRequestHelper(app)
+
+	Convey("POST /admin/statistics/reconcile", t, func() {
+		Convey("not signed", func() {
+			w := rh.Post("/admin/statistics/reconcile", url.Values{"asset_code": {"EUR"}}, test.RequestHelperNoop)
+			So(w.Code, ShouldEqual, http.StatusUnauthorized)
+		})
+		Convey("signed by not admin", func() {
+			signer, err := keypair.Random()
+			So(err, ShouldBeNil)
+			w := rh.SignedPost(signer, "/admin/statistics/reconcile", url.Values{"asset_code": {"EUR"}}, test.RequestHelperNoop)
+			So(w.Code, ShouldEqual, http.StatusForbidden)
+		})
+	})
+}
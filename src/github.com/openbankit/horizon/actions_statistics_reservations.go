@@ -0,0 +1,42 @@
+package horizon
+
+import (
+	"github.com/openbankit/horizon/render/hal"
+	"github.com/openbankit/horizon/render/problem"
+	"github.com/openbankit/horizon/resource"
+)
+
+// StatisticsReservationsAction renders account statistics reserved by transactions, which are not yet
+// applied or rejected by stellar-core. Request must be signed by admin.
+type StatisticsReservationsAction struct {
+	Action
+	Address  string
+	Resource resource.StatisticsReservations
+}
+
+// JSON is a method for actions.JSON
+func (action *StatisticsReservationsAction) JSON() {
+	action.Do(
+		action.RequireAdmin,
+		action.loadParams,
+		action.loadResource,
+		func() {
+			hal.Render(action.W, action.Resource)
+		},
+	)
+}
+
+func (action *StatisticsReservationsAction) loadParams() {
+	action.Address = action.GetAddress("account_id")
+}
+
+func (action *StatisticsReservationsAction) loadResource() {
+	reservations, err := action.App.statsReservations.Get(action.Address)
+	if err != nil {
+		action.Log.WithError(err).Error("Failed to get statistics reservations")
+		action.Err = &problem.ServerError
+		return
+	}
+
+	action.Resource.Populate(reservations)
+}
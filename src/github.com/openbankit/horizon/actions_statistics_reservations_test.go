@@ -0,0 +1,27 @@
+package horizon
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/openbankit/horizon/test"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestStatisticsReservationsAction(t *testing.T) {
+	test.LoadScenario("base")
+	app := NewTestApp()
+	defer app.Close()
+	rh := NewRequestHelper(app)
+
+	Convey("GET /admin/statistics/reservations", t, func() {
+		Convey("not signed", func() {
+			w := rh.Get("/admin/statistics/reservations?account_id="+test.NewTestConfig().BankMasterKey, test.RequestHelperNoop)
+			So(w.Code, ShouldEqual, http.StatusUnauthorized)
+		})
+		Convey("account is required", func() {
+			w := rh.SignedGet(test.AdminSeed(), "/admin/statistics/reservations", test.RequestHelperNoop)
+			So(w.Code, ShouldEqual, http.StatusBadRequest)
+		})
+	})
+}
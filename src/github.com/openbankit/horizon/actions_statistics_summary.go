@@ -0,0 +1,90 @@
+package horizon
+
+import (
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/openbankit/horizon/render/hal"
+	"github.com/openbankit/horizon/render/problem"
+	"github.com/openbankit/horizon/resource"
+)
+
+const (
+	// defaultSummaryWindow is used, if `from` is not specified
+	defaultSummaryWindow = 24 * time.Hour
+	// maxSummaryWindow limits amount of history scanned for volume, fees and reversals. Issuance is
+	// always computed from all history before `to`, so each cache miss scans whole history_operations.
+	maxSummaryWindow = 31 * 24 * time.Hour
+	// summaryBucket is a granularity of summary window. Bounds are aligned to it to make summary cacheable.
+	summaryBucket = time.Hour
+)
+
+// StatisticsSummaryAction renders bank-wide statistics: issued and circulating amount of each asset,
+// payments volume by account type pair, charged fees and reversals within [from, to).
+// Request must be signed by admin.
+type StatisticsSummaryAction struct {
+	Action
+	From     time.Time
+	To       time.Time
+	Resource resource.StatisticsSummary
+}
+
+// JSON is a method for actions.JSON
+func (action *StatisticsSummaryAction) JSON() {
+	action.Do(
+		action.RequireAdmin,
+		action.loadParams,
+		action.loadResource,
+		func() {
+			hal.Render(action.W, action.Resource)
+		},
+	)
+}
+
+func (action *StatisticsSummaryAction) loadParams() {
+	from := action.GetOptionalTime("from")
+	to := action.GetOptionalTime("to")
+	if action.Err != nil {
+		return
+	}
+
+	action.To = time.Now()
+	if to != nil {
+		action.To = *to
+	}
+	action.To = ceilTime(action.To, summaryBucket)
+
+	action.From = action.To.Add(-defaultSummaryWindow)
+	if from != nil {
+		action.From = from.Truncate(summaryBucket)
+	}
+
+	if !action.To.After(action.From) {
+		action.SetInvalidField("to", errors.New("Must be after from"))
+		return
+	}
+
+	if action.To.Sub(action.From) > maxSummaryWindow {
+		action.SetInvalidField("from", errors.New("Window must not be longer than "+maxSummaryWindow.String()))
+	}
+}
+
+func (action *StatisticsSummaryAction) loadResource() {
+	summary, err := action.App.SharedCache().StatisticsSummary.Get(action.From, action.To)
+	if err != nil {
+		action.Log.WithError(err).Error("Failed to build statistics summary")
+		action.Err = &problem.ServerError
+		return
+	}
+
+	action.Resource.Populate(summary)
+}
+
+// ceilTime rounds t up to a multiple of d
+func ceilTime(t time.Time, d time.Duration) time.Time {
+	result := t.Truncate(d)
+	if result.Before(t) {
+		result = result.Add(d)
+	}
+	return result
+}
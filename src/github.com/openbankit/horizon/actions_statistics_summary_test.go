@@ -0,0 +1,38 @@
+package horizon
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/openbankit/horizon/test"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestStatisticsSummaryAction(t *testing.T) {
+	test.LoadScenario("base")
+	app := NewTestApp()
+	defer app.Close()
+	rh := NewRequestHelper(app)
+	admin := test.AdminSeed()
+
+	Convey("GET /admin/statistics/summary", t, func() {
+		Convey("not signed", func() {
+			w := rh.Get("/admin/statistics/summary", test.RequestHelperNoop)
+			So(w.Code, ShouldEqual, http.StatusUnauthorized)
+		})
+		Convey("signed by admin", func() {
+			w := rh.SignedGet(admin, "/admin/statistics/summary", test.RequestHelperNoop)
+			So(w.Code, ShouldEqual, http.StatusOK)
+		})
+		Convey("window is too long", func() {
+			w := rh.SignedGet(admin, "/admin/statistics/summary?from=2016-01-01T00:00:00Z&to=2016-03-01T00:00:00Z", test.RequestHelperNoop)
+			So(w.Code, ShouldEqual, http.StatusBadRequest)
+		})
+	})
+	Convey("ceilTime", t, func() {
+		bound := time.Date(2016, 10, 15, 10, 0, 0, 0, time.UTC)
+		So(ceilTime(bound, time.Hour), ShouldResemble, bound)
+		So(ceilTime(bound.Add(time.Minute), time.Hour), ShouldResemble, bound.Add(time.Hour))
+	})
+}
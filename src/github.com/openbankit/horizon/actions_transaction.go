@@ -3,6 +3,7 @@ package horizon
 import (
 	"net/http"
 
+	"github.com/openbankit/horizon/commissions"
 	"github.com/openbankit/horizon/db2"
 	"github.com/openbankit/horizon/db2/history"
 	"github.com/openbankit/horizon/render/hal"
@@ -137,12 +138,17 @@ func (action *TransactionShowAction) JSON() {
 }
 
 // TransactionCreateAction submits a transaction to the stellar-core network
-// on behalf of the requesting client.
+// on behalf of the requesting client. If `async` is set, responds with 202 as soon as
+// transaction is accepted by stellar-core. Its result can be then polled using
+// TransactionStatusAction.
 type TransactionCreateAction struct {
 	Action
 	TX       string
+	Async    bool
+	Pending  bool
 	Result   txsub.Result
 	Resource resource.TransactionSuccess
+	Status   resource.TransactionStatus
 }
 
 // JSON format action handler
@@ -153,6 +159,10 @@ func (action *TransactionCreateAction) JSON() {
 		action.loadResource,
 
 		func() {
+			if action.Pending {
+				hal.RenderWithStatus(action.W, action.Status, http.StatusAccepted)
+				return
+			}
 			hal.Render(action.W, action.Resource)
 		})
 }
@@ -160,9 +170,15 @@ func (action *TransactionCreateAction) JSON() {
 func (action *TransactionCreateAction) loadTX() {
 	action.ValidateBodyType()
 	action.TX = action.GetString("tx")
+	action.Async = action.GetBool("async")
 }
 
 func (action *TransactionCreateAction) loadResult() {
+	if action.Async {
+		action.loadAsyncResult()
+		return
+	}
+
 	submission := action.App.submitter.Submit(action.Ctx, action.TX)
 
 	select {
@@ -173,7 +189,27 @@ func (action *TransactionCreateAction) loadResult() {
 	}
 }
 
+func (action *TransactionCreateAction) loadAsyncResult() {
+	submission := action.App.submitter.SubmitAsync(action.Ctx, action.TX)
+	if !submission.Pending {
+		// result of not pending submission is already available
+		action.Result = <-submission.Result
+		return
+	}
+
+	action.Pending = true
+	err := action.Status.Populate(action.Ctx, submission.Hash, txsub.TransactionPending, txsub.Result{})
+	if err != nil {
+		action.Log.WithError(err).Error("Failed to populate transaction status")
+		action.Err = &problem.ServerError
+	}
+}
+
 func (action *TransactionCreateAction) loadResource() {
+	if action.Pending {
+		return
+	}
+
 	if action.Result.Err == nil {
 		action.Resource.Populate(action.Ctx, action.Result)
 		return
@@ -232,19 +268,9 @@ func (action *TransactionCreateAction) loadResource() {
 			},
 		}
 	case *results.MalformedTransactionError:
-		action.Err = &problem.P{
-			Type:   "transaction_malformed",
-			Title:  "Transaction Malformed",
-			Status: http.StatusBadRequest,
-			Detail: "Horizon could not decode the transaction envelope in this " +
-				"request. A transaction should be an XDR TransactionEnvelope struct " +
-				"encoded using base64.  The envelope read from this request is " +
-				"echoed in the `extras.envelope_xdr` field of this response for your " +
-				"convenience.",
-			Extras: map[string]interface{}{
-				"envelope_xdr": err.EnvelopeXDR,
-			},
-		}
+		action.Err = malformedTransactionProblem(err)
+	case *commissions.SponsorshipError:
+		action.Err = commissionSponsorshipProblem(err)
 	case *results.RestrictedForAccountTypeError:
 		action.Err = &problem.P{
 			Type:   "transaction_restricted_account_types",
@@ -266,10 +292,43 @@ func (action *TransactionCreateAction) loadResource() {
 			Status: http.StatusForbidden,
 			Detail: err.Reason,
 			Extras: map[string]interface{}{
-				"reason": err.Reason,
+				"reason":        err.Reason,
+				"block_reason":  err.BlockReason,
+				"blocked_until": err.BlockedUntil,
 			},
 		}
 	default:
 		action.Err = err
 	}
 }
+
+func commissionSponsorshipProblem(err *commissions.SponsorshipError) *problem.P {
+	return &problem.P{
+		Type:   "commission_sponsorship_invalid",
+		Title:  "Commission Sponsorship Invalid",
+		Status: http.StatusBadRequest,
+		Detail: "Commission of the operation must be sponsored, but sponsorship is missing or invalid. " +
+			"Sponsor pays commission by payment to the bank's commission account, which immediately " +
+			"follows the sponsored operation. The `extras.reason` field on this response contains further details.",
+		Extras: map[string]interface{}{
+			"operation_index": err.OperationIndex,
+			"reason":          err.Reason,
+		},
+	}
+}
+
+func malformedTransactionProblem(err *results.MalformedTransactionError) *problem.P {
+	return &problem.P{
+		Type:   "transaction_malformed",
+		Title:  "Transaction Malformed",
+		Status: http.StatusBadRequest,
+		Detail: "Horizon could not decode the transaction envelope in this " +
+			"request. A transaction should be an XDR TransactionEnvelope struct " +
+			"encoded using base64.  The envelope read from this request is " +
+			"echoed in the `extras.envelope_xdr` field of this response for your " +
+			"convenience.",
+		Extras: map[string]interface{}{
+			"envelope_xdr": err.EnvelopeXDR,
+		},
+	}
+}
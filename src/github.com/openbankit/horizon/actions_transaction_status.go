@@ -0,0 +1,52 @@
+package horizon
+
+import (
+	"github.com/openbankit/horizon/render/hal"
+	"github.com/openbankit/horizon/render/problem"
+	"github.com/openbankit/horizon/resource"
+	"github.com/openbankit/horizon/txsub"
+)
+
+// TransactionStatusAction renders state of the submitted transaction: pending, if it's accepted by
+// stellar-core and waits to be included into ledger, applied or failed, if its result is found, and
+// unknown otherwise.
+type TransactionStatusAction struct {
+	Action
+	Hash     string
+	Status   txsub.TransactionStatus
+	Result   txsub.Result
+	Resource resource.TransactionStatus
+}
+
+// JSON format action handler
+func (action *TransactionStatusAction) JSON() {
+	action.Do(
+		action.loadParams,
+		action.loadStatus,
+		action.loadResource,
+		func() {
+			hal.Render(action.W, action.Resource)
+		},
+	)
+}
+
+func (action *TransactionStatusAction) loadParams() {
+	action.Hash = action.GetString("tx_id")
+}
+
+func (action *TransactionStatusAction) loadStatus() {
+	var err error
+	action.Status, action.Result, err = action.App.submitter.Status(action.Ctx, action.Hash)
+	if err != nil {
+		action.Log.WithError(err).Error("Failed to get transaction status")
+		action.Err = &problem.ServerError
+	}
+}
+
+func (action *TransactionStatusAction) loadResource() {
+	err := action.Resource.Populate(action.Ctx, action.Hash, action.Status, action.Result)
+	if err != nil {
+		action.Log.WithError(err).Error("Failed to populate transaction status")
+		action.Err = &problem.ServerError
+	}
+}
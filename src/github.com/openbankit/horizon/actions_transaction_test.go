@@ -2,6 +2,7 @@ package horizon
 
 import (
 	"encoding/json"
+	"github.com/openbankit/go-base/xdr"
 	. "github.com/smartystreets/goconvey/convey"
 	"github.com/openbankit/horizon/resource"
 	"github.com/openbankit/horizon/test"
@@ -99,7 +100,73 @@ func _TestTransactionActions(t *testing.T) {
 				So(w.Code, ShouldEqual, 200)
 
 			})
+
+			Convey("200 response for existing transaction submitted asynchronously", func() {
+				w := rh.Post(
+					"/transactions",
+					url.Values{"tx": []string{"AAAAAGL8HQvQkbK2HA3WVjRrKmjX00fG8sLI7m0ERwJW/AX3AAAAZAAAAAAAAAABAAAAAAAAAAAAAAABAAAAAAAAAAAAAAAArqN6LeOagjxMaUP96Bzfs9e0corNZXzBWJkFoK7kvkwAAAAAO5rKAAAAAAAAAAABVvwF9wAAAECDzqvkQBQoNAJifPRXDoLhvtycT3lFPCQ51gkdsFHaBNWw05S/VhW0Xgkr0CBPE4NaFV2Kmcs3ZwLmib4TRrML"}, "async": []string{"true"}},
+					test.RequestHelperNoop,
+				)
+				So(w.Code, ShouldEqual, 200)
+			})
+		})
+
+	})
+}
+
+func TestTransactionAsyncActions(t *testing.T) {
+	test.LoadScenario("base")
+	app := NewTestApp()
+	defer app.Close()
+	rh := NewRequestHelper(app)
+
+	tx := "AAAAAGL8HQvQkbK2HA3WVjRrKmjX00fG8sLI7m0ERwJW/AX3AAAAZAAAAAAAAAABAAAAAAAAAAAAAAABAAAAAAAAAAAAAAAArqN6LeOagjxMaUP96Bzfs9e0corNZXzBWJkFoK7kvkwAAAAAO5rKAAAAAAAAAAABVvwF9wAAAECDzqvkQBQoNAJifPRXDoLhvtycT3lFPCQ51gkdsFHaBNWw05S/VhW0Xgkr0CBPE4NaFV2Kmcs3ZwLmib4TRrML"
+	var env xdr.TransactionEnvelope
+	err := xdr.SafeUnmarshalBase64(tx, &env)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	getStatus := func(hash string) resource.TransactionStatus {
+		w := rh.Get("/transactions/"+hash+"/status", test.RequestHelperNoop)
+		So(w.Code, ShouldEqual, 200)
+
+		var result resource.TransactionStatus
+		err := json.Unmarshal(w.Body.Bytes(), &result)
+		So(err, ShouldBeNil)
+		return result
+	}
+
+	Convey("Async submission:", t, func() {
+		submitter := &txsub.MockSubmitter{}
+		app.submitter.Submitter = submitter
+		app.submitter.Results = &txsub.MockResultProvider{}
+		app.submitter.Sequences = &txsub.MockSequenceProvider{
+			Results: map[string]uint64{env.Tx.SourceAccount.Address(): uint64(env.Tx.SeqNum) - 1},
+		}
+
+		Convey("202 response for transaction accepted by stellar-core asynchronously", func() {
+			w := rh.Post("/transactions", url.Values{"tx": {tx}, "async": {"true"}}, test.RequestHelperNoop)
+			So(w.Code, ShouldEqual, 202)
+			So(submitter.WasSubmittedTo, ShouldBeTrue)
+
+			var result resource.TransactionStatus
+			err := json.Unmarshal(w.Body.Bytes(), &result)
+			So(err, ShouldBeNil)
+			So(result.Status, ShouldEqual, "pending")
+			So(getStatus(result.Hash).Status, ShouldEqual, "pending")
 		})
 
+		Convey("GET /transactions/:tx_id/status", func() {
+			hash := "2374e99349b9ef7dba9a5db3339b78fda8f34777b1af33ba468ad5c0df946d4d"
+			So(getStatus(hash).Status, ShouldEqual, "unknown")
+
+			app.submitter.Results = &txsub.MockResultProvider{
+				Results: []txsub.Result{{Hash: hash, LedgerSequence: 3}},
+			}
+			result := getStatus(hash)
+			So(result.Status, ShouldEqual, "applied")
+			So(result.Ledger, ShouldEqual, 3)
+		})
 	})
 }
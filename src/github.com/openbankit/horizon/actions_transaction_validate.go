@@ -0,0 +1,67 @@
+package horizon
+
+import (
+	"github.com/openbankit/horizon/commissions"
+	"github.com/openbankit/horizon/render/hal"
+	"github.com/openbankit/horizon/render/problem"
+	"github.com/openbankit/horizon/resource"
+	"github.com/openbankit/horizon/txsub"
+	"github.com/openbankit/horizon/txsub/results"
+)
+
+// TransactionValidateAction runs the same checks as TransactionCreateAction does, but does not submit
+// transaction to stellar-core and does not update account statistics. Renders results of each operation
+// and commissions which would be charged.
+type TransactionValidateAction struct {
+	Action
+	TX       string
+	Result   *txsub.ValidationResult
+	Resource resource.TransactionValidation
+}
+
+// JSON format action handler
+func (action *TransactionValidateAction) JSON() {
+	action.Do(
+		action.loadTX,
+		action.loadResult,
+		action.loadResource,
+		func() {
+			hal.Render(action.W, action.Resource)
+		},
+	)
+}
+
+func (action *TransactionValidateAction) loadTX() {
+	action.ValidateBodyType()
+	action.TX = action.GetString("tx")
+}
+
+func (action *TransactionValidateAction) loadResult() {
+	validator := txsub.NewValidator(action.CoreQ(), action.HistoryQ(), action.App, action.App.SharedCache(), action.App.networkPassphrase)
+	var err error
+	action.Result, err = validator.Validate(action.Ctx, action.TX)
+	if err == nil {
+		return
+	}
+
+	if malformed, ok := err.(*results.MalformedTransactionError); ok {
+		action.Err = malformedTransactionProblem(malformed)
+		return
+	}
+
+	if sponsorship, ok := err.(*commissions.SponsorshipError); ok {
+		action.Err = commissionSponsorshipProblem(sponsorship)
+		return
+	}
+
+	action.Log.WithError(err).Error("Failed to validate transaction")
+	action.Err = &problem.ServerError
+}
+
+func (action *TransactionValidateAction) loadResource() {
+	err := action.Resource.Populate(action.Ctx, action.Result)
+	if err != nil {
+		action.Log.WithError(err).Error("Failed to populate transaction validation")
+		action.Err = &problem.ServerError
+	}
+}
@@ -0,0 +1,239 @@
+package horizon
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/openbankit/horizon/db2"
+	"github.com/openbankit/horizon/render/hal"
+	"github.com/openbankit/horizon/resource"
+	"github.com/openbankit/horizon/webhook"
+)
+
+// WebhookSubscriptionIndexAction renders a page of webhook subscriptions. All the webhook actions require
+// admin signature: subscriptions expose account activity and make horizon send requests to their endpoints.
+type WebhookSubscriptionIndexAction struct {
+	Action
+	PagingParams db2.PageQuery
+	Records      []webhook.Subscription
+	Page         hal.Page
+}
+
+// JSON is a method for actions.JSON
+func (action *WebhookSubscriptionIndexAction) JSON() {
+	action.Do(
+		action.RequireAdmin,
+		action.loadParams,
+		action.loadRecords,
+		action.loadPage,
+		func() {
+			hal.Render(action.W, action.Page)
+		},
+	)
+}
+
+func (action *WebhookSubscriptionIndexAction) loadParams() {
+	action.PagingParams = action.GetPageQuery()
+}
+
+func (action *WebhookSubscriptionIndexAction) loadRecords() {
+	action.Err = action.WebhookQ().SubscriptionsPage(&action.Records, action.PagingParams)
+}
+
+func (action *WebhookSubscriptionIndexAction) loadPage() {
+	for _, record := range action.Records {
+		var res resource.WebhookSubscription
+		res.Populate(action.Ctx, record)
+		action.Page.Add(res)
+	}
+
+	action.Page.BaseURL = action.BaseURL()
+	action.Page.BasePath = action.Path()
+	action.Page.Limit = action.PagingParams.Limit
+	action.Page.Cursor = action.PagingParams.Cursor
+	action.Page.Order = action.PagingParams.Order
+	action.Page.PopulateLinks()
+}
+
+// WebhookSubscriptionCreateAction registers endpoint to be notified about events of the
+// specified types. If account_id is set, only events of the account are delivered.
+// If event_types is empty, all events are delivered.
+type WebhookSubscriptionCreateAction struct {
+	Action
+	Subscription webhook.Subscription
+	Resource     resource.WebhookSubscription
+}
+
+// JSON is a method for actions.JSON
+func (action *WebhookSubscriptionCreateAction) JSON() {
+	action.Do(
+		action.RequireAdmin,
+		action.loadParams,
+		action.createSubscription,
+		func() {
+			action.Resource.Populate(action.Ctx, action.Subscription)
+			hal.Render(action.W, action.Resource)
+		},
+	)
+}
+
+func (action *WebhookSubscriptionCreateAction) loadParams() {
+	action.ValidateBodyType()
+	action.Subscription.URL = action.GetString("url")
+	action.Subscription.Secret = action.GetString("secret")
+	account := action.GetOptionalAddress("account_id")
+	rawEventTypes := action.GetString("event_types")
+	if action.Err != nil {
+		return
+	}
+
+	err := webhook.CheckURL(action.Subscription.URL, action.App.Config().WebhookAllowPrivate)
+	if err != nil {
+		action.SetInvalidField("url", err)
+		return
+	}
+
+	if action.Subscription.Secret == "" {
+		action.SetInvalidField("secret", errors.New("can not be empty"))
+		return
+	}
+
+	eventTypes, err := webhook.ParseEventTypes(rawEventTypes)
+	if err != nil {
+		action.SetInvalidField("event_types", err)
+		return
+	}
+
+	action.Subscription.EventTypes = webhook.JoinEventTypes(eventTypes)
+	action.Subscription.Account = sql.NullString{String: account, Valid: account != ""}
+}
+
+func (action *WebhookSubscriptionCreateAction) createSubscription() {
+	err := action.WebhookQ().CreateSubscription(&action.Subscription)
+	if err != nil {
+		action.Log.WithError(err).Error("Failed to create webhook subscription")
+		action.Err = err
+	}
+}
+
+// WebhookSubscriptionDeleteAction removes webhook subscription and its pending deliveries.
+// Dead letters of the subscription are kept.
+type WebhookSubscriptionDeleteAction struct {
+	Action
+	ID           int64
+	Subscription webhook.Subscription
+	Resource     resource.WebhookSubscription
+}
+
+// JSON is a method for actions.JSON
+func (action *WebhookSubscriptionDeleteAction) JSON() {
+	action.Do(
+		action.RequireAdmin,
+		action.loadParams,
+		action.loadRecord,
+		action.deleteSubscription,
+		func() {
+			action.Resource.Populate(action.Ctx, action.Subscription)
+			hal.Render(action.W, action.Resource)
+		},
+	)
+}
+
+func (action *WebhookSubscriptionDeleteAction) loadParams() {
+	action.ID = action.GetInt64("id")
+}
+
+func (action *WebhookSubscriptionDeleteAction) loadRecord() {
+	action.Err = action.WebhookQ().SubscriptionByID(&action.Subscription, action.ID)
+}
+
+func (action *WebhookSubscriptionDeleteAction) deleteSubscription() {
+	_, err := action.WebhookQ().DeleteSubscription(action.ID)
+	if err != nil {
+		action.Log.WithError(err).Error("Failed to delete webhook subscription")
+		action.Err = err
+	}
+}
+
+// WebhookPingAction sends signed test event to the subscription's endpoint and renders the outcome.
+// The event is not stored and is not retried.
+type WebhookPingAction struct {
+	Action
+	ID           int64
+	Subscription webhook.Subscription
+	Resource     resource.WebhookPing
+}
+
+// JSON is a method for actions.JSON
+func (action *WebhookPingAction) JSON() {
+	action.Do(
+		action.RequireAdmin,
+		action.loadParams,
+		action.loadRecord,
+		action.ping,
+		func() {
+			hal.Render(action.W, action.Resource)
+		},
+	)
+}
+
+func (action *WebhookPingAction) loadParams() {
+	action.ID = action.GetInt64("id")
+}
+
+func (action *WebhookPingAction) loadRecord() {
+	action.Err = action.WebhookQ().SubscriptionByID(&action.Subscription, action.ID)
+}
+
+func (action *WebhookPingAction) ping() {
+	result := action.App.webhooks.Ping(&action.Subscription, webhook.NewPingEvent(action.ID, time.Now()))
+	action.Resource.Populate(action.ID, result)
+}
+
+// WebhookDeadLetterIndexAction renders a page of deliveries, which failed max number of attempts,
+// optionally filtered by subscription.
+type WebhookDeadLetterIndexAction struct {
+	Action
+	SubscriptionID int64
+	PagingParams   db2.PageQuery
+	Records        []webhook.DeadLetter
+	Page           hal.Page
+}
+
+// JSON is a method for actions.JSON
+func (action *WebhookDeadLetterIndexAction) JSON() {
+	action.Do(
+		action.RequireAdmin,
+		action.loadParams,
+		action.loadRecords,
+		action.loadPage,
+		func() {
+			hal.Render(action.W, action.Page)
+		},
+	)
+}
+
+func (action *WebhookDeadLetterIndexAction) loadParams() {
+	action.SubscriptionID = action.GetInt64("subscription_id")
+	action.PagingParams = action.GetPageQuery()
+}
+
+func (action *WebhookDeadLetterIndexAction) loadRecords() {
+	action.Err = action.WebhookQ().DeadLettersPage(&action.Records, action.SubscriptionID, action.PagingParams)
+}
+
+func (action *WebhookDeadLetterIndexAction) loadPage() {
+	for _, record := range action.Records {
+		var res resource.WebhookDeadLetter
+		res.Populate(record)
+		action.Page.Add(res)
+	}
+
+	action.Page.BaseURL = action.BaseURL()
+	action.Page.BasePath = action.Path()
+	action.Page.Limit = action.PagingParams.Limit
+	action.Page.Cursor = action.PagingParams.Cursor
+	action.Page.Order = action.PagingParams.Order
+	action.Page.PopulateLinks()
+}
@@ -0,0 +1,126 @@
+package horizon
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/openbankit/go-base/keypair"
+	"github.com/openbankit/horizon/resource"
+	"github.com/openbankit/horizon/test"
+	"github.com/openbankit/horizon/webhook"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestWebhookActions(t *testing.T) {
+	test.LoadScenario("base")
+	app := NewTestApp()
+	defer app.Close()
+	rh := NewRequestHelper(app)
+
+	var signed bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event webhook.Event
+		body := json.NewDecoder(r.Body)
+		signed = r.Header.Get(webhook.HeaderSignature) != "" && body.Decode(&event) == nil && event.Type == webhook.EventPing
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	admin := test.AdminSeed()
+
+	Convey("webhook actions require admin signature", t, func() {
+		w := rh.Get("/admin/webhooks", test.RequestHelperNoop)
+		So(w.Code, ShouldEqual, http.StatusUnauthorized)
+
+		w = rh.Post("/admin/webhooks", url.Values{"url": []string{server.URL}, "secret": []string{"secret"}}, test.RequestHelperNoop)
+		So(w.Code, ShouldEqual, http.StatusUnauthorized)
+
+		signer, err := keypair.Random()
+		So(err, ShouldBeNil)
+		w = rh.SignedGet(signer, "/admin/webhooks", test.RequestHelperNoop)
+		So(w.Code, ShouldEqual, http.StatusForbidden)
+
+		w = rh.SignedPost(signer, "/admin/webhooks/1/ping", url.Values{}, test.RequestHelperNoop)
+		So(w.Code, ShouldEqual, http.StatusForbidden)
+
+		w = rh.SignedDelete(signer, "/admin/webhooks/1", test.RequestHelperNoop)
+		So(w.Code, ShouldEqual, http.StatusForbidden)
+	})
+
+	Convey("webhook actions", t, func() {
+		test.LoadScenario("base")
+		account := test.NewTestConfig().BankMasterKey
+
+		w := rh.SignedPost(admin, "/admin/webhooks", url.Values{
+			"url":         []string{server.URL},
+			"secret":      []string{"secret"},
+			"account_id":  []string{account},
+			"event_types": []string{"payment,payment_reversal"},
+		}, test.RequestHelperNoop)
+		So(w.Code, ShouldEqual, 200)
+
+		var subscription resource.WebhookSubscription
+		err := json.Unmarshal(w.Body.Bytes(), &subscription)
+		So(err, ShouldBeNil)
+		So(subscription.URL, ShouldEqual, server.URL)
+		So(subscription.Account, ShouldEqual, account)
+		So(subscription.EventTypes, ShouldResemble, []string{"payment", "payment_reversal"})
+		So(w.Body.String(), ShouldNotContainSubstring, "secret")
+
+		Convey("GET /admin/webhooks", func() {
+			w := rh.SignedGet(admin, "/admin/webhooks", test.RequestHelperNoop)
+			So(w.Code, ShouldEqual, 200)
+			So(w.Body, ShouldBePageOf, 1)
+		})
+
+		Convey("POST /admin/webhooks/:id/ping", func() {
+			w := rh.SignedPost(admin, "/admin/webhooks/"+subscription.ID+"/ping", url.Values{}, test.RequestHelperNoop)
+			So(w.Code, ShouldEqual, 200)
+
+			var ping resource.WebhookPing
+			err := json.Unmarshal(w.Body.Bytes(), &ping)
+			So(err, ShouldBeNil)
+			So(ping.Delivered, ShouldBeTrue)
+			So(ping.StatusCode, ShouldEqual, http.StatusNoContent)
+			So(signed, ShouldBeTrue)
+		})
+
+		Convey("DELETE /admin/webhooks/:id", func() {
+			w := rh.SignedDelete(admin, "/admin/webhooks/"+subscription.ID, test.RequestHelperNoop)
+			So(w.Code, ShouldEqual, 200)
+
+			w = rh.SignedGet(admin, "/admin/webhooks", test.RequestHelperNoop)
+			So(w.Body, ShouldBePageOf, 0)
+
+			w = rh.SignedDelete(admin, "/admin/webhooks/"+subscription.ID, test.RequestHelperNoop)
+			So(w.Code, ShouldEqual, 404)
+		})
+
+		Convey("GET /admin/webhooks/dead_letters", func() {
+			w := rh.SignedGet(admin, "/admin/webhooks/dead_letters?subscription_id="+subscription.ID, test.RequestHelperNoop)
+			So(w.Code, ShouldEqual, 200)
+			So(w.Body, ShouldBePageOf, 0)
+		})
+
+		Convey("invalid subscription", func() {
+			w := rh.SignedPost(admin, "/admin/webhooks", url.Values{
+				"url":    []string{"ftp://example.com"},
+				"secret": []string{"secret"},
+			}, test.RequestHelperNoop)
+			So(w.Code, ShouldEqual, 400)
+
+			w = rh.SignedPost(admin, "/admin/webhooks", url.Values{
+				"url":         []string{server.URL},
+				"secret":      []string{"secret"},
+				"event_types": []string{"unknown"},
+			}, test.RequestHelperNoop)
+			So(w.Code, ShouldEqual, 400)
+
+			w = rh.SignedPost(admin, "/admin/webhooks", url.Values{"url": []string{server.URL}}, test.RequestHelperNoop)
+			So(w.Code, ShouldEqual, 400)
+		})
+	})
+}
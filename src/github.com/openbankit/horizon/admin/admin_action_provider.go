@@ -14,6 +14,8 @@ type AdminActionProviderInterface interface {
 type AdminActionProvider struct {
 	log      *log.Entry
 	historyQ history.QInterface
+	actor    string
+	txHash   string
 }
 
 func NewAdminActionProvider(historyQ history.QInterface) *AdminActionProvider {
@@ -23,16 +25,27 @@ func NewAdminActionProvider(historyQ history.QInterface) *AdminActionProvider {
 	}
 }
 
+// SetAuditInfo sets source account and transaction hash of administrative operation,
+// which will be recorded into audit log by created actions
+func (p *AdminActionProvider) SetAuditInfo(actor, txHash string) {
+	p.actor = actor
+	p.txHash = txHash
+}
+
 func (p *AdminActionProvider) CreateNewParser(data map[string]interface{}) (AdminActionInterface, error) {
 	if len(data) > 1 {
 		return nil, errors.New("Only one operation per time can be processed")
 	}
 	for key, rawValue := range data {
+		if AdminActionSubject(key) == SubjectBatch {
+			return p.createBatch(rawValue)
+		}
 		value, err := getAdminActionData(rawValue, key)
 		if err != nil {
 			return nil, err
 		}
 		adminAction := NewAdminAction(value, p.historyQ)
+		adminAction.SetAuditInfo(AdminActionSubject(key), p.actor, p.txHash)
 		switch AdminActionSubject(key) {
 		case SubjectCommission:
 			return NewSetCommissionAction(adminAction), nil
@@ -44,6 +57,12 @@ func (p *AdminActionProvider) CreateNewParser(data map[string]interface{}) (Admi
 			return NewManageAssetsAction(adminAction), nil
 		case SubjectMaxPaymentReversalDuration:
 			return NewManageMaxReversalDurationAction(adminAction), nil
+		case SubjectAccountTypeRestrictions:
+			return NewManageAccountTypeRestrictionsAction(adminAction), nil
+		case SubjectAccountTypeLimits:
+			return NewSetAccountTypeLimitsAction(adminAction), nil
+		case SubjectCommissionPayer:
+			return NewSetCommissionPayerAction(adminAction), nil
 		default:
 			return nil, errors.New("unknown admin action")
 		}
@@ -51,6 +70,33 @@ func (p *AdminActionProvider) CreateNewParser(data map[string]interface{}) (Admi
 	return nil, errors.New("data can't be empty")
 }
 
+// createBatch creates batch action from list of admin actions data
+func (p *AdminActionProvider) createBatch(rawValue interface{}) (AdminActionInterface, error) {
+	items, ok := rawValue.([]interface{})
+	if !ok {
+		return nil, errors.New(fmt.Sprintf("Value of %s must be list", SubjectBatch))
+	}
+
+	batch := NewBatchAction(NewAdminAction(nil, p.historyQ))
+	for i, rawItem := range items {
+		itemData, ok := rawItem.(map[string]interface{})
+		if !ok {
+			return nil, errors.New(fmt.Sprintf("Item %d of %s must be object", i, SubjectBatch))
+		}
+
+		if _, isBatch := itemData[string(SubjectBatch)]; isBatch {
+			return nil, errors.New("Batch can't be nested")
+		}
+
+		item, err := p.CreateNewParser(itemData)
+		if err != nil {
+			return nil, errors.New(fmt.Sprintf("Item %d of %s: %s", i, SubjectBatch, err.Error()))
+		}
+		batch.Actions = append(batch.Actions, item)
+	}
+	return batch, nil
+}
+
 func getAdminActionData(rawValue interface{}, key string) (result map[string]interface{}, err error) {
 	switch rawValue.(type) {
 	case map[string]interface{}:
@@ -90,5 +90,33 @@ func TestAdminActionProvider(t *testing.T) {
 				assert.Fail(t, "Expected ManageAssetsAction")
 			}
 		})
+
+		Convey("Manage account type restrictions action", func() {
+			action, err := actionProvider.CreateNewParser(map[string]interface{} {
+				string(SubjectAccountTypeRestrictions): map[string]interface{}{},
+			})
+			So(err, ShouldBeNil)
+			switch action.(type) {
+			case *ManageAccountTypeRestrictionsAction:
+			//ok
+			default:
+				//not ok
+				assert.Fail(t, "Expected ManageAccountTypeRestrictionsAction")
+			}
+		})
+
+		Convey("Set account type limits action", func() {
+			action, err := actionProvider.CreateNewParser(map[string]interface{} {
+				string(SubjectAccountTypeLimits): map[string]interface{}{},
+			})
+			So(err, ShouldBeNil)
+			switch action.(type) {
+			case *SetAccountTypeLimitsAction:
+			//ok
+			default:
+				//not ok
+				assert.Fail(t, "Expected SetAccountTypeLimitsAction")
+			}
+		})
 	})
 }
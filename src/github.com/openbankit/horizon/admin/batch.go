@@ -0,0 +1,134 @@
+package admin
+
+import (
+	"fmt"
+
+	"github.com/go-errors/errors"
+	"github.com/openbankit/horizon/render/problem"
+)
+
+const batchSavepoint = "admin_batch"
+
+// BatchAction validates and applies ordered list of admin actions as a whole.
+// All actions are validated before any of them is applied. If one of them fails to apply,
+// changes made by previous ones are rolled back. Must be applied inside of history transaction.
+type BatchAction struct {
+	AdminAction
+	Actions []AdminActionInterface
+	// Errors contains validation errors of batch items
+	Errors []*BatchItemError
+}
+
+// BatchItemError describes error of the batch item
+type BatchItemError struct {
+	Index int
+	Err   error
+}
+
+func (err *BatchItemError) Error() string {
+	return fmt.Sprintf("batch item %d: %s", err.Index, err.Err.Error())
+}
+
+// InvalidField returns error of the item as invalid field of the batch, e.g. batch[1].flat_fee
+func (err *BatchItemError) InvalidField() *InvalidFieldError {
+	fieldErr, ok := err.Err.(*InvalidFieldError)
+	if ok {
+		return InvalidField(fmt.Sprintf("%s[%d].%s", SubjectBatch, err.Index, fieldErr.FieldName), fieldErr.Reason)
+	}
+	return InvalidField(fmt.Sprintf("%s[%d]", SubjectBatch, err.Index), err.Err)
+}
+
+func NewBatchAction(adminAction AdminAction) *BatchAction {
+	return &BatchAction{
+		AdminAction: adminAction,
+	}
+}
+
+func (action *BatchAction) Validate() {
+	if len(action.Actions) == 0 {
+		action.SetInvalidField(string(SubjectBatch), errors.New("Can't be empty"))
+		return
+	}
+
+	for i, item := range action.Actions {
+		item.Validate()
+		err := item.GetError()
+		if err == nil {
+			continue
+		}
+
+		if isServerError(err) {
+			action.Err = err
+			return
+		}
+		action.Errors = append(action.Errors, &BatchItemError{Index: i, Err: err})
+	}
+
+	if len(action.Errors) != 0 {
+		action.Err = action.Errors[0].InvalidField()
+	}
+}
+
+func (action *BatchAction) Apply() {
+	if action.Err != nil {
+		return
+	}
+
+	err := action.HistoryQ().Savepoint(batchSavepoint)
+	if err != nil {
+		action.Log.WithError(err).Error("Failed to create savepoint for batch")
+		action.Err = &problem.ServerError
+		return
+	}
+
+	for i, item := range action.Actions {
+		item.Apply()
+		err = item.GetError()
+		if err == nil {
+			continue
+		}
+
+		action.Log.WithError(err).WithField("index", i).Error("Failed to apply batch item. Rolling back batch")
+		action.Err = &BatchItemError{Index: i, Err: err}
+		err = action.HistoryQ().RollbackToSavepoint(batchSavepoint)
+		if err != nil {
+			action.Log.WithError(err).Error("Failed to rollback batch")
+			action.Err = &problem.ServerError
+		}
+		return
+	}
+
+	err = action.HistoryQ().ReleaseSavepoint(batchSavepoint)
+	if err != nil {
+		action.Log.WithError(err).Error("Failed to release savepoint for batch")
+		action.Err = &problem.ServerError
+	}
+}
+
+// GetPreview returns nil, as batch consists of several changes. Use Previews to get all of them.
+func (action *BatchAction) GetPreview() *ActionPreview {
+	return nil
+}
+
+// Previews returns changes, which are going to be made by action. For batch returns changes of all items in order.
+func Previews(action AdminActionInterface) []ActionPreview {
+	batch, ok := action.(*BatchAction)
+	if !ok {
+		preview := action.GetPreview()
+		if preview == nil {
+			return nil
+		}
+		return []ActionPreview{*preview}
+	}
+
+	var result []ActionPreview
+	for _, item := range batch.Actions {
+		result = append(result, Previews(item)...)
+	}
+	return result
+}
+
+func isServerError(err error) bool {
+	prob, ok := err.(*problem.P)
+	return ok && prob.Type == problem.ServerError.Type
+}
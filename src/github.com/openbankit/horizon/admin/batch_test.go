@@ -0,0 +1,160 @@
+package admin
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/openbankit/horizon/db2/history"
+	"github.com/openbankit/horizon/log"
+	"github.com/openbankit/horizon/render/problem"
+	"github.com/openbankit/horizon/test"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestBatchAction(t *testing.T) {
+	tt := test.Start(t).Scenario("base")
+	defer tt.Finish()
+
+	log.DefaultLogger.Entry.Logger.Level = log.DebugLevel
+	historyQ := &history.Q{tt.HorizonRepo()}
+	account := test.NewTestConfig().BankMasterKey
+	provider := NewAdminActionProvider(historyQ)
+
+	Convey("Batch action", t, func() {
+		Convey("Invalid batch data", func() {
+			_, err := provider.CreateNewParser(map[string]interface{}{
+				string(SubjectBatch): map[string]interface{}{},
+			})
+			So(err, ShouldNotBeNil)
+			_, err = provider.CreateNewParser(map[string]interface{}{
+				string(SubjectBatch): []interface{}{"random_data"},
+			})
+			So(err, ShouldNotBeNil)
+			_, err = provider.CreateNewParser(map[string]interface{}{
+				string(SubjectBatch): []interface{}{
+					map[string]interface{}{
+						string(SubjectBatch): []interface{}{},
+					},
+				},
+			})
+			So(err, ShouldNotBeNil)
+		})
+		Convey("Empty batch", func() {
+			action, err := provider.CreateNewParser(map[string]interface{}{
+				string(SubjectBatch): []interface{}{},
+			})
+			So(err, ShouldBeNil)
+			action.Validate()
+			So(action.GetError(), ShouldBeInvalidField, "batch")
+		})
+		Convey("Invalid items are reported", func() {
+			action, err := provider.CreateNewParser(map[string]interface{}{
+				string(SubjectBatch): []interface{}{
+					map[string]interface{}{
+						string(SubjectTraits): map[string]interface{}{
+							"account_id":              account,
+							"block_incoming_payments": true,
+						},
+					},
+					map[string]interface{}{
+						string(SubjectCommission): map[string]interface{}{
+							"flat_fee": -1,
+						},
+					},
+					map[string]interface{}{
+						string(SubjectTraits): map[string]interface{}{
+							"account_id": "invalid_id",
+						},
+					},
+				},
+			})
+			So(err, ShouldBeNil)
+			action.Validate()
+			So(action.GetError(), ShouldBeInvalidField, "batch[1].flat_fee")
+			batch := action.(*BatchAction)
+			So(len(batch.Errors), ShouldEqual, 2)
+			So(batch.Errors[0].Index, ShouldEqual, 1)
+			So(batch.Errors[1].Index, ShouldEqual, 2)
+			So(batch.Errors[1].Err, ShouldBeInvalidField, "account_id")
+		})
+		Convey("Valid batch is applied", func() {
+			So(historyQ.Begin(), ShouldBeNil)
+			defer historyQ.Rollback()
+
+			action, err := provider.CreateNewParser(map[string]interface{}{
+				string(SubjectBatch): []interface{}{
+					map[string]interface{}{
+						string(SubjectTraits): map[string]interface{}{
+							"account_id":              account,
+							"block_incoming_payments": true,
+						},
+					},
+					map[string]interface{}{
+						string(SubjectCommission): map[string]interface{}{
+							"from":     account,
+							"flat_fee": 12,
+						},
+					},
+				},
+			})
+			So(err, ShouldBeNil)
+			action.Validate()
+			So(action.GetError(), ShouldBeNil)
+			previews := Previews(action)
+			So(len(previews), ShouldEqual, 2)
+			So(previews[0].Subject, ShouldEqual, SubjectTraits)
+			So(previews[1].Subject, ShouldEqual, SubjectCommission)
+
+			action.Apply()
+			So(action.GetError(), ShouldBeNil)
+
+			var storedAcc history.Account
+			err = historyQ.AccountByAddress(&storedAcc, account)
+			So(err, ShouldBeNil)
+			So(storedAcc.BlockIncomingPayments, ShouldBeTrue)
+			commission := action.(*BatchAction).Actions[1].(*SetCommissionAction).commission
+			stored, err := historyQ.CommissionByHash(commission.KeyHash, commission.ValidFrom, commission.ValidUntil)
+			So(err, ShouldBeNil)
+			So(stored, ShouldNotBeNil)
+			So(stored.FlatFee, ShouldEqual, 12)
+		})
+		Convey("Failed item rolls back batch", func() {
+			historyQM := &history.QMock{}
+			historyQM.On("Savepoint", batchSavepoint).Return(nil).Once()
+			historyQM.On("RollbackToSavepoint", batchSavepoint).Return(nil).Once()
+
+			first := &AdminActionMock{}
+			first.On("GetError").Return(nil)
+			second := &AdminActionMock{}
+			second.On("GetError").Return(nil).Once()
+			second.On("GetError").Return(&problem.ServerError).Once()
+			third := &AdminActionMock{}
+			third.On("GetError").Return(nil).Once()
+
+			action := NewBatchAction(NewAdminAction(nil, historyQM))
+			action.Actions = []AdminActionInterface{first, second, third}
+			action.Validate()
+			So(action.Err, ShouldBeNil)
+			action.Apply()
+			So(action.Err, ShouldNotBeNil)
+			itemErr, ok := action.Err.(*BatchItemError)
+			So(ok, ShouldBeTrue)
+			So(itemErr.Index, ShouldEqual, 1)
+			historyQM.AssertExpectations(t)
+			third.AssertExpectations(t)
+		})
+		Convey("Failed savepoint", func() {
+			historyQM := &history.QMock{}
+			historyQM.On("Savepoint", batchSavepoint).Return(errors.New("not in transaction")).Once()
+			item := &AdminActionMock{}
+			item.On("GetError").Return(nil)
+
+			action := NewBatchAction(NewAdminAction(nil, historyQM))
+			action.Actions = []AdminActionInterface{item}
+			action.Validate()
+			So(action.Err, ShouldBeNil)
+			action.Apply()
+			So(action.Err, ShouldEqual, &problem.ServerError)
+		})
+	})
+}
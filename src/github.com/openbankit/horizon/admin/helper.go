@@ -1,31 +1,36 @@
 package admin
 
 import (
-	"io/ioutil"
 	"bytes"
+	"io/ioutil"
 	"net/http"
+	"strings"
+
 	"github.com/openbankit/go-base/hash"
 )
 
 // Returns admin action signature's content
-func getAdminHelperSignatureBase(bodyString string, timeCreated string) string {
-	return "{method: 'post', body: '" + bodyString + "', timestamp: '" + timeCreated + "'}"
+func getAdminHelperSignatureBase(method string, bodyString string, timeCreated string) string {
+	return "{method: '" + strings.ToLower(method) + "', body: '" + bodyString + "', timestamp: '" + timeCreated + "'}"
 }
 
-// Returns content hash of request
+// Returns content hash of request. Body of POST request is signed, request URI - otherwise
 func GetContentsHash(request *http.Request, timeCreated string) [32]byte {
-	// Read the content
-	var bodyBytes []byte
-	if request.Body != nil {
-		bodyBytes, _ = ioutil.ReadAll(request.Body)
-		// Restore the io.ReadCloser to its original state
-		request.Body = ioutil.NopCloser(bytes.NewBuffer(bodyBytes))
+	var bodyString string
+	if request.Method == "POST" {
+		// Read the content
+		var bodyBytes []byte
+		if request.Body != nil {
+			bodyBytes, _ = ioutil.ReadAll(request.Body)
+			// Restore the io.ReadCloser to its original state
+			request.Body = ioutil.NopCloser(bytes.NewBuffer(bodyBytes))
+		}
+		bodyString = string(bodyBytes)
+	} else {
+		bodyString = request.URL.RequestURI()
 	}
 
-	// Use the content
-	bodyString := string(bodyBytes)
-
-	signatureBase := getAdminHelperSignatureBase(bodyString, timeCreated)
+	signatureBase := getAdminHelperSignatureBase(request.Method, bodyString, timeCreated)
 	hashBase := hash.Hash([]byte(signatureBase))
 
 	return hashBase
@@ -10,6 +10,10 @@ const (
 	SubjectAccountLimits              AdminActionSubject = "account_limits"
 	SubjectAsset                      AdminActionSubject = "asset"
 	SubjectMaxPaymentReversalDuration AdminActionSubject = "max_reversal_duration"
+	SubjectAccountTypeRestrictions    AdminActionSubject = "account_type_restrictions"
+	SubjectAccountTypeLimits          AdminActionSubject = "account_type_limits"
+	SubjectCommissionPayer            AdminActionSubject = "commission_payer"
+	SubjectBatch                      AdminActionSubject = "batch"
 )
 
 type InvalidFieldError struct {
@@ -0,0 +1,137 @@
+package admin
+
+import (
+	"strconv"
+
+	"github.com/go-errors/errors"
+	"github.com/openbankit/go-base/xdr"
+	"github.com/openbankit/horizon/db2/history"
+	"github.com/openbankit/horizon/render/problem"
+)
+
+// ManageAccountTypeRestrictionsAction allows or restricts payments from one account type to another
+type ManageAccountTypeRestrictionsAction struct {
+	AdminAction
+	fromType     xdr.AccountType
+	toType       xdr.AccountType
+	allowed      bool
+	isNew        bool
+	restrictions *history.AccountTypeRestrictions
+	before       *accountTypeRestrictions
+}
+
+// accountTypeRestrictions is a snapshot of account type restrictions stored in audit log. Key of restrictions
+// is account type of sender, value - list of allowed destination account types.
+type accountTypeRestrictions struct {
+	Restrictions map[string][]xdr.AccountType `json:"restrictions"`
+}
+
+func newAccountTypeRestrictionsSnapshot(restrictions map[xdr.AccountType][]xdr.AccountType) *accountTypeRestrictions {
+	result := accountTypeRestrictions{
+		Restrictions: make(map[string][]xdr.AccountType, len(restrictions)),
+	}
+	for from, to := range restrictions {
+		result.Restrictions[strconv.FormatInt(int64(from), 10)] = to
+	}
+	return &result
+}
+
+func NewManageAccountTypeRestrictionsAction(adminAction AdminAction) *ManageAccountTypeRestrictionsAction {
+	return &ManageAccountTypeRestrictionsAction{
+		AdminAction: adminAction,
+	}
+}
+
+func (action *ManageAccountTypeRestrictionsAction) Validate() {
+	action.loadParams()
+	if action.Err != nil {
+		return
+	}
+
+	stored, err := action.HistoryQ().OptionsByName(history.OPTIONS_ACCOUNT_TYPE_RESTRICTIONS)
+	if err != nil {
+		action.Log.WithError(err).Error("Failed to get account type restrictions")
+		action.Err = &problem.ServerError
+		return
+	}
+
+	if stored == nil {
+		action.isNew = true
+		action.restrictions = history.NewAccountTypeRestrictions()
+	} else {
+		action.restrictions = stored.AccountTypeRestrictions()
+	}
+
+	restrictions, err := action.restrictions.GetRestrictions()
+	if err != nil {
+		action.Log.WithError(err).Error("Failed to unmarshal account type restrictions")
+		action.Err = &problem.ServerError
+		return
+	}
+
+	if !action.isNew {
+		action.before = newAccountTypeRestrictionsSnapshot(restrictions)
+	}
+
+	restrictions[action.fromType] = action.updateAllowed(restrictions[action.fromType])
+	err = action.restrictions.SetRestrictions(restrictions)
+	if err != nil {
+		action.Log.WithError(err).Error("Failed to marshal account type restrictions")
+		action.Err = &problem.ServerError
+		return
+	}
+
+	action.setPreview(getActionPerformed(action.isNew, false), action.before, newAccountTypeRestrictionsSnapshot(restrictions))
+}
+
+func (action *ManageAccountTypeRestrictionsAction) Apply() {
+	if action.Err != nil {
+		return
+	}
+
+	option := history.Options(*action.restrictions)
+	var err error
+	if action.isNew {
+		err = action.HistoryQ().OptionsInsert(&option)
+	} else {
+		_, err = action.HistoryQ().OptionsUpdate(&option)
+	}
+
+	if err != nil {
+		action.Log.WithError(err).Error("Failed to insert/update account type restrictions")
+		action.Err = &problem.ServerError
+		return
+	}
+
+	action.recordAudit()
+}
+
+// updateAllowed returns list of destination types with toType added or removed
+func (action *ManageAccountTypeRestrictionsAction) updateAllowed(allowedTypes []xdr.AccountType) []xdr.AccountType {
+	result := make([]xdr.AccountType, 0, len(allowedTypes)+1)
+	for _, allowedType := range allowedTypes {
+		if allowedType != action.toType {
+			result = append(result, allowedType)
+		}
+	}
+
+	if action.allowed {
+		result = append(result, action.toType)
+	}
+	return result
+}
+
+func (action *ManageAccountTypeRestrictionsAction) loadParams() {
+	action.fromType = action.GetAccountType("from_type")
+	action.toType = action.GetAccountType("to_type")
+	allowed := action.GetOptionalBool("allowed")
+	if action.Err != nil {
+		return
+	}
+
+	if allowed == nil {
+		action.SetInvalidField("allowed", errors.New("Can't be empty"))
+		return
+	}
+	action.allowed = *allowed
+}
@@ -0,0 +1,99 @@
+package admin
+
+import (
+	"encoding/json"
+
+	"github.com/openbankit/go-base/keypair"
+	"github.com/openbankit/go-base/xdr"
+	"github.com/openbankit/horizon/db2/history"
+	"github.com/openbankit/horizon/log"
+	"github.com/openbankit/horizon/test"
+	. "github.com/smartystreets/goconvey/convey"
+	"strconv"
+	"testing"
+)
+
+func TestActionsManageAccountTypeRestrictions(t *testing.T) {
+	tt := test.Start(t).Scenario("base")
+	defer tt.Finish()
+
+	log.DefaultLogger.Entry.Logger.Level = log.DebugLevel
+	historyQ := &history.Q{tt.HorizonRepo()}
+	Convey("Manage account type restrictions", t, func() {
+		data := map[string]interface{}{
+			"from_type": strconv.Itoa(int(xdr.AccountTypeAccountMerchant)),
+			"to_type":   strconv.Itoa(int(xdr.AccountTypeAccountSettlementAgent)),
+			"allowed":   "false",
+		}
+		Convey("Invalid from type", func() {
+			data["from_type"] = "-1"
+			action := NewManageAccountTypeRestrictionsAction(NewAdminAction(data, historyQ))
+			action.Validate()
+			So(action.Err, ShouldBeInvalidField, "from_type")
+		})
+		Convey("Empty to type", func() {
+			delete(data, "to_type")
+			action := NewManageAccountTypeRestrictionsAction(NewAdminAction(data, historyQ))
+			action.Validate()
+			So(action.Err, ShouldBeInvalidField, "to_type")
+		})
+		Convey("Empty allowed", func() {
+			delete(data, "allowed")
+			action := NewManageAccountTypeRestrictionsAction(NewAdminAction(data, historyQ))
+			action.Validate()
+			So(action.Err, ShouldBeInvalidField, "allowed")
+		})
+		Convey("Restrict and allow", func() {
+			applyAccountTypeRestriction(data, historyQ, false)
+			data["allowed"] = "true"
+			applyAccountTypeRestriction(data, historyQ, true)
+			// allowing twice does not duplicate type
+			applyAccountTypeRestriction(data, historyQ, true)
+			stored, err := historyQ.OptionsByName(history.OPTIONS_ACCOUNT_TYPE_RESTRICTIONS)
+			So(err, ShouldBeNil)
+			restrictions, err := stored.AccountTypeRestrictions().GetRestrictions()
+			So(err, ShouldBeNil)
+			So(len(restrictions[xdr.AccountTypeAccountMerchant]), ShouldEqual, 4)
+		})
+		Convey("Audit log", func() {
+			actor, err := keypair.Random()
+			So(err, ShouldBeNil)
+			txHash := "9d1e2f3a4b5c6d7e8f9a0b1c2d3e4f5a6b7c8d9e0f1a2b3c4d5e6f7a8b9c0d1e"
+			applyAccountTypeRestriction(data, historyQ, false)
+			data["allowed"] = "true"
+			adminAction := NewAdminAction(data, historyQ)
+			adminAction.SetAuditInfo(SubjectAccountTypeRestrictions, actor.Address(), txHash)
+			action := NewManageAccountTypeRestrictionsAction(adminAction)
+			action.Validate()
+			So(action.Err, ShouldBeNil)
+			action.Apply()
+			So(action.Err, ShouldBeNil)
+
+			var entries []history.AuditLog
+			err = historyQ.AuditLogs().ForTransaction(txHash).Select(&entries)
+			So(err, ShouldBeNil)
+			So(len(entries), ShouldEqual, 1)
+
+			var before, after accountTypeRestrictions
+			So(json.Unmarshal([]byte(entries[0].Before), &before), ShouldBeNil)
+			So(json.Unmarshal([]byte(entries[0].After), &after), ShouldBeNil)
+			merchant := strconv.Itoa(int(xdr.AccountTypeAccountMerchant))
+			So(before.Restrictions[merchant], ShouldNotContain, xdr.AccountTypeAccountSettlementAgent)
+			So(after.Restrictions[merchant], ShouldContain, xdr.AccountTypeAccountSettlementAgent)
+		})
+	})
+}
+
+func applyAccountTypeRestriction(data map[string]interface{}, historyQ *history.Q, expected bool) {
+	action := NewManageAccountTypeRestrictionsAction(NewAdminAction(data, historyQ))
+	action.Validate()
+	So(action.Err, ShouldBeNil)
+	action.Apply()
+	So(action.Err, ShouldBeNil)
+	stored, err := historyQ.OptionsByName(history.OPTIONS_ACCOUNT_TYPE_RESTRICTIONS)
+	So(err, ShouldBeNil)
+	So(stored, ShouldNotBeNil)
+	isAllowed, err := stored.AccountTypeRestrictions().IsAllowed(xdr.AccountTypeAccountMerchant, xdr.AccountTypeAccountSettlementAgent)
+	So(err, ShouldBeNil)
+	So(isAllowed, ShouldEqual, expected)
+}
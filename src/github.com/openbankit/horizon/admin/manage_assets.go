@@ -2,6 +2,7 @@ package admin
 
 import (
 	"github.com/openbankit/go-base/xdr"
+	"github.com/openbankit/horizon/audit"
 	"github.com/openbankit/horizon/db2/history"
 	"github.com/openbankit/horizon/render/problem"
 	"database/sql"
@@ -14,6 +15,7 @@ type ManageAssetsAction struct {
 	delete      bool
 	isAnonymous bool
 	storedAsset history.Asset
+	before      *history.Asset
 }
 
 func NewManageAssetsAction(adminAction AdminAction) *ManageAssetsAction {
@@ -43,6 +45,11 @@ func (action *ManageAssetsAction) Validate() {
 		return
 	}
 
+	if !action.isNew {
+		action.before = new(history.Asset)
+		*action.before = action.storedAsset
+	}
+
 	var code, issuer string
 	var assetType xdr.AssetType
 	err = action.asset.Extract(&assetType, &code, &issuer)
@@ -55,6 +62,16 @@ func (action *ManageAssetsAction) Validate() {
 	action.storedAsset.Code = code
 	action.storedAsset.Issuer = issuer
 	action.storedAsset.IsAnonymous = action.isAnonymous
+
+	switch {
+	case action.delete:
+		action.setPreview(audit.ActionPerformedDelete, action.before, nil)
+	case action.isNew:
+		// pointer is used to have id of inserted asset in audit log
+		action.setPreview(audit.ActionPerformedInsert, nil, &action.storedAsset)
+	default:
+		action.setPreview(audit.ActionPerformedUpdate, action.before, &action.storedAsset)
+	}
 }
 
 func (action *ManageAssetsAction) Apply() {
@@ -64,15 +81,18 @@ func (action *ManageAssetsAction) Apply() {
 
 	if action.delete {
 		_, action.Err = action.HistoryQ().DeleteAsset(action.storedAsset.Id)
+		action.recordAudit()
 		return
 	}
 
 	if action.isNew {
 		action.Log.WithField("Asset", action.storedAsset).Warn("Inserting asset!")
 		action.Err = action.HistoryQ().InsertAsset(&action.storedAsset)
+		action.recordAudit()
 		return
 	}
 	_, action.Err = action.HistoryQ().UpdateAsset(&action.storedAsset)
+	action.recordAudit()
 }
 
 func (action *ManageAssetsAction) loadParams() {
@@ -10,6 +10,26 @@ import (
 type ManageMaxReversalDurationAction struct {
 	AdminAction
 	maxReversalDurationSec int64
+	isNew                  bool
+	option                 history.Options
+}
+
+// maxReversalDuration is a snapshot of max reversal duration option stored in audit log
+type maxReversalDuration struct {
+	MaxReversalDurationSec int64 `json:"max_reversal_duration"`
+}
+
+// newMaxReversalDurationSnapshot decodes stored option. Returns nil, if option is not set.
+func newMaxReversalDurationSnapshot(option *history.Options) (*maxReversalDuration, error) {
+	if option == nil {
+		return nil, nil
+	}
+
+	duration, err := option.MaxReversalDuration().GetMaxDuration()
+	if err != nil {
+		return nil, err
+	}
+	return &maxReversalDuration{MaxReversalDurationSec: int64(duration / time.Second)}, nil
 }
 
 func NewManageMaxReversalDurationAction(adminAction AdminAction) *ManageMaxReversalDurationAction {
@@ -23,27 +43,40 @@ func (action *ManageMaxReversalDurationAction) Validate() {
 	if action.Err != nil {
 		return
 	}
-}
 
-func (action *ManageMaxReversalDurationAction) Apply() {
-	if action.Err != nil {
+	stored, err := action.HistoryQ().OptionsByName(history.OPTIONS_MAX_REVERSAL_DURATION)
+	if err != nil {
+		action.Log.WithError(err).Error("Failed to check if max reversal duration option exists")
+		action.Err = &problem.ServerError
 		return
 	}
+	action.isNew = stored == nil
+
+	duration := history.NewMaxReversalDuration()
+	duration.SetMaxDuration(time.Duration(action.maxReversalDurationSec) * time.Second)
+	action.option = history.Options(*duration)
 
-	exists, err := action.exists()
+	before, err := newMaxReversalDurationSnapshot(stored)
 	if err != nil {
-		action.Log.WithError(err).Error("Failed to check if max reversal duration option exists")
+		action.Log.WithError(err).Error("Failed to parse stored max reversal duration")
 		action.Err = &problem.ServerError
 		return
 	}
+	action.setPreview(getActionPerformed(action.isNew, false), before, &maxReversalDuration{
+		MaxReversalDurationSec: action.maxReversalDurationSec,
+	})
+}
+
+func (action *ManageMaxReversalDurationAction) Apply() {
+	if action.Err != nil {
+		return
+	}
 
-	maxReversalDuration := history.NewMaxReversalDuration()
-	maxReversalDuration.SetMaxDuration(time.Duration(action.maxReversalDurationSec) * time.Second)
-	option := history.Options(*maxReversalDuration)
-	if exists {
-		_, err = action.HistoryQ().OptionsUpdate(&option)
+	var err error
+	if action.isNew {
+		err = action.HistoryQ().OptionsInsert(&action.option)
 	} else {
-		err = action.HistoryQ().OptionsInsert(&option)
+		_, err = action.HistoryQ().OptionsUpdate(&action.option)
 	}
 
 	if err != nil {
@@ -51,11 +84,8 @@ func (action *ManageMaxReversalDurationAction) Apply() {
 		action.Err = &problem.ServerError
 		return
 	}
-}
 
-func (action *ManageMaxReversalDurationAction) exists() (bool, error) {
-	stored, err := action.HistoryQ().OptionsByName(history.OPTIONS_MAX_REVERSAL_DURATION)
-	return stored != nil, err
+	action.recordAudit()
 }
 
 func (action *ManageMaxReversalDurationAction) loadParams() {
@@ -32,3 +32,10 @@ func (m *AdminActionMock) Apply() {
 	return
 }
 
+func (m *AdminActionMock) GetPreview() *ActionPreview {
+	preview := m.Called().Get(0)
+	if preview == nil {
+		return nil
+	}
+	return preview.(*ActionPreview)
+}
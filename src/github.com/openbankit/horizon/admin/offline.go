@@ -0,0 +1,28 @@
+package admin
+
+import (
+	"github.com/go-errors/errors"
+)
+
+// paramsLoader is implemented by admin actions, which parse raw data without accessing db
+type paramsLoader interface {
+	loadParams()
+	GetError() error
+}
+
+// ValidateParams parses admin action data with the same parser as Validate does, but without db checks.
+// Used to check administrative operations built offline.
+func ValidateParams(data map[string]interface{}) error {
+	action, err := NewAdminActionProvider(nil).CreateNewParser(data)
+	if err != nil {
+		return err
+	}
+
+	loader, ok := action.(paramsLoader)
+	if !ok {
+		return errors.New("admin action can not be validated offline")
+	}
+
+	loader.loadParams()
+	return loader.GetError()
+}
@@ -0,0 +1,52 @@
+package admin
+
+import (
+	"testing"
+
+	"github.com/openbankit/go-base/keypair"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestValidateParams(t *testing.T) {
+	Convey("Validate params offline", t, func() {
+		account, err := keypair.Random()
+		So(err, ShouldBeNil)
+		Convey("Unknown subject", func() {
+			err := ValidateParams(map[string]interface{}{
+				"unknown": map[string]interface{}{},
+			})
+			So(err, ShouldNotBeNil)
+		})
+		Convey("Invalid field", func() {
+			err := ValidateParams(map[string]interface{}{
+				string(SubjectAccountLimits): map[string]interface{}{
+					"account_id":    account.Address(),
+					"asset_code":    "UAH",
+					"daily_max_out": "ten",
+				},
+			})
+			So(err, ShouldBeInvalidField, "daily_max_out")
+		})
+		Convey("Valid", func() {
+			err := ValidateParams(map[string]interface{}{
+				string(SubjectTraits): map[string]interface{}{
+					"account_id":              account.Address(),
+					"block_incoming_payments": "true",
+				},
+			})
+			So(err, ShouldBeNil)
+			err = ValidateParams(map[string]interface{}{
+				string(SubjectMaxPaymentReversalDuration): map[string]interface{}{
+					"max_reversal_duration": "3600",
+				},
+			})
+			So(err, ShouldBeNil)
+		})
+		Convey("Batch can not be validated offline", func() {
+			err := ValidateParams(map[string]interface{}{
+				string(SubjectBatch): []interface{}{},
+			})
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
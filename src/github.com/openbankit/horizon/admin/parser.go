@@ -1,10 +1,16 @@
 package admin
 
 import (
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/openbankit/go-base/keypair"
 	"github.com/openbankit/go-base/xdr"
+	"github.com/openbankit/horizon/audit"
 	"github.com/openbankit/horizon/db2/history"
 	"github.com/openbankit/horizon/helpers"
 	"github.com/openbankit/horizon/log"
+	"github.com/openbankit/horizon/render/problem"
 	"github.com/spf13/cast"
 )
 
@@ -15,6 +21,16 @@ type AdminActionInterface interface {
 	Validate()
 	// applies action
 	Apply()
+	// returns preview of changes, which will be made by Apply. Available after successful Validate
+	GetPreview() *ActionPreview
+}
+
+// ActionPreview describes change of the subject, which admin action is going to make
+type ActionPreview struct {
+	Subject AdminActionSubject    `json:"subject"`
+	Action  audit.ActionPerformed `json:"action"`
+	Before  interface{}           `json:"before"`
+	After   interface{}           `json:"after"`
 }
 
 type AdminAction struct {
@@ -23,6 +39,14 @@ type AdminAction struct {
 	Log     *log.Entry
 
 	hq history.QInterface
+
+	// subject, actor and txHash are used to record changes into audit log
+	subject AdminActionSubject
+	actor   string
+	txHash  string
+
+	// preview of the changes, which are going to be applied
+	preview *ActionPreview
 }
 
 func (action *AdminAction) HistoryQ() history.QInterface {
@@ -33,6 +57,14 @@ func (action *AdminAction) GetError() error {
 	return action.Err
 }
 
+// GetPreview returns changes, which are going to be made by action
+func (action *AdminAction) GetPreview() *ActionPreview {
+	if action.Err != nil {
+		return nil
+	}
+	return action.preview
+}
+
 func NewAdminAction(data map[string]interface{}, hq history.QInterface) AdminAction {
 	return AdminAction{
 		rawData: data,
@@ -41,6 +73,80 @@ func NewAdminAction(data map[string]interface{}, hq history.QInterface) AdminAct
 	}
 }
 
+// SetAuditInfo sets source account of administrative operation and hash of transaction it was submitted in.
+// Changes made by action are recorded into audit log only if audit info is set.
+func (action *AdminAction) SetAuditInfo(subject AdminActionSubject, actor, txHash string) {
+	action.subject = subject
+	action.actor = actor
+	action.txHash = txHash
+}
+
+// setPreview stores before/after snapshot of the subject, which is going to be changed.
+// Must be called from Validate.
+func (action *AdminAction) setPreview(performed audit.ActionPerformed, before, after interface{}) {
+	if action.Err != nil {
+		return
+	}
+
+	action.preview = &ActionPreview{
+		Subject: action.subject,
+		Action:  performed,
+		Before:  before,
+		After:   after,
+	}
+}
+
+// recordAudit writes before/after snapshot of the changed subject into audit log
+func (action *AdminAction) recordAudit() {
+	if action.Err != nil {
+		return
+	}
+
+	if action.preview == nil {
+		action.Log.Error("Preview is not set. Skipping audit log entry")
+		return
+	}
+
+	if action.actor == "" {
+		action.Log.Debug("Audit info is not set. Skipping audit log entry")
+		return
+	}
+
+	actor, err := keypair.Parse(action.actor)
+	if err != nil {
+		action.Log.WithError(err).WithField("actor", action.actor).Error("Failed to parse actor")
+		action.Err = &problem.ServerError
+		return
+	}
+
+	info := audit.AdminActionInfo{
+		ActorPublicKey:  actor,
+		Subject:         audit.AdminActionSubject(action.subject),
+		ActionPerformed: action.preview.Action,
+		Meta:            action.rawData,
+		TxHash:          action.txHash,
+		Before:          action.preview.Before,
+		After:           action.preview.After,
+	}
+	err = action.hq.CreateAuditLogEntry(info.ToHistory())
+	if err != nil {
+		action.Log.WithError(err).Error("Failed to create audit log entry")
+		action.Err = &problem.ServerError
+	}
+}
+
+// getActionPerformed returns audit action for subject, which is created, updated or deleted
+func getActionPerformed(isNew, isDelete bool) audit.ActionPerformed {
+	switch {
+	case isNew:
+		return audit.ActionPerformedInsert
+	case isDelete:
+		return audit.ActionPerformedDelete
+	default:
+		return audit.ActionPerformedUpdate
+	}
+}
+
 func (p *AdminAction) SetInvalidField(name string, reason error) {
 	p.Err = InvalidField(name, reason)
 }
@@ -73,6 +179,24 @@ func (p *AdminAction) GetOptionalRawAccountType(name string) *int32 {
 	return helpers.GetOptionalRawAccountType(p, name)
 }
 
+func (p *AdminAction) GetOptionalAccountType(name string) *xdr.AccountType {
+	return helpers.GetOptionalAccountType(p, name)
+}
+
+// GetAccountType returns account type. Sets invalid field error, if it's empty
+func (p *AdminAction) GetAccountType(name string) xdr.AccountType {
+	accountType := p.GetOptionalAccountType(name)
+	if p.Err != nil {
+		return xdr.AccountType(0)
+	}
+
+	if accountType == nil {
+		p.SetInvalidField(name, errors.New("Can't be empty"))
+		return xdr.AccountType(0)
+	}
+	return *accountType
+}
+
 func (p *AdminAction) GetAsset(prefix string) xdr.Asset {
 	return helpers.GetAsset(p, prefix)
 }
@@ -81,6 +205,14 @@ func (p *AdminAction) GetInt64(name string) int64 {
 	return helpers.GetInt64(p, name)
 }
 
+// GetInt64OrDefault returns defaultValue, if field is not set
+func (p *AdminAction) GetInt64OrDefault(name string, defaultValue int64) int64 {
+	if _, ok := p.rawData[name]; !ok {
+		return defaultValue
+	}
+	return p.GetInt64(name)
+}
+
 func (p *AdminAction) GetBool(name string) bool {
 	return helpers.GetBool(p, name)
 }
@@ -97,6 +229,10 @@ func (p *AdminAction) GetOptionalAsset(prefix string) *xdr.Asset {
 	return helpers.GetOptionalAsset(p, prefix)
 }
 
+func (p *AdminAction) GetOptionalTime(name string) *time.Time {
+	return helpers.GetOptionalTime(p, name)
+}
+
 func (p *AdminAction) GetOptionalAmount(name string) int64 {
 	return int64(helpers.GetOptionalAmount(p, name))
 }
@@ -0,0 +1,111 @@
+package admin
+
+import (
+	"database/sql"
+	"github.com/go-errors/errors"
+	"github.com/openbankit/horizon/audit"
+	"github.com/openbankit/horizon/db2/history"
+	"github.com/openbankit/horizon/render/problem"
+)
+
+// SetAccountTypeLimitsAction sets or deletes default limits for all accounts of specified type
+type SetAccountTypeLimitsAction struct {
+	AdminAction
+	Limits history.AccountTypeLimits
+	delete bool
+	isNew  bool
+	before *history.AccountTypeLimits
+}
+
+func NewSetAccountTypeLimitsAction(adminAction AdminAction) *SetAccountTypeLimitsAction {
+	return &SetAccountTypeLimitsAction{
+		AdminAction: adminAction,
+	}
+}
+
+func (action *SetAccountTypeLimitsAction) Validate() {
+	action.loadParams()
+	if action.HasError() {
+		return
+	}
+
+	stored := new(history.AccountTypeLimits)
+	err := action.HistoryQ().GetAccountTypeLimitsForCounterparty(stored, action.Limits.AccountType, action.Limits.AssetCode,
+		action.Limits.CounterpartyType)
+	if err == nil {
+		action.before = stored
+	} else {
+		if err != sql.ErrNoRows {
+			action.Log.WithStack(err).WithError(err).Error("Failed to get account type limits")
+			action.Err = &problem.ServerError
+			return
+		}
+		action.isNew = true
+	}
+
+	if action.isNew && action.delete {
+		action.Err = &problem.NotFound
+		return
+	}
+
+	if action.delete {
+		action.setPreview(audit.ActionPerformedDelete, action.before, nil)
+		return
+	}
+	action.setPreview(getActionPerformed(action.isNew, false), action.before, action.Limits)
+}
+
+func (action *SetAccountTypeLimitsAction) Apply() {
+	if action.Err != nil {
+		return
+	}
+
+	var err error
+	switch {
+	case action.delete:
+		_, err = action.HistoryQ().DeleteAccountTypeLimits(action.Limits.AccountType, action.Limits.AssetCode,
+			action.Limits.CounterpartyType)
+	case action.isNew:
+		err = action.HistoryQ().CreateAccountTypeLimits(action.Limits)
+	default:
+		err = action.HistoryQ().UpdateAccountTypeLimits(action.Limits)
+	}
+
+	if err != nil {
+		action.Log.WithStack(err).WithField("is_new", action.isNew).WithError(err).Error("Failed to persist account type limits")
+		action.Err = &problem.ServerError
+		return
+	}
+
+	action.recordAudit()
+}
+
+func (action *SetAccountTypeLimitsAction) loadParams() {
+	action.Limits.AccountType = action.GetAccountType("account_type")
+	action.Limits.AssetCode = action.GetString("asset_code")
+	action.delete = action.GetBool("delete")
+	action.Limits.MaxOperationOut = action.GetInt64("max_operation_out")
+	action.Limits.DailyMaxOut = action.GetInt64("daily_max_out")
+	action.Limits.WeeklyMaxOut = action.GetInt64OrDefault("weekly_max_out", -1)
+	action.Limits.MonthlyMaxOut = action.GetInt64("monthly_max_out")
+	action.Limits.AnnualMaxOut = action.GetInt64OrDefault("annual_max_out", -1)
+	action.Limits.MaxOperationIn = action.GetInt64("max_operation_in")
+	action.Limits.DailyMaxIn = action.GetInt64("daily_max_in")
+	action.Limits.WeeklyMaxIn = action.GetInt64OrDefault("weekly_max_in", -1)
+	action.Limits.MonthlyMaxIn = action.GetInt64("monthly_max_in")
+	action.Limits.AnnualMaxIn = action.GetInt64OrDefault("annual_max_in", -1)
+
+	// limits are applied to payments with all counterparties, if counterparty type is not set
+	action.Limits.CounterpartyType = history.AnyCounterpartyType
+	counterpartyType := action.GetOptionalAccountType("counterparty_type")
+	if counterpartyType != nil {
+		action.Limits.CounterpartyType = int16(*counterpartyType)
+	}
+	if action.Err != nil {
+		return
+	}
+
+	if action.Limits.AssetCode == "" {
+		action.SetInvalidField("asset_code", errors.New("Can't be empty"))
+	}
+}
@@ -0,0 +1,128 @@
+package admin
+
+import (
+	"github.com/openbankit/go-base/xdr"
+	"github.com/openbankit/horizon/db2/history"
+	"github.com/openbankit/horizon/log"
+	"github.com/openbankit/horizon/render/problem"
+	"github.com/openbankit/horizon/test"
+	. "github.com/smartystreets/goconvey/convey"
+	"strconv"
+	"testing"
+)
+
+func TestActionsSetAccountTypeLimits(t *testing.T) {
+	tt := test.Start(t).Scenario("base")
+	defer tt.Finish()
+
+	log.DefaultLogger.Entry.Logger.Level = log.DebugLevel
+	historyQ := &history.Q{tt.HorizonRepo()}
+	Convey("Set account type limits", t, func() {
+		expected := history.AccountTypeLimits{
+			AccountType:      xdr.AccountTypeAccountRegisteredUser,
+			AssetCode:        "EUAH",
+			CounterpartyType: history.AnyCounterpartyType,
+			MaxOperationOut:  1,
+			DailyMaxOut:      2,
+			WeeklyMaxOut:     -1,
+			MonthlyMaxOut:    3,
+			AnnualMaxOut:     -1,
+			MaxOperationIn:   5,
+			DailyMaxIn:       7,
+			WeeklyMaxIn:      -1,
+			MonthlyMaxIn:     11,
+			AnnualMaxIn:      -1,
+		}
+		data := accountTypeLimitsToMap(expected)
+		Convey("Invalid account type", func() {
+			data["account_type"] = "-1"
+			action := NewSetAccountTypeLimitsAction(NewAdminAction(data, historyQ))
+			action.Validate()
+			So(action.Err, ShouldBeInvalidField, "account_type")
+		})
+		Convey("Empty asset code", func() {
+			delete(data, "asset_code")
+			action := NewSetAccountTypeLimitsAction(NewAdminAction(data, historyQ))
+			action.Validate()
+			So(action.Err, ShouldBeInvalidField, "asset_code")
+		})
+		Convey("Delete nonexisting limits", func() {
+			data["delete"] = "true"
+			action := NewSetAccountTypeLimitsAction(NewAdminAction(data, historyQ))
+			action.Validate()
+			So(action.Err, problem.ShouldBeProblem, problem.NotFound)
+		})
+		Convey("Happy path", func() {
+			// create
+			applyAccountTypeLimits(data, historyQ)
+			var stored history.AccountTypeLimits
+			err := historyQ.GetAccountTypeLimits(&stored, expected.AccountType, expected.AssetCode)
+			So(err, ShouldBeNil)
+			So(stored, ShouldResemble, expected)
+			// update
+			expected.DailyMaxOut = 13
+			expected.WeeklyMaxOut = 17
+			expected.AnnualMaxIn = 19
+			data = accountTypeLimitsToMap(expected)
+			applyAccountTypeLimits(data, historyQ)
+			err = historyQ.GetAccountTypeLimits(&stored, expected.AccountType, expected.AssetCode)
+			So(err, ShouldBeNil)
+			So(stored, ShouldResemble, expected)
+			// delete
+			data["delete"] = "true"
+			applyAccountTypeLimits(data, historyQ)
+			err = historyQ.GetAccountTypeLimits(&stored, expected.AccountType, expected.AssetCode)
+			So(historyQ.NoRows(err), ShouldBeTrue)
+		})
+		Convey("Counterparty limits", func() {
+			applyAccountTypeLimits(data, historyQ)
+			counterpartyLimits := expected
+			counterpartyLimits.CounterpartyType = int16(xdr.AccountTypeAccountMerchant)
+			counterpartyLimits.DailyMaxOut = 23
+			data = accountTypeLimitsToMap(counterpartyLimits)
+			data["counterparty_type"] = strconv.Itoa(int(counterpartyLimits.CounterpartyType))
+			applyAccountTypeLimits(data, historyQ)
+
+			var stored history.AccountTypeLimits
+			err := historyQ.GetAccountTypeLimitsForCounterparty(&stored, expected.AccountType, expected.AssetCode,
+				counterpartyLimits.CounterpartyType)
+			So(err, ShouldBeNil)
+			So(stored, ShouldResemble, counterpartyLimits)
+			// limits applied to all counterparties are not changed
+			err = historyQ.GetAccountTypeLimits(&stored, expected.AccountType, expected.AssetCode)
+			So(err, ShouldBeNil)
+			So(stored, ShouldResemble, expected)
+
+			data["delete"] = "true"
+			applyAccountTypeLimits(data, historyQ)
+			err = historyQ.GetAccountTypeLimitsForCounterparty(&stored, expected.AccountType, expected.AssetCode,
+				counterpartyLimits.CounterpartyType)
+			So(historyQ.NoRows(err), ShouldBeTrue)
+		})
+	})
+}
+
+func accountTypeLimitsToMap(l history.AccountTypeLimits) map[string]interface{} {
+	return map[string]interface{}{
+		"account_type":      strconv.Itoa(int(l.AccountType)),
+		"asset_code":        l.AssetCode,
+		"max_operation_out": strconv.Itoa(int(l.MaxOperationOut)),
+		"daily_max_out":     strconv.Itoa(int(l.DailyMaxOut)),
+		"weekly_max_out":    strconv.Itoa(int(l.WeeklyMaxOut)),
+		"monthly_max_out":   strconv.Itoa(int(l.MonthlyMaxOut)),
+		"annual_max_out":    strconv.Itoa(int(l.AnnualMaxOut)),
+		"max_operation_in":  strconv.Itoa(int(l.MaxOperationIn)),
+		"daily_max_in":      strconv.Itoa(int(l.DailyMaxIn)),
+		"weekly_max_in":     strconv.Itoa(int(l.WeeklyMaxIn)),
+		"monthly_max_in":    strconv.Itoa(int(l.MonthlyMaxIn)),
+		"annual_max_in":     strconv.Itoa(int(l.AnnualMaxIn)),
+	}
+}
+
+func applyAccountTypeLimits(data map[string]interface{}, historyQ *history.Q) {
+	action := NewSetAccountTypeLimitsAction(NewAdminAction(data, historyQ))
+	action.Validate()
+	So(action.Err, ShouldBeNil)
+	action.Apply()
+	So(action.Err, ShouldBeNil)
+}
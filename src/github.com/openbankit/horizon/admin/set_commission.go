@@ -2,9 +2,11 @@ package admin
 
 import (
 	"github.com/openbankit/horizon/assets"
+	"github.com/openbankit/horizon/audit"
 	"github.com/openbankit/horizon/db2/history"
 	"github.com/openbankit/horizon/render/problem"
 	"errors"
+	"time"
 )
 
 type SetCommissionAction struct {
@@ -12,9 +14,15 @@ type SetCommissionAction struct {
 	CommissionKey history.CommissionKey
 	FlatFee       int64
 	PercentFee    int64
+	MinFee        int64
+	MaxFee        int64
+	Tiers         []history.CommissionTier
+	ValidFrom     *time.Time
+	ValidUntil    *time.Time
 	Delete        bool
 	commission    *history.Commission
-	isNew bool
+	stored        *history.Commission
+	isNew         bool
 }
 
 func NewSetCommissionAction(adminAction AdminAction) *SetCommissionAction {
@@ -36,21 +44,40 @@ func (action *SetCommissionAction) Validate() {
 		action.Err = errors.New("invalid commission_key")
 		return
 	}
+	action.commission.MinFee = action.MinFee
+	action.commission.MaxFee = action.MaxFee
+	action.commission.ValidFrom = action.ValidFrom
+	action.commission.ValidUntil = action.ValidUntil
+	err = action.commission.SetTiers(action.Tiers)
+	if err != nil {
+		action.Log.WithError(err).Error("Failed to marshal commission tiers")
+		action.Err = &problem.ServerError
+		return
+	}
 
-	stored, err := action.HistoryQ().CommissionByHash(action.commission.KeyHash)
+	action.stored, err = action.HistoryQ().CommissionByHash(action.commission.KeyHash, action.ValidFrom, action.ValidUntil)
 	if err != nil {
 		action.Log.WithStack(err).WithError(err).Error("Failed to get commission by id")
 		action.Err = &problem.ServerError
 		return
 	}
 
-	if stored == nil {
+	if action.stored == nil {
 		action.isNew = true
 		if action.Delete {
 			action.Err = &problem.NotFound
 			return
 		}
+		action.setPreview(audit.ActionPerformedInsert, nil, action.commission)
+		return
 	}
+	action.commission.ID = action.stored.ID
+
+	if action.Delete {
+		action.setPreview(audit.ActionPerformedDelete, action.stored, nil)
+		return
+	}
+	action.setPreview(audit.ActionPerformedUpdate, action.stored, action.commission)
 }
 
 func (action *SetCommissionAction) Apply() {
@@ -66,13 +93,15 @@ func (action *SetCommissionAction) Apply() {
 		if err != nil {
 			action.Log.WithField("commission", action.commission).WithError(err).Error("Failed to insert new commission")
 			action.Err = &problem.ServerError
+			return
 		}
+		action.recordAudit()
 		return
 	}
 
 	var updated bool
 	if action.Delete {
-		updated, err = action.HistoryQ().DeleteCommission(action.commission.KeyHash)
+		updated, err = action.HistoryQ().DeleteCommission(action.commission.ID)
 	} else {
 		action.Log.WithField("commission", action.commission).Debug("Trying to update commission")
 		updated, err = action.HistoryQ().UpdateCommission(action.commission)
@@ -86,7 +115,10 @@ func (action *SetCommissionAction) Apply() {
 
 	if !updated {
 		action.Err = &problem.NotFound
+		return
 	}
+
+	action.recordAudit()
 }
 
 func (action *SetCommissionAction) loadParams() {
@@ -109,5 +141,71 @@ func (action *SetCommissionAction) loadParams() {
 		action.SetInvalidField("percent_fee", errors.New("percent_fee can not be negative"))
 		return
 	}
+	action.MinFee = action.GetInt64("min_fee")
+	if action.MinFee < 0 {
+		action.SetInvalidField("min_fee", errors.New("min_fee can not be negative"))
+		return
+	}
+	action.MaxFee = action.GetInt64("max_fee")
+	if action.MaxFee < 0 {
+		action.SetInvalidField("max_fee", errors.New("max_fee can not be negative"))
+		return
+	}
+	if action.MaxFee != 0 && action.MaxFee < action.MinFee {
+		action.SetInvalidField("max_fee", errors.New("max_fee can not be less than min_fee"))
+		return
+	}
+	action.loadTiers()
+	action.ValidFrom = action.GetOptionalTime("valid_from")
+	action.ValidUntil = action.GetOptionalTime("valid_until")
+	if action.ValidFrom != nil && action.ValidUntil != nil && !action.ValidUntil.After(*action.ValidFrom) {
+		action.SetInvalidField("valid_until", errors.New("valid_until must be after valid_from"))
+		return
+	}
 	action.Delete = action.GetBool("delete")
 }
+
+// loadTiers parses list of tiers, each tier must have from_amount and percent_fee
+func (action *SetCommissionAction) loadTiers() {
+	if action.Err != nil {
+		return
+	}
+	rawTiers, ok := action.rawData["tiers"]
+	if !ok || rawTiers == nil {
+		return
+	}
+	tiersList, ok := rawTiers.([]interface{})
+	if !ok {
+		action.SetInvalidField("tiers", errors.New("tiers must be list"))
+		return
+	}
+	action.Tiers = make([]history.CommissionTier, len(tiersList))
+	usedAmounts := make(map[int64]bool, len(tiersList))
+	for i, rawTier := range tiersList {
+		tierData, ok := rawTier.(map[string]interface{})
+		if !ok {
+			action.SetInvalidField("tiers", errors.New("tier must be object"))
+			return
+		}
+		tierAction := NewAdminAction(tierData, action.hq)
+		action.Tiers[i].FromAmount = tierAction.GetInt64("from_amount")
+		action.Tiers[i].PercentFee = tierAction.GetInt64("percent_fee")
+		if tierAction.Err != nil {
+			action.SetInvalidField("tiers", tierAction.Err)
+			return
+		}
+		if action.Tiers[i].FromAmount <= 0 {
+			action.SetInvalidField("tiers", errors.New("from_amount must be positive"))
+			return
+		}
+		if action.Tiers[i].PercentFee < 0 {
+			action.SetInvalidField("tiers", errors.New("percent_fee can not be negative"))
+			return
+		}
+		if usedAmounts[action.Tiers[i].FromAmount] {
+			action.SetInvalidField("tiers", errors.New("from_amount must be unique"))
+			return
+		}
+		usedAmounts[action.Tiers[i].FromAmount] = true
+	}
+}
@@ -0,0 +1,111 @@
+package admin
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/openbankit/horizon/audit"
+	"github.com/openbankit/horizon/db2/history"
+	"github.com/openbankit/horizon/render/problem"
+)
+
+// Values of payer, which are not addresses
+const (
+	// CommissionPayerSender - commissions of payments are paid by senders
+	CommissionPayerSender = "sender"
+	// CommissionPayerDestination - merchant sponsors commissions of payments to it
+	CommissionPayerDestination = "destination"
+)
+
+// SetCommissionPayerAction designates account, which must sponsor commissions of payments to merchant.
+// Payer can be the merchant itself or a third account.
+type SetCommissionPayerAction struct {
+	AdminAction
+	Address string
+	Payer   string
+
+	account history.Account
+	before  commissionPayer
+}
+
+// commissionPayer is a snapshot of merchant's commission payer stored in audit log
+type commissionPayer struct {
+	Address         string `json:"account_id"`
+	CommissionPayer string `json:"commission_payer"`
+}
+
+func NewSetCommissionPayerAction(adminAction AdminAction) *SetCommissionPayerAction {
+	return &SetCommissionPayerAction{
+		AdminAction: adminAction,
+	}
+}
+
+func (action *SetCommissionPayerAction) Validate() {
+	action.loadParams()
+	if action.Err != nil {
+		return
+	}
+
+	err := action.HistoryQ().AccountByAddress(&action.account, action.Address)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			action.Log.WithStack(err).WithError(err).Error("Failed to get account")
+			action.Err = &problem.ServerError
+			return
+		}
+		action.Err = &problem.NotFound
+		return
+	}
+
+	if action.Payer != "" && action.Payer != action.Address {
+		var payer history.Account
+		err = action.HistoryQ().AccountByAddress(&payer, action.Payer)
+		if err != nil {
+			if err != sql.ErrNoRows {
+				action.Log.WithStack(err).WithError(err).Error("Failed to get payer account")
+				action.Err = &problem.ServerError
+				return
+			}
+			action.SetInvalidField("payer", errors.New("Account does not exist"))
+			return
+		}
+	}
+
+	action.before = commissionPayer{
+		Address:         action.account.Address,
+		CommissionPayer: action.account.CommissionPayer,
+	}
+	action.account.CommissionPayer = action.Payer
+	action.setPreview(audit.ActionPerformedUpdate, action.before, commissionPayer{
+		Address:         action.account.Address,
+		CommissionPayer: action.account.CommissionPayer,
+	})
+}
+
+func (action *SetCommissionPayerAction) Apply() {
+	if action.Err != nil {
+		return
+	}
+
+	action.Err = action.HistoryQ().AccountUpdate(&action.account)
+	action.recordAudit()
+}
+
+func (action *SetCommissionPayerAction) loadParams() {
+	action.Address = action.GetAddress("account_id")
+	payer := action.GetString("payer")
+	if action.Err != nil {
+		return
+	}
+
+	switch payer {
+	case "":
+		action.SetInvalidField("payer", errors.New("Can't be empty"))
+	case CommissionPayerSender:
+		action.Payer = ""
+	case CommissionPayerDestination:
+		action.Payer = action.Address
+	default:
+		action.Payer = action.GetAddress("payer")
+	}
+}
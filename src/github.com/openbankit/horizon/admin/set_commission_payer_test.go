@@ -0,0 +1,125 @@
+package admin
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/openbankit/go-base/keypair"
+	"github.com/openbankit/horizon/db2/history"
+	"github.com/openbankit/horizon/render/problem"
+	"github.com/openbankit/horizon/test"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestActionsSetCommissionPayer(t *testing.T) {
+	tt := test.Start(t).Scenario("base")
+	defer tt.Finish()
+
+	historyQ := &history.Q{tt.HorizonRepo()}
+	merchant := "GCO5BZT5V3N3SK2CD5UKDSEQJBYFSIMYDV2B75SLKWEXLRYF5GNORYCG"
+	sponsor := test.NewTestConfig().BankMasterKey
+
+	newAction := func(data map[string]interface{}) *SetCommissionPayerAction {
+		return NewSetCommissionPayerAction(NewAdminAction(data, historyQ))
+	}
+
+	storedPayer := func() string {
+		var stored history.Account
+		err := historyQ.AccountByAddress(&stored, merchant)
+		So(err, ShouldBeNil)
+		return stored.CommissionPayer
+	}
+
+	Convey("Invalid params", t, func() {
+		Convey("Invalid account", func() {
+			action := newAction(map[string]interface{}{
+				"account_id": "invalid_id",
+				"payer":      CommissionPayerDestination,
+			})
+			action.Validate()
+			So(action.Err, ShouldBeInvalidField, "account_id")
+		})
+		Convey("Empty payer", func() {
+			action := newAction(map[string]interface{}{
+				"account_id": merchant,
+			})
+			action.Validate()
+			So(action.Err, ShouldBeInvalidField, "payer")
+		})
+		Convey("Invalid payer", func() {
+			action := newAction(map[string]interface{}{
+				"account_id": merchant,
+				"payer":      "receiver",
+			})
+			action.Validate()
+			So(action.Err, ShouldBeInvalidField, "payer")
+		})
+		Convey("Payer does not exist", func() {
+			payer, err := keypair.Random()
+			So(err, ShouldBeNil)
+			action := newAction(map[string]interface{}{
+				"account_id": merchant,
+				"payer":      payer.Address(),
+			})
+			action.Validate()
+			So(action.Err, ShouldBeInvalidField, "payer")
+		})
+		Convey("Account does not exist", func() {
+			account, err := keypair.Random()
+			So(err, ShouldBeNil)
+			action := newAction(map[string]interface{}{
+				"account_id": account.Address(),
+				"payer":      CommissionPayerDestination,
+			})
+			action.Validate()
+			So(action.Err, ShouldEqual, &problem.NotFound)
+		})
+	})
+	Convey("Set commission payer", t, func() {
+		for _, testCase := range []struct {
+			payer    string
+			expected string
+		}{
+			{CommissionPayerDestination, merchant},
+			{sponsor, sponsor},
+			{CommissionPayerSender, ""},
+		} {
+			action := newAction(map[string]interface{}{
+				"account_id": merchant,
+				"payer":      testCase.payer,
+			})
+			action.Validate()
+			So(action.Err, ShouldBeNil)
+			action.Apply()
+			So(action.Err, ShouldBeNil)
+			So(storedPayer(), ShouldEqual, testCase.expected)
+		}
+	})
+	Convey("Audit log", t, func() {
+		actor, err := keypair.Random()
+		So(err, ShouldBeNil)
+		txHash := "4c6b7e0a1f0d5f7e9a0f8c3b5e2d1a6c7b8e9f0a1b2c3d4e5f6a7b8c9d0e1f2a"
+		adminAction := NewAdminAction(map[string]interface{}{
+			"account_id": merchant,
+			"payer":      CommissionPayerDestination,
+		}, historyQ)
+		adminAction.SetAuditInfo(SubjectCommissionPayer, actor.Address(), txHash)
+		action := NewSetCommissionPayerAction(adminAction)
+		action.Validate()
+		So(action.Err, ShouldBeNil)
+		action.Apply()
+		So(action.Err, ShouldBeNil)
+
+		var entries []history.AuditLog
+		err = historyQ.AuditLogs().ForTransaction(txHash).Select(&entries)
+		So(err, ShouldBeNil)
+		So(len(entries), ShouldEqual, 1)
+		So(entries[0].Subject, ShouldEqual, string(SubjectCommissionPayer))
+
+		var before, after commissionPayer
+		So(json.Unmarshal([]byte(entries[0].Before), &before), ShouldBeNil)
+		So(json.Unmarshal([]byte(entries[0].After), &after), ShouldBeNil)
+		So(before.CommissionPayer, ShouldEqual, "")
+		So(after.CommissionPayer, ShouldEqual, merchant)
+	})
+}
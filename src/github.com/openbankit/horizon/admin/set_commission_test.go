@@ -1,6 +1,7 @@
 package admin
 
 import (
+	"encoding/json"
 	"github.com/openbankit/go-base/keypair"
 	"github.com/openbankit/go-base/xdr"
 	"github.com/openbankit/horizon/assets"
@@ -12,6 +13,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"strconv"
 	"testing"
+	"time"
 )
 
 func TestActionsSetCommission(t *testing.T) {
@@ -92,6 +94,41 @@ func TestActionsSetCommission(t *testing.T) {
 			So(action.Err, ShouldNotBeNil)
 			So(action.Err, ShouldBeInvalidField, "percent_fee")
 		})
+		Convey("Invalid max_fee", func() {
+			action := NewSetCommissionAction(NewAdminAction(map[string]interface{}{
+				"min_fee": "10",
+				"max_fee": "5",
+			}, historyQ))
+			action.Validate()
+			So(action.Err, ShouldNotBeNil)
+			So(action.Err, ShouldBeInvalidField, "max_fee")
+		})
+		Convey("Invalid validity period", func() {
+			action := NewSetCommissionAction(NewAdminAction(map[string]interface{}{
+				"valid_from":  "2016-10-02T00:00:00Z",
+				"valid_until": "2016-10-01T00:00:00Z",
+			}, historyQ))
+			action.Validate()
+			So(action.Err, ShouldNotBeNil)
+			So(action.Err, ShouldBeInvalidField, "valid_until")
+		})
+		Convey("Invalid tiers", func() {
+			action := NewSetCommissionAction(NewAdminAction(map[string]interface{}{
+				"tiers": []interface{}{
+					map[string]interface{}{
+						"from_amount": "100",
+						"percent_fee": "1",
+					},
+					map[string]interface{}{
+						"from_amount": "100",
+						"percent_fee": "2",
+					},
+				},
+			}, historyQ))
+			action.Validate()
+			So(action.Err, ShouldNotBeNil)
+			So(action.Err, ShouldBeInvalidField, "tiers")
+		})
 		Convey("valid insert", func() {
 			fromKey, err := keypair.Random()
 			assert.Nil(t, err)
@@ -118,6 +155,15 @@ func TestActionsSetCommission(t *testing.T) {
 				"asset_issuer": assetIssuer,
 				"flat_fee":     strconv.FormatInt(flatFee, 10),
 				"percent_fee":  strconv.FormatInt(percentFee, 10),
+				"valid_from":   "2016-10-01T00:00:00Z",
+				"min_fee":      "100",
+				"max_fee":      "100000000",
+				"tiers": []interface{}{
+					map[string]interface{}{
+						"from_amount": "1000000000",
+						"percent_fee": "5",
+					},
+				},
 			}
 			action := NewSetCommissionAction(NewAdminAction(data, historyQ))
 			check := func(action AdminAction) {
@@ -137,6 +183,14 @@ func TestActionsSetCommission(t *testing.T) {
 				assert.Equal(t, assetIssuer, stKey.Asset.Issuer)
 				assert.Equal(t, flatFee, st.FlatFee)
 				assert.Equal(t, percentFee, st.PercentFee)
+				assert.Equal(t, int64(100), st.MinFee)
+				assert.NotNil(t, st.ValidFrom)
+				assert.Equal(t, "2016-10-01T00:00:00Z", st.ValidFrom.UTC().Format(time.RFC3339))
+				assert.Nil(t, st.ValidUntil)
+				assert.Equal(t, int64(100000000), st.MaxFee)
+				tiers, err := st.GetTiers()
+				assert.Nil(t, err)
+				assert.Equal(t, []history.CommissionTier{{FromAmount: 1000000000, PercentFee: 5}}, tiers)
 			}
 			action.Validate()
 			So(action.Err, ShouldBeNil)
@@ -151,6 +205,28 @@ func TestActionsSetCommission(t *testing.T) {
 				updateAction.Apply()
 				check(updateAction.AdminAction)
 			})
+			Convey("audit log", func() {
+				actor, err := keypair.Random()
+				So(err, ShouldBeNil)
+				txHash := "3374e99349b9ef7dba9a5db3339b78fda8f34777b1af33ba468ad5c0df946d4d"
+				data["delete"] = "true"
+				adminAction := NewAdminAction(data, historyQ)
+				adminAction.SetAuditInfo(SubjectCommission, actor.Address(), txHash)
+				deleteAction := NewSetCommissionAction(adminAction)
+				deleteAction.Validate()
+				So(deleteAction.Err, ShouldBeNil)
+				deleteAction.Apply()
+				So(deleteAction.Err, ShouldBeNil)
+				var entries []history.AuditLog
+				err = historyQ.AuditLogs().ForTransaction(txHash).Select(&entries)
+				So(err, ShouldBeNil)
+				So(len(entries), ShouldEqual, 1)
+				So(entries[0].Action, ShouldEqual, "delete")
+				So(entries[0].After, ShouldEqual, "null")
+				var before history.Commission
+				So(json.Unmarshal([]byte(entries[0].Before), &before), ShouldBeNil)
+				So(before.FlatFee, ShouldEqual, flatFee)
+			})
 			Convey("delete", func() {
 				data["delete"] = "true"
 				deleteAction := NewSetCommissionAction(NewAdminAction(data, historyQ))
@@ -165,6 +241,59 @@ func TestActionsSetCommission(t *testing.T) {
 			})
 			historyQ.DeleteCommissions()
 		})
+		Convey("standing and scheduled commissions coexist", func() {
+			fromKey, err := keypair.Random()
+			So(err, ShouldBeNil)
+			from := fromKey.Address()
+			standingData := map[string]interface{}{
+				"from":        from,
+				"flat_fee":    "100",
+				"percent_fee": "0",
+			}
+			scheduledData := map[string]interface{}{
+				"from":        from,
+				"flat_fee":    "10",
+				"percent_fee": "0",
+				"valid_from":  "2016-10-01T00:00:00Z",
+				"valid_until": "2016-11-01T00:00:00Z",
+			}
+			for _, data := range []map[string]interface{}{standingData, scheduledData} {
+				action := NewSetCommissionAction(NewAdminAction(data, historyQ))
+				action.Validate()
+				So(action.Err, ShouldBeNil)
+				So(action.isNew, ShouldBeTrue)
+				action.Apply()
+				So(action.Err, ShouldBeNil)
+			}
+
+			var stored []history.Commission
+			err = historyQ.Commissions().ForAccount(from).Select(&stored)
+			So(err, ShouldBeNil)
+			So(len(stored), ShouldEqual, 2)
+
+			keys := map[string]history.CommissionKey{stored[0].KeyHash: {From: from}}
+			active, err := historyQ.GetHighestWeightCommission(keys, time.Date(2016, 10, 15, 0, 0, 0, 0, time.UTC))
+			So(err, ShouldBeNil)
+			So(len(active), ShouldEqual, 2)
+			active, err = historyQ.GetHighestWeightCommission(keys, time.Date(2016, 11, 2, 0, 0, 0, 0, time.UTC))
+			So(err, ShouldBeNil)
+			So(len(active), ShouldEqual, 1)
+			So(active[0].FlatFee, ShouldEqual, 100)
+
+			// update of scheduled commission does not touch standing one
+			scheduledData["flat_fee"] = "20"
+			action := NewSetCommissionAction(NewAdminAction(scheduledData, historyQ))
+			action.Validate()
+			So(action.Err, ShouldBeNil)
+			So(action.isNew, ShouldBeFalse)
+			action.Apply()
+			So(action.Err, ShouldBeNil)
+			active, err = historyQ.GetHighestWeightCommission(keys, time.Date(2016, 11, 2, 0, 0, 0, 0, time.UTC))
+			So(err, ShouldBeNil)
+			So(len(active), ShouldEqual, 1)
+			So(active[0].FlatFee, ShouldEqual, 100)
+			historyQ.DeleteCommissions()
+		})
 
 	})
 }
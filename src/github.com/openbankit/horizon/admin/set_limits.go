@@ -9,7 +9,8 @@ import (
 type SetLimitsAction struct {
 	AdminAction
 	Account *history.Account
-	Limits history.AccountLimits
+	Limits  history.AccountLimits
+	isNew   bool
 }
 
 func NewSetLimitsAction(adminAction AdminAction) *SetLimitsAction {
@@ -37,29 +38,35 @@ func (action *SetLimitsAction) Validate() {
 		action.Err = &problem.ServerError
 		return
 	}
-}
 
-func (action *SetLimitsAction) Apply() {
-	if action.Err != nil {
-		return
-	}
 	// 2. Try get limits for account
-	var isNewEntry bool
-	var accLimits history.AccountLimits
-	err := action.HistoryQ().GetAccountLimits(&accLimits, action.Limits.Account, action.Limits.AssetCode)
+	var stored history.AccountLimits
+	err = action.HistoryQ().GetAccountLimitsForCounterparty(&stored, action.Limits.Account, action.Limits.AssetCode,
+		action.Limits.CounterpartyType)
 	if err != nil {
 		if err != sql.ErrNoRows {
 			action.Log.WithStack(err).WithError(err).Error("Failed to get account limits")
 			action.Err = &problem.ServerError
 			return
 		}
-		isNewEntry = true
+		action.isNew = true
 	}
-	// 3. Validate and set limits
-	accLimits = action.Limits
 
-	// 4. Persist changes
-	if isNewEntry {
+	var before interface{}
+	if !action.isNew {
+		before = stored
+	}
+	action.setPreview(getActionPerformed(action.isNew, false), before, action.Limits)
+}
+
+func (action *SetLimitsAction) Apply() {
+	if action.Err != nil {
+		return
+	}
+
+	// 3. Persist changes
+	var err error
+	if action.isNew {
 		var limitedAssets map[string]bool
 		limitedAssets, err = action.Account.UnmarshalLimitedAssets()
 		if err != nil {
@@ -67,7 +74,7 @@ func (action *SetLimitsAction) Apply() {
 			action.Err = &problem.ServerError
 			return
 		}
-		limitedAssets[accLimits.AssetCode] = true
+		limitedAssets[action.Limits.AssetCode] = true
 		action.Account.SetLimitedAssets(limitedAssets)
 		err = action.HistoryQ().AccountUpdate(action.Account)
 		if err != nil {
@@ -76,15 +83,18 @@ func (action *SetLimitsAction) Apply() {
 			return
 		}
 
-		err = action.HistoryQ().CreateAccountLimits(accLimits)
+		err = action.HistoryQ().CreateAccountLimits(action.Limits)
 	} else {
-		err = action.HistoryQ().UpdateAccountLimits(accLimits)
+		err = action.HistoryQ().UpdateAccountLimits(action.Limits)
 	}
 
 	if err != nil {
-		action.Log.WithStack(err).WithField("is_new", isNewEntry).WithError(err).Error("Failed to insert/update account limits")
+		action.Log.WithStack(err).WithField("is_new", action.isNew).WithError(err).Error("Failed to insert/update account limits")
 		action.Err = &problem.ServerError
+		return
 	}
+
+	action.recordAudit()
 }
 
 func (action *SetLimitsAction) loadParams() {
@@ -92,8 +102,19 @@ func (action *SetLimitsAction) loadParams() {
 	action.Limits.AssetCode = action.GetString("asset_code")
 	action.Limits.MaxOperationOut = action.GetInt64("max_operation_out")
 	action.Limits.DailyMaxOut = action.GetInt64("daily_max_out")
+	action.Limits.WeeklyMaxOut = action.GetInt64OrDefault("weekly_max_out", -1)
 	action.Limits.MonthlyMaxOut = action.GetInt64("monthly_max_out")
+	action.Limits.AnnualMaxOut = action.GetInt64OrDefault("annual_max_out", -1)
 	action.Limits.MaxOperationIn = action.GetInt64("max_operation_in")
 	action.Limits.DailyMaxIn = action.GetInt64("daily_max_in")
+	action.Limits.WeeklyMaxIn = action.GetInt64OrDefault("weekly_max_in", -1)
 	action.Limits.MonthlyMaxIn = action.GetInt64("monthly_max_in")
+	action.Limits.AnnualMaxIn = action.GetInt64OrDefault("annual_max_in", -1)
+
+	// limits are applied to payments with all counterparties, if counterparty type is not set
+	action.Limits.CounterpartyType = history.AnyCounterpartyType
+	counterpartyType := action.GetOptionalAccountType("counterparty_type")
+	if counterpartyType != nil {
+		action.Limits.CounterpartyType = int16(*counterpartyType)
+	}
 }
@@ -2,6 +2,7 @@ package admin
 
 import (
 	"github.com/openbankit/go-base/keypair"
+	"github.com/openbankit/go-base/xdr"
 	"github.com/openbankit/horizon/db2/history"
 	"github.com/openbankit/horizon/log"
 	"github.com/openbankit/horizon/render/problem"
@@ -41,24 +42,42 @@ func TestActionsSetLimits(t *testing.T) {
 			account := test.NewTestConfig().BankMasterKey
 			// create new limit
 			expected := history.AccountLimits{
-				AssetCode:       "USD",
-				Account:         account,
-				MaxOperationOut: 1,
-				DailyMaxOut:     2,
-				MonthlyMaxOut:   3,
-				MaxOperationIn:  5,
-				DailyMaxIn:      7,
-				MonthlyMaxIn:    11,
+				AssetCode:        "USD",
+				Account:          account,
+				CounterpartyType: history.AnyCounterpartyType,
+				MaxOperationOut:  1,
+				DailyMaxOut:      2,
+				WeeklyMaxOut:     -1,
+				MonthlyMaxOut:    3,
+				AnnualMaxOut:     -1,
+				MaxOperationIn:   5,
+				DailyMaxIn:       7,
+				WeeklyMaxIn:      -1,
+				MonthlyMaxIn:     11,
+				AnnualMaxIn:      -1,
 			}
 			data := limitsToMap(expected)
 			applyLimit(t, data, expected, historyQ)
 			// update
 			expected.DailyMaxIn = 13
 			expected.MaxOperationOut = 17
+			expected.WeeklyMaxOut = 19
+			expected.AnnualMaxIn = 23
 			data = limitsToMap(expected)
 			applyLimit(t, data, expected, historyQ)
+			// limits for specific counterparty type do not override general ones
+			counterpartyLimits := expected
+			counterpartyLimits.CounterpartyType = int16(xdr.AccountTypeAccountMerchant)
+			counterpartyLimits.MonthlyMaxOut = 29
+			data = limitsToMap(counterpartyLimits)
+			data["counterparty_type"] = strconv.Itoa(int(xdr.AccountTypeAccountMerchant))
+			applyLimit(t, data, counterpartyLimits, historyQ)
+			var generalLimits history.AccountLimits
+			err := historyQ.GetAccountLimits(&generalLimits, account, expected.AssetCode)
+			So(err, ShouldBeNil)
+			So(generalLimits, ShouldResemble, expected)
 			var storedAccount history.Account
-			err := historyQ.AccountByAddress(&storedAccount, account)
+			err = historyQ.AccountByAddress(&storedAccount, account)
 			So(err, ShouldBeNil)
 			limitedAssets, err := storedAccount.UnmarshalLimitedAssets()
 			So(err, ShouldBeNil)
@@ -74,10 +93,14 @@ func limitsToMap(l history.AccountLimits) map[string]interface{} {
 		"asset_code":        l.AssetCode,
 		"max_operation_out": strconv.Itoa(int(l.MaxOperationOut)),
 		"daily_max_out":     strconv.Itoa(int(l.DailyMaxOut)),
+		"weekly_max_out":    strconv.Itoa(int(l.WeeklyMaxOut)),
 		"monthly_max_out":   strconv.Itoa(int(l.MonthlyMaxOut)),
+		"annual_max_out":    strconv.Itoa(int(l.AnnualMaxOut)),
 		"max_operation_in":  strconv.Itoa(int(l.MaxOperationIn)),
 		"daily_max_in":      strconv.Itoa(int(l.DailyMaxIn)),
+		"weekly_max_in":     strconv.Itoa(int(l.WeeklyMaxIn)),
 		"monthly_max_in":    strconv.Itoa(int(l.MonthlyMaxIn)),
+		"annual_max_in":     strconv.Itoa(int(l.AnnualMaxIn)),
 	}
 }
 
@@ -88,7 +111,8 @@ func applyLimit(t *testing.T, data map[string]interface{}, expected history.Acco
 	action.Apply()
 	So(action.Err, ShouldBeNil)
 	var limits history.AccountLimits
-	err := historyQ.GetAccountLimits(&limits, data["account_id"].(string), expected.AssetCode)
+	err := historyQ.GetAccountLimitsForCounterparty(&limits, data["account_id"].(string), expected.AssetCode,
+		expected.CounterpartyType)
 	if err != nil {
 		log.WithField("account_id", data["account_id"]).WithError(err).Error("failed to get account limits")
 	}
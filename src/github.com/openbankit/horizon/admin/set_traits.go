@@ -1,18 +1,60 @@
 package admin
 
 import (
+	"github.com/openbankit/horizon/audit"
 	"github.com/openbankit/horizon/db2/history"
 	"github.com/openbankit/horizon/render/problem"
 	"database/sql"
+	"errors"
+	"time"
 )
 
+// maxBlockNoteLength is max length of the note, explaining block of account's payments
+const maxBlockNoteLength = 1024
+
 type SetTraitsAction struct {
 	AdminAction
 	Address  string
 	BlockIn  *bool
 	BlockOut *bool
 
+	// details of the blocks. Can be set only if payments are blocked
+	BlockInReason  string
+	BlockInUntil   *time.Time
+	BlockInNote    string
+	BlockOutReason string
+	BlockOutUntil  *time.Time
+	BlockOutNote   string
+
 	account  history.Account
+	before   accountTraits
+}
+
+// accountTraits is a snapshot of account's traits stored in audit log
+type accountTraits struct {
+	Address                string     `json:"account_id"`
+	BlockIncomingPayments  bool       `json:"block_incoming_payments"`
+	BlockIncomingReason    string     `json:"block_incoming_reason,omitempty"`
+	BlockIncomingUntil     *time.Time `json:"block_incoming_until,omitempty"`
+	BlockIncomingNote      string     `json:"block_incoming_note,omitempty"`
+	BlockOutcomingPayments bool       `json:"block_outcoming_payments"`
+	BlockOutcomingReason   string     `json:"block_outcoming_reason,omitempty"`
+	BlockOutcomingUntil    *time.Time `json:"block_outcoming_until,omitempty"`
+	BlockOutcomingNote     string     `json:"block_outcoming_note,omitempty"`
+}
+
+func newAccountTraits(account history.Account) accountTraits {
+	return accountTraits{
+		Address:                account.Address,
+		BlockIncomingPayments:  account.BlockIncomingPayments,
+		BlockIncomingReason:    account.BlockIncomingReason,
+		BlockIncomingUntil:     account.BlockIncomingUntil,
+		BlockIncomingNote:      account.BlockIncomingNote,
+		BlockOutcomingPayments: account.BlockOutcomingPayments,
+		BlockOutcomingReason:   account.BlockOutcomingReason,
+		BlockOutcomingUntil:    account.BlockOutcomingUntil,
+		BlockOutcomingNote:     account.BlockOutcomingNote,
+	}
 }
 
 func NewSetTraitsAction(adminAction AdminAction) *SetTraitsAction {
@@ -38,14 +80,34 @@ func (action *SetTraitsAction) Validate() {
 		return
 	}
 
+	action.before = newAccountTraits(action.account)
+
 	//Set traits
 	if action.BlockIn != nil {
 		action.account.BlockIncomingPayments = *action.BlockIn
+		action.account.BlockIncomingReason = ""
+		action.account.BlockIncomingUntil = nil
+		action.account.BlockIncomingNote = ""
+		if *action.BlockIn {
+			action.account.BlockIncomingReason = action.BlockInReason
+			action.account.BlockIncomingUntil = action.BlockInUntil
+			action.account.BlockIncomingNote = action.BlockInNote
+		}
 	}
 
 	if action.BlockOut != nil {
 		action.account.BlockOutcomingPayments = *action.BlockOut
+		action.account.BlockOutcomingReason = ""
+		action.account.BlockOutcomingUntil = nil
+		action.account.BlockOutcomingNote = ""
+		if *action.BlockOut {
+			action.account.BlockOutcomingReason = action.BlockOutReason
+			action.account.BlockOutcomingUntil = action.BlockOutUntil
+			action.account.BlockOutcomingNote = action.BlockOutNote
+		}
 	}
+
+	action.setPreview(audit.ActionPerformedUpdate, action.before, newAccountTraits(action.account))
 }
 
 func (action *SetTraitsAction) Apply() {
@@ -54,10 +116,55 @@ func (action *SetTraitsAction) Apply() {
 	}
 
 	action.Err = action.HistoryQ().AccountUpdate(&action.account)
+	action.recordAudit()
 }
 
 func (action *SetTraitsAction) loadParams() {
 	action.Address = action.GetAddress("account_id")
 	action.BlockIn = action.GetOptionalBool("block_incoming_payments")
 	action.BlockOut = action.GetOptionalBool("block_outcoming_payments")
+	action.BlockInReason, action.BlockInUntil, action.BlockInNote = action.loadBlockDetails("incoming", action.BlockIn)
+	action.BlockOutReason, action.BlockOutUntil, action.BlockOutNote = action.loadBlockDetails("outcoming", action.BlockOut)
+}
+
+// loadBlockDetails loads reason, expiration time and note of incoming or outcoming payments block.
+// If block is set, but reason is not specified, BlockReasonOther is used.
+func (action *SetTraitsAction) loadBlockDetails(direction string, block *bool) (reason string, until *time.Time, note string) {
+	reasonField := "block_" + direction + "_reason"
+	untilField := "block_" + direction + "_until"
+	noteField := "block_" + direction + "_note"
+	reason = action.GetString(reasonField)
+	until = action.GetOptionalTime(untilField)
+	note = action.GetString(noteField)
+	if action.Err != nil {
+		return
+	}
+
+	isBlocked := block != nil && *block
+	if !isBlocked {
+		switch {
+		case reason != "":
+			action.SetInvalidField(reasonField, errors.New("Can be set only if payments are blocked"))
+		case until != nil:
+			action.SetInvalidField(untilField, errors.New("Can be set only if payments are blocked"))
+		case note != "":
+			action.SetInvalidField(noteField, errors.New("Can be set only if payments are blocked"))
+		}
+		return
+	}
+
+	if reason == "" {
+		reason = history.BlockReasonOther
+	}
+
+	if !history.IsValidBlockReason(reason) {
+		action.SetInvalidField(reasonField, errors.New("Unknown block reason"))
+		return
+	}
+
+	if len(note) > maxBlockNoteLength {
+		action.SetInvalidField(noteField, errors.New("Too long"))
+		return
+	}
+	return
 }
@@ -1,6 +1,7 @@
 package admin
 
 import (
+	"encoding/json"
 	"github.com/openbankit/go-base/keypair"
 	"github.com/openbankit/horizon/db2/history"
 	"github.com/openbankit/horizon/log"
@@ -8,6 +9,7 @@ import (
 	. "github.com/smartystreets/goconvey/convey"
 	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
 )
 
 func TestActionsSetTraits(t *testing.T) {
@@ -92,6 +94,91 @@ func TestActionsSetTraits(t *testing.T) {
 			So(action.Err, ShouldBeNil)
 			checkTraitsAction(action, storedAcc, historyQ)
 		})
+		Convey("block details", func() {
+			action := NewSetTraitsAction(NewAdminAction(map[string]interface{}{
+				"account_id":            account,
+				"block_incoming_reason": history.BlockReasonFraudSuspected,
+			}, historyQ))
+			action.Validate()
+			So(action.Err, ShouldBeInvalidField, "block_incoming_reason")
+
+			action = NewSetTraitsAction(NewAdminAction(map[string]interface{}{
+				"account_id":               account,
+				"block_outcoming_payments": true,
+				"block_outcoming_reason":   "random_reason",
+			}, historyQ))
+			action.Validate()
+			So(action.Err, ShouldBeInvalidField, "block_outcoming_reason")
+
+			until := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+			action = NewSetTraitsAction(NewAdminAction(map[string]interface{}{
+				"account_id":               account,
+				"block_outcoming_payments": true,
+				"block_outcoming_reason":   history.BlockReasonFraudSuspected,
+				"block_outcoming_until":    until.Format(time.RFC3339),
+				"block_outcoming_note":     "chargeback investigation",
+			}, historyQ))
+			action.Validate()
+			So(action.Err, ShouldBeNil)
+			action.Apply()
+			So(action.Err, ShouldBeNil)
+
+			var stored history.Account
+			err := historyQ.AccountByAddress(&stored, account)
+			So(err, ShouldBeNil)
+			So(stored.BlockOutcomingPayments, ShouldBeTrue)
+			So(stored.BlockOutcomingReason, ShouldEqual, history.BlockReasonFraudSuspected)
+			So(stored.BlockOutcomingNote, ShouldEqual, "chargeback investigation")
+			So(stored.BlockOutcomingUntil, ShouldNotBeNil)
+			So(stored.BlockOutcomingUntil.Equal(until), ShouldBeTrue)
+			So(stored.IsOutcomingBlocked(time.Now()), ShouldBeTrue)
+			So(stored.IsOutcomingBlocked(until.Add(time.Second)), ShouldBeFalse)
+
+			// unblock clears details
+			action = NewSetTraitsAction(NewAdminAction(map[string]interface{}{
+				"account_id":               account,
+				"block_outcoming_payments": false,
+			}, historyQ))
+			action.Validate()
+			So(action.Err, ShouldBeNil)
+			action.Apply()
+			So(action.Err, ShouldBeNil)
+			err = historyQ.AccountByAddress(&stored, account)
+			So(err, ShouldBeNil)
+			So(stored.BlockOutcomingPayments, ShouldBeFalse)
+			So(stored.BlockOutcomingReason, ShouldEqual, "")
+			So(stored.BlockOutcomingUntil, ShouldBeNil)
+		})
+		Convey("audit log", func() {
+			actor, err := keypair.Random()
+			So(err, ShouldBeNil)
+			txHash := "2374e99349b9ef7dba9a5db3339b78fda8f34777b1af33ba468ad5c0df946d4d"
+			adminAction := NewAdminAction(map[string]interface{}{
+				"account_id":              account,
+				"block_incoming_payments": "true",
+			}, historyQ)
+			adminAction.SetAuditInfo(SubjectTraits, actor.Address(), txHash)
+			action := NewSetTraitsAction(adminAction)
+			action.Validate()
+			So(action.Err, ShouldBeNil)
+			action.Apply()
+			So(action.Err, ShouldBeNil)
+
+			var entries []history.AuditLog
+			err = historyQ.AuditLogs().ForTransaction(txHash).Select(&entries)
+			So(err, ShouldBeNil)
+			So(len(entries), ShouldEqual, 1)
+			So(entries[0].Actor, ShouldEqual, actor.Address())
+			So(entries[0].Subject, ShouldEqual, string(SubjectTraits))
+			So(entries[0].Action, ShouldEqual, "update")
+
+			var before, after accountTraits
+			So(json.Unmarshal([]byte(entries[0].Before), &before), ShouldBeNil)
+			So(json.Unmarshal([]byte(entries[0].After), &after), ShouldBeNil)
+			So(before.Address, ShouldEqual, account)
+			So(before.BlockIncomingPayments, ShouldBeFalse)
+			So(after.BlockIncomingPayments, ShouldBeTrue)
+		})
 	})
 }
 
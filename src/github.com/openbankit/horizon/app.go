@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"reflect"
 	"runtime"
+	"sync"
 	"time"
 
 	//"github.com/openbankit/go-base/build"
@@ -21,6 +23,8 @@ import (
 	"github.com/openbankit/horizon/pump"
 	"github.com/openbankit/horizon/render/sse"
 	"github.com/openbankit/horizon/txsub"
+	"github.com/openbankit/horizon/txsub/transactions/statistics"
+	"github.com/openbankit/horizon/webhook"
 	"github.com/garyburd/redigo/redis"
 	"github.com/rcrowley/go-metrics"
 	"golang.org/x/net/context"
@@ -36,6 +40,7 @@ var version = ""
 // App represents the root of the state of a horizon instance.
 type App struct {
 	config            conf.Config
+	configLock        sync.RWMutex
 	web               *Web
 	historyQ          *history.Q
 	coreQ             *core.Q
@@ -51,6 +56,9 @@ type App struct {
 	paths             paths.Finder
 	friendbot         *friendbot.Bot
 	ingester          *ingest.System
+	statsReconciler   *statistics.Reconciler
+	statsReservations *statistics.Reservations
+	webhooks          *webhook.Dispatcher
 
 	// metrics
 	metrics                metrics.Registry
@@ -148,10 +156,44 @@ func (a *App) Close() {
 		a.ingester.Close()
 	}
 
+	if a.statsReconciler != nil {
+		a.statsReconciler.Close()
+	}
+
+	if a.webhooks != nil {
+		a.webhooks.Close()
+	}
+
 	a.historyQ.Repo.DB.Close()
 	a.coreQ.Repo.DB.Close()
 }
 
+// Config returns copy of the effective config of the app.
+func (a *App) Config() conf.Config {
+	a.configLock.RLock()
+	defer a.configLock.RUnlock()
+	return a.config
+}
+
+// ReloadConfig applies settings, which are safe to change on running horizon, from the provided
+// config: rate limit, log level, anonymous user restrictions and admin signature validity.
+// Subsystems sharing app's config use new values on next read. Returns names of other settings,
+// which differ from the effective ones and are ignored until restart.
+func (a *App) ReloadConfig(config conf.Config) []string {
+	a.configLock.Lock()
+	defer a.configLock.Unlock()
+
+	rateLimitChanged := !reflect.DeepEqual(a.config.RateLimit, config.RateLimit)
+	restartRequired := a.config.Reload(config)
+
+	log.DefaultLogger.Logger.Level = a.config.LogLevel
+	if rateLimitChanged {
+		a.web.setRateLimiter(newWebRateLimiter(a, a.config.RateLimit))
+	}
+
+	return restartRequired
+}
+
 func (a *App) SharedCache() *cache.SharedCache {
 	return a.sharedCache
 }
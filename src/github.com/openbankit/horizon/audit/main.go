@@ -10,9 +10,14 @@ import (
 type AdminActionSubject string
 
 const (
-	SubjectCommission    AdminActionSubject = "commission"
-	SubjectTraits        AdminActionSubject = "traits"
-	SubjectAccountLimits AdminActionSubject = "account_limits"
+	SubjectCommission                 AdminActionSubject = "commission"
+	SubjectTraits                     AdminActionSubject = "traits"
+	SubjectAccountLimits              AdminActionSubject = "account_limits"
+	SubjectAsset                      AdminActionSubject = "asset"
+	SubjectMaxPaymentReversalDuration AdminActionSubject = "max_reversal_duration"
+	SubjectAccountTypeRestrictions    AdminActionSubject = "account_type_restrictions"
+	SubjectAccountTypeLimits          AdminActionSubject = "account_type_limits"
+	SubjectCommissionPayer            AdminActionSubject = "commission_payer"
 )
 
 type ActionPerformed string
@@ -28,6 +33,9 @@ type AdminActionInfo struct {
 	Subject         AdminActionSubject //subject to change
 	ActionPerformed ActionPerformed    //action performed on subject
 	Meta            interface{}        //meta information about audit event
+	TxHash          string             //hash of transaction, containing administrative operation
+	Before          interface{}        //state of subject before change, nil if subject did not exist
+	After           interface{}        //state of subject after change, nil if subject was deleted
 }
 
 func (info *AdminActionInfo) IsValid() bool {
@@ -35,14 +43,22 @@ func (info *AdminActionInfo) IsValid() bool {
 }
 
 func (info *AdminActionInfo) ToHistory() *history.AuditLog {
-	metaData, err := json.Marshal(info.Meta)
-	if err != nil {
-		log.WithStack(err).WithError(err).Error("Failed to marshal meta data")
-	}
 	return &history.AuditLog{
 		Actor:   info.ActorPublicKey.Address(),
 		Subject: string(info.Subject),
 		Action:  string(info.ActionPerformed),
-		Meta:    string(metaData),
+		Meta:    marshal(info.Meta, "meta data"),
+		TxHash:  info.TxHash,
+		Before:  marshal(info.Before, "state before change"),
+		After:   marshal(info.After, "state after change"),
+	}
+}
+
+func marshal(data interface{}, name string) string {
+	rawData, err := json.Marshal(data)
+	if err != nil {
+		log.WithStack(err).WithError(err).Error("Failed to marshal " + name)
+		return "null"
 	}
+	return string(rawData)
 }
@@ -2,4 +2,5 @@ package cache
 
 type SharedCache struct {
 	AccountHistoryCache *HistoryAccount
+	StatisticsSummary   *StatisticsSummary
 }
@@ -0,0 +1,46 @@
+package cache
+
+import (
+	"time"
+
+	"github.com/openbankit/horizon/db2/history"
+	"github.com/openbankit/horizon/ingest/statistics"
+	"github.com/patrickmn/go-cache"
+)
+
+// maxStatisticsSummaryEntries limits number of cached summaries. Summaries of new windows are not cached,
+// until expired ones are evicted.
+const maxStatisticsSummaryEntries = 256
+
+// StatisticsSummary provides a cached lookup of bank-wide statistics for time windows
+type StatisticsSummary struct {
+	*cache.Cache
+	builder *statistics.SummaryBuilder
+}
+
+// NewStatisticsSummary initializes a new instance of `StatisticsSummary`
+func NewStatisticsSummary(historyQ *history.Q, defaultExpiration, cleanupInterval time.Duration) *StatisticsSummary {
+	return &StatisticsSummary{
+		Cache:   cache.New(defaultExpiration, cleanupInterval),
+		builder: statistics.NewSummaryBuilder(historyQ),
+	}
+}
+
+// Get returns summary for [from, to). Summary is built from history db, if it is not cached.
+func (c *StatisticsSummary) Get(from, to time.Time) (*statistics.Summary, error) {
+	key := from.UTC().Format(time.RFC3339Nano) + "-" + to.UTC().Format(time.RFC3339Nano)
+	found, ok := c.Cache.Get(key)
+	if ok {
+		return found.(*statistics.Summary), nil
+	}
+
+	summary, err := c.builder.Build(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.Cache.ItemCount() < maxStatisticsSummaryEntries {
+		c.Cache.Set(key, summary, cache.DefaultExpiration)
+	}
+	return summary, nil
+}
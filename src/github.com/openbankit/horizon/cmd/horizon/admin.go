@@ -0,0 +1,308 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openbankit/go-base/build"
+	"github.com/openbankit/go-base/keypair"
+	"github.com/openbankit/horizon/admin"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// adminField describes flag of admin command, which is passed into admin action data as field
+type adminField struct {
+	Name  string
+	Usage string
+	// IsJSON fields are decoded before being passed into admin action data
+	IsJSON bool
+}
+
+// flagName returns name of the flag for the field
+func (f adminField) flagName() string {
+	return strings.Replace(f.Name, "_", "-", -1)
+}
+
+var assetFields = []adminField{
+	{Name: "asset_type", Usage: "type of the asset (credit_alphanum4, credit_alphanum12)"},
+	{Name: "asset_code", Usage: "code of the asset"},
+	{Name: "asset_issuer", Usage: "issuer of the asset"},
+}
+
+// adminHTTPClient is used to talk to horizon. Timeout exceeds horizon's submission timeout,
+// so that the result of submission is received.
+var adminHTTPClient = &http.Client{Timeout: 90 * time.Second}
+
+var adminCmd = &cobra.Command{
+	Use:   "admin [command]",
+	Short: "commands to build and sign administrative operations",
+	Long: "admin builds administrative operation from flags, validates it with the same parsers as horizon does, " +
+		"signs transaction with provided seed and prints envelope xdr or submits it to horizon. " +
+		"Secret seed is read from the ADMIN_SEED environment variable or from stdin, if --seed-stdin is set.",
+}
+
+func init() {
+	viper.BindEnv("admin-seed", "ADMIN_SEED")
+
+	adminCmd.PersistentFlags().Bool("seed-stdin", false, "read secret seed used to sign transaction from the first line of stdin")
+	adminCmd.PersistentFlags().String("source", "", "source account of transaction, seed's account is used by default")
+	adminCmd.PersistentFlags().Uint64("sequence", 0, "sequence of transaction, loaded from horizon, if not set")
+	adminCmd.PersistentFlags().String("network-passphrase", "", "passphrase of the network transaction is signed for")
+	adminCmd.PersistentFlags().String("horizon-url", "http://localhost:8000", "horizon used to load sequence and submit transaction")
+	adminCmd.PersistentFlags().Bool("submit", false, "submit transaction to horizon instead of printing envelope xdr")
+
+	adminCmd.AddCommand(newAdminCmd("set-limits", "sets limits of account", admin.SubjectAccountLimits, []adminField{
+		{Name: "account_id", Usage: "account to set limits for"},
+		{Name: "asset_code", Usage: "code of the limited asset"},
+		{Name: "counterparty_type", Usage: "type of counterparty limits are applied to, all counterparties by default"},
+		{Name: "max_operation_out", Usage: "max amount of single outgoing payment"},
+		{Name: "daily_max_out", Usage: "max daily outcome"},
+		{Name: "weekly_max_out", Usage: "max weekly outcome"},
+		{Name: "monthly_max_out", Usage: "max monthly outcome"},
+		{Name: "annual_max_out", Usage: "max annual outcome"},
+		{Name: "max_operation_in", Usage: "max amount of single incoming payment"},
+		{Name: "daily_max_in", Usage: "max daily income"},
+		{Name: "weekly_max_in", Usage: "max weekly income"},
+		{Name: "monthly_max_in", Usage: "max monthly income"},
+		{Name: "annual_max_in", Usage: "max annual income"},
+	}))
+	adminCmd.AddCommand(newAdminCmd("set-traits", "blocks or unblocks payments of account", admin.SubjectTraits, []adminField{
+		{Name: "account_id", Usage: "account to set traits for"},
+		{Name: "block_incoming_payments", Usage: "true to block incoming payments, false to unblock"},
+		{Name: "block_incoming_reason", Usage: "reason of incoming payments block"},
+		{Name: "block_incoming_until", Usage: "time incoming payments are blocked until (2006-01-02T15:04:05Z)"},
+		{Name: "block_incoming_note", Usage: "note on incoming payments block"},
+		{Name: "block_outcoming_payments", Usage: "true to block outgoing payments, false to unblock"},
+		{Name: "block_outcoming_reason", Usage: "reason of outgoing payments block"},
+		{Name: "block_outcoming_until", Usage: "time outgoing payments are blocked until (2006-01-02T15:04:05Z)"},
+		{Name: "block_outcoming_note", Usage: "note on outgoing payments block"},
+	}))
+	adminCmd.AddCommand(newAdminCmd("set-commission", "sets or deletes commission", admin.SubjectCommission, append([]adminField{
+		{Name: "from", Usage: "payment source the commission is applied to"},
+		{Name: "to", Usage: "payment destination the commission is applied to"},
+		{Name: "from_type", Usage: "type of payment source the commission is applied to"},
+		{Name: "to_type", Usage: "type of payment destination the commission is applied to"},
+		{Name: "flat_fee", Usage: "flat fee"},
+		{Name: "percent_fee", Usage: "percent fee"},
+		{Name: "min_fee", Usage: "min fee"},
+		{Name: "max_fee", Usage: "max fee"},
+		{Name: "tiers", Usage: `json list of tiers, e.g. [{"from_amount": 100, "percent_fee": 1}]`, IsJSON: true},
+		{Name: "valid_from", Usage: "time commission is valid from (2006-01-02T15:04:05Z)"},
+		{Name: "valid_until", Usage: "time commission is valid until (2006-01-02T15:04:05Z)"},
+		{Name: "delete", Usage: "true to delete commission"},
+	}, assetFields...)))
+	adminCmd.AddCommand(newAdminCmd("manage-asset", "adds, updates or deletes asset", admin.SubjectAsset, append([]adminField{
+		{Name: "is_anonymous", Usage: "true, if anonymous users can use the asset"},
+		{Name: "delete", Usage: "true to delete asset"},
+	}, assetFields...)))
+	adminCmd.AddCommand(newAdminCmd("set-max-reversal", "sets max duration of payment reversal", admin.SubjectMaxPaymentReversalDuration, []adminField{
+		{Name: "max_reversal_duration", Usage: "max duration of payment reversal in seconds"},
+	}))
+	adminCmd.AddCommand(newAdminCmd("set-commission-payer", "sets account sponsoring commissions of payments to merchant", admin.SubjectCommissionPayer, []adminField{
+		{Name: "account_id", Usage: "merchant account receiving payments"},
+		{Name: "payer", Usage: "sender, destination or address of account sponsoring commissions"},
+	}))
+}
+
+func newAdminCmd(use, short string, subject admin.AdminActionSubject, fields []adminField) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Run: func(cmd *cobra.Command, args []string) {
+			fieldsData, err := getAdminFieldsData(cmd, fields)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			data := map[string]interface{}{
+				string(subject): fieldsData,
+			}
+			err = admin.ValidateParams(data)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			rawData, err := json.Marshal(data)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			runAdminCmd(cmd, string(rawData))
+		},
+	}
+
+	for _, field := range fields {
+		cmd.Flags().String(field.flagName(), "", field.Usage)
+	}
+	return cmd
+}
+
+// getAdminFieldsData returns data of admin action built from set flags
+func getAdminFieldsData(cmd *cobra.Command, fields []adminField) (map[string]interface{}, error) {
+	data := make(map[string]interface{})
+	for _, field := range fields {
+		if !cmd.Flags().Changed(field.flagName()) {
+			continue
+		}
+
+		value, err := cmd.Flags().GetString(field.flagName())
+		if err != nil {
+			return nil, err
+		}
+
+		if !field.IsJSON {
+			data[field.Name] = value
+			continue
+		}
+
+		var decoded interface{}
+		err = json.Unmarshal([]byte(value), &decoded)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %s", field.flagName(), err.Error())
+		}
+		data[field.Name] = decoded
+	}
+	return data, nil
+}
+
+// runAdminCmd builds and signs transaction with administrative operation and prints or submits it
+func runAdminCmd(cmd *cobra.Command, opData string) {
+	flags := cmd.Flags()
+	seedStdin, _ := flags.GetBool("seed-stdin")
+	source, _ := flags.GetString("source")
+	sequence, _ := flags.GetUint64("sequence")
+	passphrase, _ := flags.GetString("network-passphrase")
+	horizonURL, _ := flags.GetString("horizon-url")
+	submit, _ := flags.GetBool("submit")
+
+	seed, err := readAdminSeed(seedStdin)
+	if err != nil {
+		log.Fatalf("failed to read seed: %s", err.Error())
+	}
+
+	if seed == "" {
+		log.Fatal("seed is blank. Please set the ADMIN_SEED environment variable or pass it to stdin with --seed-stdin")
+	}
+
+	signer, err := keypair.Parse(seed)
+	if err != nil {
+		log.Fatalf("invalid seed: %s", err.Error())
+	}
+
+	if _, ok := signer.(*keypair.Full); !ok {
+		log.Fatal("seed must be secret seed")
+	}
+
+	if source == "" {
+		source = signer.Address()
+	}
+
+	if passphrase == "" {
+		passphrase = viper.GetString("network-passphrase")
+	}
+
+	if passphrase == "" {
+		log.Fatal("network-passphrase is blank. Please specify --network-passphrase or set the NETWORK_PASSPHRASE environment variable.")
+	}
+
+	if sequence == 0 {
+		sequence, err = loadAccountSequence(horizonURL, source)
+		if err != nil {
+			log.Fatalf("failed to load sequence of %s: %s", source, err.Error())
+		}
+		sequence++
+	}
+
+	tx := build.Transaction(
+		build.SourceAccount{source},
+		build.Sequence{sequence},
+		build.Network{passphrase},
+		build.AdministrativeOp(build.OpLongData{opData}),
+	)
+	if tx.Err != nil {
+		log.Fatal(tx.Err)
+	}
+
+	txe := tx.Sign(seed)
+	envelope, err := txe.Base64()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if !submit {
+		fmt.Println(envelope)
+		return
+	}
+
+	response, err := submitTransaction(horizonURL, envelope)
+	if err != nil {
+		log.Fatalf("failed to submit transaction: %s", err.Error())
+	}
+	fmt.Println(response)
+}
+
+// readAdminSeed returns secret seed read from the first line of stdin or from environment.
+// Seed is not accepted as a flag, as command line is visible to other users of the host.
+func readAdminSeed(fromStdin bool) (string, error) {
+	if !fromStdin {
+		return viper.GetString("admin-seed"), nil
+	}
+
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && line == "" {
+		return "", err
+	}
+	return strings.TrimSpace(line), nil
+}
+
+// loadAccountSequence returns current sequence of account loaded from horizon
+func loadAccountSequence(horizonURL, address string) (uint64, error) {
+	resp, err := adminHTTPClient.Get(strings.TrimRight(horizonURL, "/") + "/accounts/" + address)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("unexpected response status %s", resp.Status)
+	}
+
+	var account struct {
+		Sequence string `json:"sequence"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&account)
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseUint(account.Sequence, 10, 64)
+}
+
+// submitTransaction submits envelope to horizon and returns response body
+func submitTransaction(horizonURL, envelope string) (string, error) {
+	resp, err := adminHTTPClient.PostForm(strings.TrimRight(horizonURL, "/")+"/transactions", url.Values{"tx": {envelope}})
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected response status %s: %s", resp.Status, string(body))
+	}
+	return string(body), nil
+}
@@ -13,7 +13,9 @@ import (
 	"github.com/openbankit/horizon/db2/history"
 	"github.com/openbankit/horizon/db2/schema"
 	"github.com/openbankit/horizon/ingest"
+	"github.com/openbankit/horizon/ingest/statistics"
 	hlog "github.com/openbankit/horizon/log"
+	"github.com/openbankit/horizon/redis"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -108,6 +110,7 @@ var dbReingestCmd = &cobra.Command{
 			Repo: hdb,
 		}, time.Duration(1)*time.Minute, time.Duration(10)*time.Second)
 		i := ingest.New(passphrase, cdb, hdb, cache)
+		i.CommissionAccount = config.BankCommissionKey
 		logStatus := func(stage string) {
 			count := i.Metrics.IngestLedgerTimer.Count()
 			rate := i.Metrics.IngestLedgerTimer.RateMean()
@@ -147,10 +150,127 @@ var dbReingestCmd = &cobra.Command{
 	},
 }
 
+var dbRebuildStatisticsCmd = &cobra.Command{
+	Use:   "rebuild-statistics",
+	Short: "recomputes account statistics from history",
+	Long: "rebuild-statistics replays payments and reversals ingested in the current year into account statistics, " +
+		"reports differences from stored rows and replaces them. Ingestion should be stopped while command is running.",
+	Run: func(cmd *cobra.Command, args []string) {
+		initConfig()
+		hlog.DefaultLogger.Logger.Level = config.LogLevel
+
+		account, err := cmd.Flags().GetString("account")
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		asset, err := cmd.Flags().GetString("asset")
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		dryRun, err := cmd.Flags().GetBool("dry-run")
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		hdb, err := db2.Open(config.DatabaseURL)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		diffs, err := rebuildStatistics(&history.Q{Repo: hdb}, account, asset, dryRun)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		for _, diff := range diffs {
+			printStatisticsDifference(diff)
+		}
+		log.Printf("Found %d differences", len(diffs))
+
+		// memory storage is not shared with running horizon
+		if dryRun || len(diffs) == 0 || config.StatisticsStorage == redis.StorageMemory {
+			return
+		}
+
+		if config.StatisticsStorage == redis.StoragePostgres {
+			err = redis.InitStorage(config.StatisticsStorage, hdb)
+		} else if config.RedisURL != "" {
+			err = redis.Init(config.RedisURL)
+		} else {
+			return
+		}
+
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		// drop cached statistics of rebuilt accounts, so they are reloaded from db on next access
+		conn := redis.NewConnectionProvider().GetConnection()
+		defer conn.Close()
+		for _, diff := range diffs {
+			err = conn.Delete(redis.GetAccountStatisticsKey(diff.Rebuilt.Account, diff.Rebuilt.AssetCode))
+			if err != nil {
+				log.Fatal(err)
+			}
+		}
+	},
+}
+
 func init() {
 	dbCmd.AddCommand(dbInitCmd)
 	dbCmd.AddCommand(dbMigrateCmd)
 	dbCmd.AddCommand(dbReingestCmd)
+	dbCmd.AddCommand(dbRebuildStatisticsCmd)
+
+	dbRebuildStatisticsCmd.Flags().String("account", "", "rebuild statistics only for specified account")
+	dbRebuildStatisticsCmd.Flags().String("asset", "", "rebuild statistics only for specified asset code")
+	dbRebuildStatisticsCmd.Flags().Bool("dry-run", false, "only report differences, without fixing them")
+}
+
+// rebuildStatistics recomputes statistics and replaces stored rows, which differ, in a single transaction
+func rebuildStatistics(q *history.Q, account, asset string, dryRun bool) ([]statistics.Difference, error) {
+	now := time.Now()
+	rebuilder := statistics.NewRebuilder(q, account, asset)
+	rebuilt, err := rebuilder.Rebuild(now)
+	if err != nil {
+		return nil, err
+	}
+
+	diffs, err := rebuilder.Diff(rebuilt, now)
+	if err != nil || dryRun || len(diffs) == 0 {
+		return diffs, err
+	}
+
+	err = q.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer q.Rollback()
+
+	err = rebuilder.Save(diffs)
+	if err != nil {
+		return nil, err
+	}
+
+	return diffs, q.Commit()
+}
+
+func printStatisticsDifference(diff statistics.Difference) {
+	var stored history.AccountStatistics
+	if diff.Stored != nil {
+		stored = *diff.Stored
+	}
+
+	rebuilt := diff.Rebuilt
+	fmt.Printf("%s %s counterparty %d (stored -> rebuilt):\n", rebuilt.Account, rebuilt.AssetCode, rebuilt.CounterpartyType)
+	fmt.Printf("  income:  daily %d -> %d, weekly %d -> %d, monthly %d -> %d, annual %d -> %d\n",
+		stored.DailyIncome, rebuilt.DailyIncome, stored.WeeklyIncome, rebuilt.WeeklyIncome,
+		stored.MonthlyIncome, rebuilt.MonthlyIncome, stored.AnnualIncome, rebuilt.AnnualIncome)
+	fmt.Printf("  outcome: daily %d -> %d, weekly %d -> %d, monthly %d -> %d, annual %d -> %d\n",
+		stored.DailyOutcome, rebuilt.DailyOutcome, stored.WeeklyOutcome, rebuilt.WeeklyOutcome,
+		stored.MonthlyOutcome, rebuilt.MonthlyOutcome, stored.AnnualOutcome, rebuilt.AnnualOutcome)
 }
 
 func reingest(i *ingest.System, args []string) (int, error) {
@@ -1,13 +1,20 @@
 package main
 
 import (
+	"fmt"
 	"log"
+	"os"
+	"os/signal"
 	"runtime"
+	"syscall"
 
 	"github.com/openbankit/go-base/amount"
 	"github.com/openbankit/horizon"
 	conf "github.com/openbankit/horizon/config"
 	hlog "github.com/openbankit/horizon/log"
+	"github.com/openbankit/horizon/redis"
+	"github.com/openbankit/horizon/txsub/sequence"
+	"github.com/openbankit/horizon/txsub/storage"
 	"github.com/PuerkitoBio/throttled"
 	"github.com/Sirupsen/logrus"
 	"github.com/joho/godotenv"
@@ -35,6 +42,7 @@ func init() {
 	viper.SetDefault("port", 8000)
 	viper.SetDefault("autopump", false)
 
+	viper.BindEnv("config", "CONFIG_FILE")
 	viper.BindEnv("port", "PORT")
 	viper.BindEnv("autopump", "AUTOPUMP")
 	viper.BindEnv("db-url", "DATABASE_URL")
@@ -54,6 +62,12 @@ func init() {
 	viper.BindEnv("network-passphrase", "NETWORK_PASSPHRASE")
 	viper.BindEnv("bank-master-key", "BANK_MASTER_KEY")
 	viper.BindEnv("bank-commission-key", "BANK_COMMISSION_KEY")
+	viper.BindEnv("stats-storage", "STATS_STORAGE")
+	viper.BindEnv("txsub-storage", "TXSUB_STORAGE")
+	viper.BindEnv("txsub-queue-size", "TXSUB_QUEUE_SIZE")
+	viper.BindEnv("txsub-queue-account-size", "TXSUB_QUEUE_ACCOUNT_SIZE")
+	viper.BindEnv("txsub-queue-priority-size", "TXSUB_QUEUE_PRIORITY_SIZE")
+	viper.BindEnv("webhook-allow-private", "WEBHOOK_ALLOW_PRIVATE")
 
 	viper.BindEnv("restrictions-anonymous-user-max-daily-outcome", "RESTRICTIONS_ANONYMOUS_USER_MAX_DAILY_OUTCOME")
 	viper.BindEnv("restrictions-anonymous-user-max-monthly-outcome", "RESTRICTIONS_ANONYMOUS_USER_MAX_MONTHLY_OUTCOME")
@@ -67,10 +81,18 @@ func init() {
 		Long:  "client-facing api server for the stellar network",
 		Run: func(cmd *cobra.Command, args []string) {
 			initApp(cmd, args)
+			watchConfigReload()
 			app.Serve()
 		},
 	}
 
+	rootCmd.PersistentFlags().String(
+		"config",
+		"",
+		"Path to config file (toml, yaml or json) with settings named as flags. Flags and env vars take precedence. "+
+			"On SIGHUP file is reread and rate limit, log level, anonymous user restrictions and admin-sig-valid are applied",
+	)
+
 	rootCmd.Flags().String(
 		"db-url",
 		"",
@@ -179,6 +201,54 @@ func init() {
 		"Bank's commission key",
 	)
 
+	rootCmd.Flags().String(
+		"stats-storage",
+		"redis",
+		"Storage of account statistics used to check limits (redis, memory, postgres). Memory storage is not shared between horizon instances",
+	)
+
+	rootCmd.Flags().String(
+		"txsub-storage",
+		"memory",
+		"Storage of open transaction submissions (memory, postgres). Postgres storage is shared between horizon instances and survives restart, requires postgres 9.5 or later",
+	)
+
+	rootCmd.Flags().Int(
+		"txsub-queue-size",
+		sequence.DefaultMaxSize,
+		"Max number of transactions waiting for their sequence in the submission queue",
+	)
+
+	rootCmd.Flags().Int(
+		"txsub-queue-account-size",
+		0,
+		"Max number of transactions of single account waiting in either lane of the submission queue. When zero, not limited",
+	)
+
+	rootCmd.Flags().Int(
+		"txsub-queue-priority-size",
+		256,
+		"Max number of transactions of bank and agents waiting in the separate priority lane of the submission queue. When zero, they share the submission queue with other accounts",
+	)
+
+	rootCmd.Flags().Bool(
+		"webhook-allow-private",
+		false,
+		"Allow webhook subscriptions to loopback, private and link-local addresses",
+	)
+
+	rootCmd.Flags().Int(
+		"stats-reconcile-interval",
+		0,
+		"Interval in seconds between checks of account statistics cached in redis against history db. When zero, checks are disabled",
+	)
+
+	rootCmd.Flags().Bool(
+		"stats-reconcile-heal",
+		false,
+		"Drop account statistics from redis, if they do not match history db",
+	)
+
 	// User restrictions
 
 	rootCmd.Flags().String(
@@ -212,8 +282,10 @@ func init() {
 	)
 
 	rootCmd.AddCommand(dbCmd)
+	rootCmd.AddCommand(adminCmd)
 
 	viper.BindPFlags(rootCmd.Flags())
+	viper.BindPFlags(rootCmd.PersistentFlags())
 }
 
 func initApp(cmd *cobra.Command, args []string) {
@@ -228,40 +300,92 @@ func initApp(cmd *cobra.Command, args []string) {
 }
 
 func initConfig() {
-	if viper.GetString("db-url") == "" {
-		log.Fatal("Invalid config: db-url is blank.  Please specify --db-url on the command line or set the DATABASE_URL environment variable.")
+	err := readConfigFile()
+	if err != nil {
+		log.Fatalf("Could not read config file: %v", err)
 	}
 
-	if viper.GetString("stellar-core-db-url") == "" {
-		log.Fatal("Invalid config: stellar-core-db-url is blank.  Please specify --stellar-core-db-url on the command line or set the STELLAR_CORE_DATABASE_URL environment variable.")
+	config, err = loadConfig()
+	if err != nil {
+		log.Fatalf("Invalid config: %v", err)
 	}
 
-	if viper.GetString("stellar-core-url") == "" {
-		log.Fatal("Invalid config: stellar-core-url is blank.  Please specify --stellar-core-url on the command line or set the STELLAR_CORE_URL environment variable.")
+	hlog.DefaultLogger.Level = config.LogLevel
+}
+
+// readConfigFile reads config file, if it's specified. Settings from the file take precedence
+// over defaults, but not over flags and env vars.
+func readConfigFile() error {
+	configFile := viper.GetString("config")
+	if configFile == "" {
+		return nil
 	}
 
-	ll, err := logrus.ParseLevel(viper.GetString("log-level"))
+	viper.SetConfigFile(configFile)
+	return viper.ReadInConfig()
+}
+
+// watchConfigReload rereads config file on SIGHUP and applies settings, which are safe to
+// change without restart. Invalid config is rejected and current settings are kept.
+func watchConfigReload() {
+	if viper.GetString("config") == "" {
+		return
+	}
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGHUP)
 
+	go func() {
+		for range signals {
+			reloadConfig()
+		}
+	}()
+}
+
+func reloadConfig() {
+	err := readConfigFile()
 	if err != nil {
-		log.Fatalf("Could not parse log-level: %v", viper.GetString("log-level"))
+		hlog.WithField("err", err).Error("Could not reread config file, keeping current config")
+		return
 	}
 
-	hlog.DefaultLogger.Level = ll
+	next, err := loadConfig()
+	if err != nil {
+		hlog.WithField("err", err).Error("Invalid config, keeping current config")
+		return
+	}
 
-	cert, key := viper.GetString("tls-cert"), viper.GetString("tls-key")
+	restartRequired := app.ReloadConfig(next)
+	if len(restartRequired) > 0 {
+		hlog.WithField("settings", restartRequired).Warn("Changed settings require restart to be applied")
+	}
+	hlog.Info("Config reloaded")
+}
 
-	switch {
-	case cert != "" && key == "":
-		log.Fatal("Invalid TLS config: key not configured")
-	case cert == "" && key != "":
-		log.Fatal("Invalid TLS config: cert not configured")
+// loadConfig builds and validates config from flags, env vars and config file
+func loadConfig() (conf.Config, error) {
+	ll, err := logrus.ParseLevel(viper.GetString("log-level"))
+	if err != nil {
+		return conf.Config{}, fmt.Errorf("could not parse log-level: %v", viper.GetString("log-level"))
 	}
 
-	if viper.GetBool("ingest") && viper.GetString("bank-master-key") == "" {
-		log.Fatal("Invalid config: bank-master-key is blank. Please set the BANK_MASTER_KEY environment variable.")
+	statsStorage := viper.GetString("stats-storage")
+	switch statsStorage {
+	case redis.StorageRedis, redis.StorageMemory, redis.StoragePostgres:
+	default:
+		return conf.Config{}, fmt.Errorf("unknown stats-storage: %v", statsStorage)
 	}
-	if viper.GetString("bank-commission-key") == "" {
-		log.Fatal("Invalid config: bank-commission-key is blank. Please set the BANK_COMMISSION_KEY environment variable.")
+
+	submissionStorage := viper.GetString("txsub-storage")
+	switch submissionStorage {
+	case "", storage.StorageMemory, storage.StoragePostgres:
+	default:
+		return conf.Config{}, fmt.Errorf("unknown txsub-storage: %v", submissionStorage)
+	}
+
+	restrictions, err := getAnonymousUserRestrictions()
+	if err != nil {
+		return conf.Config{}, err
 	}
 
 	adminSigValid := viper.GetInt("admin-sig-valid")
@@ -279,29 +403,39 @@ func initConfig() {
 		processedOpTimeout = statisticsTimeout / 2
 	}
 
-	config = conf.Config{
-		DatabaseURL:               viper.GetString("db-url"),
-		StellarCoreDatabaseURL:    viper.GetString("stellar-core-db-url"),
-		StellarCoreURL:            viper.GetString("stellar-core-url"),
-		Autopump:                  viper.GetBool("autopump"),
-		Port:                      viper.GetInt("port"),
-		RateLimit:                 getRateLimit(),
-		RedisURL:                  viper.GetString("redis-url"),
-		LogLevel:                  ll,
-		SentryDSN:                 viper.GetString("sentry-dsn"),
-		LogglyToken:               viper.GetString("loggly-token"),
-		LogglyHost:                viper.GetString("loggly-host"),
-		FriendbotSecret:           viper.GetString("friendbot-secret"),
-		TLSCert:                   cert,
-		TLSKey:                    key,
-		Ingest:                    viper.GetBool("ingest"),
-		BankMasterKey:             viper.GetString("bank-master-key"),
-		BankCommissionKey:         viper.GetString("bank-commission-key"),
-		AnonymousUserRestrictions: getAnonymousUserRestrictions(),
-		AdminSignatureValid:       time.Duration(adminSigValid) * time.Second,
-		StatisticsTimeout:         time.Duration(statisticsTimeout) * time.Second,
-		ProcessedOpTimeout:        time.Duration(processedOpTimeout) * time.Second,
+	result := conf.Config{
+		DatabaseURL:                 viper.GetString("db-url"),
+		StellarCoreDatabaseURL:      viper.GetString("stellar-core-db-url"),
+		StellarCoreURL:              viper.GetString("stellar-core-url"),
+		Autopump:                    viper.GetBool("autopump"),
+		Port:                        viper.GetInt("port"),
+		RateLimit:                   getRateLimit(),
+		RedisURL:                    viper.GetString("redis-url"),
+		LogLevel:                    ll,
+		SentryDSN:                   viper.GetString("sentry-dsn"),
+		LogglyToken:                 viper.GetString("loggly-token"),
+		LogglyHost:                  viper.GetString("loggly-host"),
+		FriendbotSecret:             viper.GetString("friendbot-secret"),
+		TLSCert:                     viper.GetString("tls-cert"),
+		TLSKey:                      viper.GetString("tls-key"),
+		Ingest:                      viper.GetBool("ingest"),
+		BankMasterKey:               viper.GetString("bank-master-key"),
+		BankCommissionKey:           viper.GetString("bank-commission-key"),
+		AnonymousUserRestrictions:   restrictions,
+		AdminSignatureValid:         time.Duration(adminSigValid) * time.Second,
+		StatisticsTimeout:           time.Duration(statisticsTimeout) * time.Second,
+		ProcessedOpTimeout:          time.Duration(processedOpTimeout) * time.Second,
+		StatisticsStorage:           statsStorage,
+		StatisticsReconcileInterval: time.Duration(viper.GetInt("stats-reconcile-interval")) * time.Second,
+		StatisticsReconcileHeal:     viper.GetBool("stats-reconcile-heal"),
+		SubmissionStorage:           submissionStorage,
+		SubmissionQueueSize:         viper.GetInt("txsub-queue-size"),
+		SubmissionQueueAccountSize:  viper.GetInt("txsub-queue-account-size"),
+		SubmissionQueuePrioritySize: viper.GetInt("txsub-queue-priority-size"),
+		WebhookAllowPrivate:         viper.GetBool("webhook-allow-private"),
 	}
+
+	return result, result.Validate()
 }
 
 func getRateLimit() *throttled.RateQuota {
@@ -315,48 +449,36 @@ func getRateLimit() *throttled.RateQuota {
 	}
 }
 
-func getAnonymousUserRestrictions() conf.AnonymousUserRestrictions {
+func getAnonymousUserRestrictions() (conf.AnonymousUserRestrictions, error) {
 	var restrictions conf.AnonymousUserRestrictions
-	var value int64
 	var err error
 
-	value, err = parseAmount(viper.GetString("restrictions-anonymous-user-max-daily-outcome"))
+	restrictions.MaxDailyOutcome, err = getAmount("restrictions-anonymous-user-max-daily-outcome")
 	if err != nil {
-		log.Fatalf(
-			"Could not parse restrictions-anonymous-user-max-daily-outcome: %v",
-			viper.GetString("restrictions-anonymous-user-max-daily-outcome"),
-		)
+		return restrictions, err
 	}
-	restrictions.MaxDailyOutcome = value
 
-	value, err = parseAmount(viper.GetString("restrictions-anonymous-user-max-monthly-outcome"))
+	restrictions.MaxMonthlyOutcome, err = getAmount("restrictions-anonymous-user-max-monthly-outcome")
 	if err != nil {
-		log.Fatalf(
-			"Could not parse restrictions-anonymous-user-max-monthly-outcome: %v",
-			viper.GetString("restrictions-anonymous-user-max-monthly-outcome"),
-		)
+		return restrictions, err
 	}
-	restrictions.MaxMonthlyOutcome = value
 
-	value, err = parseAmount(viper.GetString("restrictions-anonymous-user-max-annual-outcome"))
+	restrictions.MaxAnnualOutcome, err = getAmount("restrictions-anonymous-user-max-annual-outcome")
 	if err != nil {
-		log.Fatalf(
-			"Could not parse restrictions-anonymous-user-max-annual-outcome: %v",
-			viper.GetString("restrictions-anonymous-user-max-annual-outcome"),
-		)
+		return restrictions, err
 	}
-	restrictions.MaxAnnualOutcome = value
 
-	value, err = parseAmount(viper.GetString("restrictions-anonymous-user-max-balance"))
+	restrictions.MaxBalance, err = getAmount("restrictions-anonymous-user-max-balance")
+	return restrictions, err
+}
+
+// getAmount parses amount setting
+func getAmount(key string) (int64, error) {
+	value, err := parseAmount(viper.GetString(key))
 	if err != nil {
-		log.Fatalf(
-			"Could not parse restrictions-anonymous-user-max-balance: %v",
-			viper.GetString("restrictions-anonymous-user-max-balance"),
-		)
+		return 0, fmt.Errorf("could not parse %s: %v", key, viper.GetString(key))
 	}
-	restrictions.MaxBalance = value
-
-	return restrictions
+	return value, nil
 }
 
 func parseAmount(strAmount string) (int64, error) {
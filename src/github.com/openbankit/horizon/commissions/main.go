@@ -11,11 +11,14 @@ import (
 	"errors"
 	"math"
 	"math/big"
+	"time"
 )
 
 type CommissionsManager struct {
 	SharedCache *cache.SharedCache
 	HistoryQ    history.QInterface
+	// CommissionAccount is the bank's commission account, sponsors pay commissions to
+	CommissionAccount string
 }
 
 func New(sharedCache *cache.SharedCache, histQ history.QInterface) *CommissionsManager {
@@ -25,18 +28,35 @@ func New(sharedCache *cache.SharedCache, histQ history.QInterface) *CommissionsM
 	}
 }
 
-// sets commission for each operation
+// SetCommissionAccount sets the bank's commission account, enabling sponsored commissions
+func (cm *CommissionsManager) SetCommissionAccount(address string) *CommissionsManager {
+	cm.CommissionAccount = address
+	return cm
+}
+
+// sets commission for each operation. Sponsored operations and commission payments of their sponsors are not charged.
+// Returns SponsorshipError, if sponsorship is invalid or missing, while required by destination.
 func (cm *CommissionsManager) SetCommissions(env *xdr.TransactionEnvelope) (err error) {
 	if env == nil {
 		return errors.New("SetCommissions: tx must not be nil")
 	}
 	env.OperationFees = make([]xdr.OperationFee, len(env.Tx.Operations))
-	for i, op := range env.Tx.Operations {
-		commission, err := cm.CalculateCommissionForOperation(env.Tx.SourceAccount, op)
+	for i := 0; i < len(env.Tx.Operations); i++ {
+		commission, err := cm.CalculateCommissionForOperation(env.Tx.SourceAccount, env.Tx.Operations[i])
 		if err != nil {
 			return err
 		}
 		env.OperationFees[i] = *commission
+
+		isSponsored, err := cm.setSponsoredCommission(env, i, commission)
+		if err != nil {
+			return err
+		}
+
+		if isSponsored {
+			// commission payment of the sponsor is not charged
+			i++
+		}
 	}
 	return
 }
@@ -88,30 +108,43 @@ func (cm *CommissionsManager) getCommission(sourceId, destinationId xdr.AccountI
 
 	baseAsset := assets.ToBaseAsset(asset)
 	keys := history.CreateCommissionKeys(sourceId.Address(), destinationId.Address(), int32(sourceAccountType), int32(destAccountType), baseAsset)
-	commissions, err := cm.HistoryQ.GetHighestWeightCommission(keys)
+	commissions, err := cm.HistoryQ.GetHighestWeightCommission(keys, time.Now())
 	if err != nil {
 		log.WithStack(err).WithError(err).Error("Failed to GetHighestWeightCommission")
 		return nil, err
 	}
 	log.WithField("commissions", commissions).Debug("Got filtered commissions by weight")
-	histCommission := getSmallestFee(commissions, amount)
-
-	return histCommission, nil
+	return getSmallestFee(commissions, amount)
 }
 
 // selects smallest fee from commissions slice
-func getSmallestFee(commissions []history.Commission, amount xdr.Int64) *history.Commission {
+func getSmallestFee(commissions []history.Commission, amount xdr.Int64) (*history.Commission, error) {
 	var histCommission *history.Commission
 	fee := xdr.Int64(math.MaxInt64)
 	for _, comm := range commissions {
-		newFee := calculatePercentFee(amount, xdr.Int64(comm.PercentFee)) + xdr.Int64(comm.FlatFee)
+		newFee, _, err := calculateFee(&comm, amount)
+		if err != nil {
+			log.WithField("commission", comm).WithError(err).Error("Failed to calculate fee")
+			return nil, err
+		}
 		if newFee <= fee {
 			fee = newFee
 			histCommission = new(history.Commission)
 			*histCommission = comm
 		}
 	}
-	return histCommission
+	return histCommission, nil
+}
+
+// calculateFee returns fee to charge and percent fee of the tier payment amount falls into.
+// Fee is bounded by commission's min and max fee
+func calculateFee(commission *history.Commission, amount xdr.Int64) (xdr.Int64, xdr.Int64, error) {
+	percent, err := commission.GetPercentFee(int64(amount))
+	if err != nil {
+		return 0, 0, err
+	}
+	fee := calculatePercentFee(amount, xdr.Int64(percent)) + xdr.Int64(commission.FlatFee)
+	return xdr.Int64(commission.ApplyBounds(int64(fee))), xdr.Int64(percent), nil
 }
 
 // returns xdr.Operation fee with highest weight and lowest fee from db based on keys created from params
@@ -126,13 +159,16 @@ func (cm *CommissionsManager) CalculateCommission(source, destination xdr.Accoun
 			Type: xdr.OperationFeeTypeOpFeeNone,
 		}, nil
 	}
-	percent := xdr.Int64(commission.PercentFee)
+	fee, percent, err := calculateFee(commission, amount)
+	if err != nil {
+		return nil, err
+	}
 	flatFee := xdr.Int64(commission.FlatFee)
 	return &xdr.OperationFee{
 		Type: xdr.OperationFeeTypeOpFeeCharged,
 		Fee: &xdr.OperationFeeFee{
 			Asset:          asset,
-			AmountToCharge: calculatePercentFee(amount, percent) + xdr.Int64(flatFee),
+			AmountToCharge: fee,
 			PercentFee:     &percent,
 			FlatFee:        &flatFee,
 		},
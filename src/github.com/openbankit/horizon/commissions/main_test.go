@@ -95,8 +95,57 @@ func TestCommission(t *testing.T) {
 				PercentFee: int64(400000000),
 			},
 		}
-		comm := getSmallestFee(comms, xdr.Int64(1000000000))
+		comm, err := getSmallestFee(comms, xdr.Int64(1000000000))
+		assert.Nil(t, err)
 		assert.NotNil(t, comm)
 		assert.Equal(t, comms[0], *comm)
+		Convey("capped fee is smaller", func() {
+			comms[1].MaxFee = 10000000
+			comm, err := getSmallestFee(comms, xdr.Int64(1000000000))
+			assert.Nil(t, err)
+			assert.NotNil(t, comm)
+			assert.Equal(t, comms[1], *comm)
+		})
+	})
+	Convey("calculate fee", t, func() {
+		comm := history.Commission{
+			FlatFee:    amount.One,
+			PercentFee: amount.One, // 1%
+		}
+		err := comm.SetTiers([]history.CommissionTier{
+			{FromAmount: 1000 * amount.One, PercentFee: amount.One / 2},
+			{FromAmount: 100 * amount.One, PercentFee: 2 * amount.One},
+		})
+		assert.Nil(t, err)
+		Convey("below tiers", func() {
+			fee, percent, err := calculateFee(&comm, xdr.Int64(50*amount.One))
+			assert.Nil(t, err)
+			assert.Equal(t, xdr.Int64(amount.One), percent)
+			assert.Equal(t, xdr.Int64(1.5*amount.One), fee)
+		})
+		Convey("first tier", func() {
+			fee, percent, err := calculateFee(&comm, xdr.Int64(100*amount.One))
+			assert.Nil(t, err)
+			assert.Equal(t, xdr.Int64(2*amount.One), percent)
+			assert.Equal(t, xdr.Int64(3*amount.One), fee)
+		})
+		Convey("last tier", func() {
+			fee, percent, err := calculateFee(&comm, xdr.Int64(2000*amount.One))
+			assert.Nil(t, err)
+			assert.Equal(t, xdr.Int64(amount.One/2), percent)
+			assert.Equal(t, xdr.Int64(11*amount.One), fee)
+		})
+		Convey("min fee", func() {
+			comm.MinFee = 2 * amount.One
+			fee, _, err := calculateFee(&comm, xdr.Int64(50*amount.One))
+			assert.Nil(t, err)
+			assert.Equal(t, xdr.Int64(2*amount.One), fee)
+		})
+		Convey("max fee", func() {
+			comm.MaxFee = 5 * amount.One
+			fee, _, err := calculateFee(&comm, xdr.Int64(2000*amount.One))
+			assert.Nil(t, err)
+			assert.Equal(t, xdr.Int64(5*amount.One), fee)
+		})
 	})
 }
@@ -0,0 +1,193 @@
+package commissions
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/openbankit/go-base/amount"
+	"github.com/openbankit/go-base/xdr"
+	"github.com/openbankit/horizon/assets"
+	"github.com/openbankit/horizon/db2/history"
+	"github.com/openbankit/horizon/db2/history/details"
+)
+
+// Commission of payment can be sponsored in fee-bump style: sponsor adds payment of the commission
+// to the bank's commission account right after the sponsored operation and signs the transaction.
+// Source of the sponsored operation is not charged. Merchant can designate an account, which must
+// sponsor commissions of all payments to the merchant.
+
+// Sponsorship describes commission of operation paid by sponsor
+type Sponsorship struct {
+	Sponsor xdr.AccountId
+	Amount  xdr.Int64
+	Asset   xdr.Asset
+}
+
+// SponsorshipError is returned, if commission of operation must be sponsored, but sponsorship is missing or invalid
+type SponsorshipError struct {
+	OperationIndex int
+	Reason         string
+}
+
+func (err *SponsorshipError) Error() string {
+	return fmt.Sprintf("operation %d: %s", err.OperationIndex, err.Reason)
+}
+
+// GetSponsorship returns sponsorship of i-th operation or nil, if commission of the operation is not sponsored.
+// Operation is sponsored, if it's a payment or path payment immediately followed by payment from another
+// account to the commission account in the asset commission is charged in.
+func GetSponsorship(txSource xdr.AccountId, ops []xdr.Operation, i int, commissionAccount string) *Sponsorship {
+	if commissionAccount == "" || i+1 >= len(ops) {
+		return nil
+	}
+
+	var asset xdr.Asset
+	switch ops[i].Body.Type {
+	case xdr.OperationTypePayment:
+		payment := ops[i].Body.MustPaymentOp()
+		if payment.Destination.Address() == commissionAccount {
+			return nil
+		}
+		asset = payment.Asset
+	case xdr.OperationTypePathPayment:
+		payment := ops[i].Body.MustPathPaymentOp()
+		if payment.Destination.Address() == commissionAccount {
+			return nil
+		}
+		asset = payment.DestAsset
+	default:
+		return nil
+	}
+
+	next := ops[i+1]
+	if next.Body.Type != xdr.OperationTypePayment {
+		return nil
+	}
+
+	commission := next.Body.MustPaymentOp()
+	if commission.Destination.Address() != commissionAccount || !assets.Equals(commission.Asset, asset) {
+		return nil
+	}
+
+	sponsor := operationSource(txSource, next)
+	if sponsor.Address() == operationSource(txSource, ops[i]).Address() {
+		return nil
+	}
+
+	return &Sponsorship{
+		Sponsor: sponsor,
+		Amount:  commission.Amount,
+		Asset:   commission.Asset,
+	}
+}
+
+// GetSponsorships returns sponsorships of transaction's operations. Result is aligned with operations,
+// operations, which commissions are not sponsored, have nil sponsorship.
+func GetSponsorships(tx xdr.Transaction, commissionAccount string) []*Sponsorship {
+	result := make([]*Sponsorship, len(tx.Operations))
+	for i := range tx.Operations {
+		result[i] = GetSponsorship(tx.SourceAccount, tx.Operations, i, commissionAccount)
+	}
+	return result
+}
+
+// FeeSponsor converts sponsorship into sponsor details of the fee
+func (s *Sponsorship) FeeSponsor() *details.FeeSponsor {
+	return &details.FeeSponsor{
+		Account: s.Sponsor.Address(),
+		Amount:  amount.String(s.Amount),
+	}
+}
+
+// CommissionPayer returns account designated by destination to sponsor commissions of payments to it.
+// Returns empty string, if commission is paid by source. Payer is read from db, as account cache is
+// not invalidated, when admin changes the payer.
+func (cm *CommissionsManager) CommissionPayer(source, destination xdr.AccountId) (string, error) {
+	var account history.Account
+	err := cm.HistoryQ.AccountByAddress(&account, destination.Address())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", nil
+		}
+		return "", err
+	}
+
+	if account.CommissionPayer == source.Address() {
+		return "", nil
+	}
+	return account.CommissionPayer, nil
+}
+
+// setSponsoredCommission checks sponsorship of i-th operation against its commission and the payer designated
+// by destination. Operation is sponsored only if sponsored amount is equal to the commission. Returns true,
+// if commission is sponsored and both operations must not be charged.
+func (cm *CommissionsManager) setSponsoredCommission(env *xdr.TransactionEnvelope, i int, commission *xdr.OperationFee) (bool, error) {
+	ops := env.Tx.Operations
+	sponsorship := GetSponsorship(env.Tx.SourceAccount, ops, i, cm.CommissionAccount)
+
+	var payer string
+	destination, isPayment := paymentDestination(ops[i])
+	if isPayment && commission.Type == xdr.OperationFeeTypeOpFeeCharged {
+		var err error
+		payer, err = cm.CommissionPayer(operationSource(env.Tx.SourceAccount, ops[i]), destination)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	if sponsorship == nil {
+		if payer != "" {
+			return false, &SponsorshipError{
+				OperationIndex: i,
+				Reason:         "commission must be sponsored by " + payer,
+			}
+		}
+		return false, nil
+	}
+
+	var fee xdr.Int64
+	if commission.Type == xdr.OperationFeeTypeOpFeeCharged {
+		fee = commission.MustFee().AmountToCharge
+	}
+
+	if sponsorship.Amount != fee {
+		// payment to the commission account following the operation is not a sponsorship, unless
+		// destination requires one: source is charged as usual
+		if payer == "" {
+			return false, nil
+		}
+		return false, &SponsorshipError{
+			OperationIndex: i,
+			Reason:         "sponsored commission must be equal to " + amount.String(fee),
+		}
+	}
+
+	if payer != "" && payer != sponsorship.Sponsor.Address() {
+		return false, &SponsorshipError{
+			OperationIndex: i,
+			Reason:         "commission must be sponsored by " + payer,
+		}
+	}
+
+	env.OperationFees[i] = xdr.OperationFee{Type: xdr.OperationFeeTypeOpFeeNone}
+	env.OperationFees[i+1] = xdr.OperationFee{Type: xdr.OperationFeeTypeOpFeeNone}
+	return true, nil
+}
+
+func operationSource(txSource xdr.AccountId, op xdr.Operation) xdr.AccountId {
+	if op.SourceAccount != nil {
+		return *op.SourceAccount
+	}
+	return txSource
+}
+
+func paymentDestination(op xdr.Operation) (xdr.AccountId, bool) {
+	switch op.Body.Type {
+	case xdr.OperationTypePayment:
+		return op.Body.MustPaymentOp().Destination, true
+	case xdr.OperationTypePathPayment:
+		return op.Body.MustPathPaymentOp().Destination, true
+	default:
+		return xdr.AccountId{}, false
+	}
+}
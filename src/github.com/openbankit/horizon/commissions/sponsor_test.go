@@ -0,0 +1,174 @@
+package commissions
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/openbankit/go-base/amount"
+	"github.com/openbankit/go-base/keypair"
+	"github.com/openbankit/go-base/xdr"
+	"github.com/openbankit/horizon/cache"
+	"github.com/openbankit/horizon/db2/history"
+	"github.com/openbankit/horizon/helpers"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/mock"
+)
+
+func randomAccountId(t *testing.T) xdr.AccountId {
+	kp, err := keypair.Random()
+	if err != nil {
+		t.Fatal(err)
+	}
+	accountId, err := helpers.ParseAccountId(kp.Address())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return accountId
+}
+
+func paymentOp(source *xdr.AccountId, destination xdr.AccountId, asset xdr.Asset, paymentAmount xdr.Int64) xdr.Operation {
+	return xdr.Operation{
+		SourceAccount: source,
+		Body: xdr.OperationBody{
+			Type: xdr.OperationTypePayment,
+			PaymentOp: &xdr.PaymentOp{
+				Destination: destination,
+				Asset:       asset,
+				Amount:      paymentAmount,
+			},
+		},
+	}
+}
+
+func TestSponsorship(t *testing.T) {
+	sender := randomAccountId(t)
+	merchant := randomAccountId(t)
+	sponsor := randomAccountId(t)
+	commissionAccount := randomAccountId(t)
+	asset, err := xdr.NewAsset(xdr.AssetTypeAssetTypeCreditAlphanum4, xdr.AssetAlphaNum4{
+		AssetCode: [4]byte{'E', 'U', 'R'},
+		Issuer:    commissionAccount,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fee := xdr.Int64(amount.One)
+
+	newEnvelope := func(ops ...xdr.Operation) *xdr.TransactionEnvelope {
+		return &xdr.TransactionEnvelope{
+			Tx: xdr.Transaction{
+				SourceAccount: sender,
+				Operations:    ops,
+			},
+		}
+	}
+
+	newManager := func(commissionPayer string) *CommissionsManager {
+		historyQMock := &history.QMock{}
+		historyQMock.On("AccountByAddress", sender.Address()).Return(history.Account{
+			Address:     sender.Address(),
+			AccountType: xdr.AccountTypeAccountAnonymousUser,
+		}, nil)
+		historyQMock.On("AccountByAddress", merchant.Address()).Return(history.Account{
+			Address:         merchant.Address(),
+			AccountType:     xdr.AccountTypeAccountMerchant,
+			CommissionPayer: commissionPayer,
+		}, nil)
+		historyQMock.On("AccountByAddress", sponsor.Address()).Return(history.Account{
+			Address:     sponsor.Address(),
+			AccountType: xdr.AccountTypeAccountMerchant,
+		}, nil)
+		historyQMock.On("AccountByAddress", commissionAccount.Address()).Return(history.Account{}, sql.ErrNoRows)
+		historyQMock.On("GetHighestWeightCommission", mock.Anything, mock.Anything).Return([]history.Commission{
+			{FlatFee: int64(fee)},
+		}, nil)
+		return New(&cache.SharedCache{
+			AccountHistoryCache: cache.NewHistoryAccount(historyQMock),
+		}, historyQMock).SetCommissionAccount(commissionAccount.Address())
+	}
+
+	payment := paymentOp(nil, merchant, asset, 100*amount.One)
+	commissionPayment := paymentOp(&sponsor, commissionAccount, asset, fee)
+
+	Convey("GetSponsorship", t, func() {
+		env := newEnvelope(payment, commissionPayment)
+		Convey("sponsored", func() {
+			sponsorship := GetSponsorship(sender, env.Tx.Operations, 0, commissionAccount.Address())
+			So(sponsorship, ShouldNotBeNil)
+			So(sponsorship.Sponsor.Address(), ShouldEqual, sponsor.Address())
+			So(sponsorship.Amount, ShouldEqual, fee)
+			So(GetSponsorship(sender, env.Tx.Operations, 1, commissionAccount.Address()), ShouldBeNil)
+		})
+		Convey("commission account is not set", func() {
+			So(GetSponsorship(sender, env.Tx.Operations, 0, ""), ShouldBeNil)
+		})
+		Convey("commission is paid by sender", func() {
+			env := newEnvelope(payment, paymentOp(nil, commissionAccount, asset, fee))
+			So(GetSponsorship(sender, env.Tx.Operations, 0, commissionAccount.Address()), ShouldBeNil)
+		})
+		Convey("commission is paid in another asset", func() {
+			env := newEnvelope(payment, paymentOp(&sponsor, commissionAccount, xdr.Asset{Type: xdr.AssetTypeAssetTypeNative}, fee))
+			So(GetSponsorship(sender, env.Tx.Operations, 0, commissionAccount.Address()), ShouldBeNil)
+		})
+		Convey("aligned with operations", func() {
+			sponsorships := GetSponsorships(env.Tx, commissionAccount.Address())
+			So(len(sponsorships), ShouldEqual, 2)
+			So(sponsorships[0], ShouldNotBeNil)
+			So(sponsorships[1], ShouldBeNil)
+		})
+	})
+	Convey("SetCommissions", t, func() {
+		Convey("not sponsored", func() {
+			env := newEnvelope(payment)
+			So(newManager("").SetCommissions(env), ShouldBeNil)
+			So(env.OperationFees[0].Type, ShouldEqual, xdr.OperationFeeTypeOpFeeCharged)
+			So(env.OperationFees[0].MustFee().AmountToCharge, ShouldEqual, fee)
+		})
+		Convey("sponsored", func() {
+			env := newEnvelope(payment, commissionPayment)
+			So(newManager("").SetCommissions(env), ShouldBeNil)
+			So(env.OperationFees[0].Type, ShouldEqual, xdr.OperationFeeTypeOpFeeNone)
+			So(env.OperationFees[1].Type, ShouldEqual, xdr.OperationFeeTypeOpFeeNone)
+		})
+		Convey("payment to commission account differs from commission", func() {
+			// e.g. batch paying merchant and topping up commission account
+			env := newEnvelope(payment, paymentOp(&sponsor, commissionAccount, asset, 50*amount.One))
+			So(newManager("").SetCommissions(env), ShouldBeNil)
+			So(env.OperationFees[0].Type, ShouldEqual, xdr.OperationFeeTypeOpFeeCharged)
+			So(env.OperationFees[0].MustFee().AmountToCharge, ShouldEqual, fee)
+			So(env.OperationFees[1].Type, ShouldEqual, xdr.OperationFeeTypeOpFeeCharged)
+		})
+		Convey("sponsored amount differs from commission of designated payer", func() {
+			env := newEnvelope(payment, paymentOp(&sponsor, commissionAccount, asset, 2*fee))
+			err := newManager(sponsor.Address()).SetCommissions(env)
+			So(err, ShouldHaveSameTypeAs, &SponsorshipError{})
+			So(err.(*SponsorshipError).OperationIndex, ShouldEqual, 0)
+		})
+		Convey("sponsored by designated payer", func() {
+			env := newEnvelope(payment, commissionPayment)
+			So(newManager(sponsor.Address()).SetCommissions(env), ShouldBeNil)
+			So(env.OperationFees[0].Type, ShouldEqual, xdr.OperationFeeTypeOpFeeNone)
+		})
+		Convey("designated payer does not sponsor", func() {
+			env := newEnvelope(payment)
+			err := newManager(merchant.Address()).SetCommissions(env)
+			So(err, ShouldHaveSameTypeAs, &SponsorshipError{})
+			So(err.Error(), ShouldContainSubstring, merchant.Address())
+		})
+		Convey("payer designated after merchant was cached", func() {
+			manager := newManager(sponsor.Address())
+			manager.SharedCache.AccountHistoryCache.Add(merchant.Address(), &history.Account{
+				Address:     merchant.Address(),
+				AccountType: xdr.AccountTypeAccountMerchant,
+			})
+			payer, err := manager.CommissionPayer(sender, merchant)
+			So(err, ShouldBeNil)
+			So(payer, ShouldEqual, sponsor.Address())
+		})
+		Convey("sponsored not by designated payer", func() {
+			env := newEnvelope(payment, commissionPayment)
+			err := newManager(merchant.Address()).SetCommissions(env)
+			So(err, ShouldHaveSameTypeAs, &SponsorshipError{})
+		})
+	})
+}
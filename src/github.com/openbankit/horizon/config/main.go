@@ -1,9 +1,12 @@
 package config
 
 import (
+	"errors"
+	"reflect"
+	"time"
+
 	"github.com/PuerkitoBio/throttled"
 	"github.com/Sirupsen/logrus"
-	"time"
 )
 
 // Config is the configuration for horizon.  It get's populated by the
@@ -35,4 +38,79 @@ type Config struct {
 	StatisticsTimeout         time.Duration
 	// time flag for processed operation is stored
 	ProcessedOpTimeout        time.Duration
+	// storage of user statistics and processed operations: redis, memory or postgres
+	StatisticsStorage string
+	// interval of background statistics reconciliation between redis and history db. Disabled if zero
+	StatisticsReconcileInterval time.Duration
+	// if true, background reconciliation drops mismatching statistics from redis
+	StatisticsReconcileHeal bool
+	// storage of open transaction submissions: memory or postgres. Postgres storage is shared between horizon instances
+	SubmissionStorage string
+	// max number of submissions buffered in the default lane of submission queue. Default is used if zero
+	SubmissionQueueSize int
+	// max number of submissions of single account buffered in either lane of submission queue. Unlimited if zero
+	SubmissionQueueAccountSize int
+	// max number of submissions of bank and agents buffered in the priority lane of submission queue. Disabled if zero
+	SubmissionQueuePrioritySize int
+	// if true, webhook subscriptions may point to loopback, private and link-local addresses
+	WebhookAllowPrivate bool
+}
+
+// Validate checks that config is complete and consistent
+func (c *Config) Validate() error {
+	switch {
+	case c.DatabaseURL == "":
+		return errors.New("db-url is blank")
+	case c.StellarCoreDatabaseURL == "":
+		return errors.New("stellar-core-db-url is blank")
+	case c.StellarCoreURL == "":
+		return errors.New("stellar-core-url is blank")
+	case c.TLSCert != "" && c.TLSKey == "":
+		return errors.New("invalid TLS config: key not configured")
+	case c.TLSCert == "" && c.TLSKey != "":
+		return errors.New("invalid TLS config: cert not configured")
+	case c.Ingest && c.BankMasterKey == "":
+		return errors.New("bank-master-key is blank")
+	case c.BankCommissionKey == "":
+		return errors.New("bank-commission-key is blank")
+	case c.ProcessedOpTimeout > c.StatisticsTimeout:
+		return errors.New("processed-op-timeout must not exceed stats-timeout")
+	case c.SubmissionQueueSize < 0 || c.SubmissionQueueAccountSize < 0 || c.SubmissionQueuePrioritySize < 0:
+		return errors.New("txsub queue sizes must not be negative")
+	}
+
+	return c.AnonymousUserRestrictions.Validate()
+}
+
+// Provider returns snapshot of the effective config. Snapshot is a copy, which is safe to use,
+// while config is reloaded.
+type Provider interface {
+	Config() Config
+}
+
+// Config implements Provider for config, which is not reloaded
+func (c *Config) Config() Config {
+	return *c
+}
+
+// Reload applies settings, which are safe to change on running horizon, from next config:
+// rate limit, log level, anonymous user restrictions and admin signature validity.
+// Returns names of other fields, which differ and require restart to be applied.
+func (c *Config) Reload(next Config) []string {
+	applied := *c
+	applied.RateLimit = next.RateLimit
+	applied.LogLevel = next.LogLevel
+	applied.AnonymousUserRestrictions = next.AnonymousUserRestrictions
+	applied.AdminSignatureValid = next.AdminSignatureValid
+
+	var restartRequired []string
+	appliedValue, nextValue := reflect.ValueOf(applied), reflect.ValueOf(next)
+	for i := 0; i < appliedValue.NumField(); i++ {
+		if !reflect.DeepEqual(appliedValue.Field(i).Interface(), nextValue.Field(i).Interface()) {
+			restartRequired = append(restartRequired, appliedValue.Type().Field(i).Name)
+		}
+	}
+
+	*c = applied
+	return restartRequired
 }
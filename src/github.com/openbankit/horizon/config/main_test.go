@@ -0,0 +1,88 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/PuerkitoBio/throttled"
+	"github.com/Sirupsen/logrus"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func validConfig() Config {
+	return Config{
+		DatabaseURL:            "postgres://localhost/horizon",
+		StellarCoreDatabaseURL: "postgres://localhost/core",
+		StellarCoreURL:         "http://localhost:11626",
+		Port:                   8000,
+		LogLevel:               logrus.InfoLevel,
+		BankCommissionKey:      "GCOMMISSION",
+		AnonymousUserRestrictions: AnonymousUserRestrictions{
+			MaxDailyOutcome: 5000000000,
+		},
+		AdminSignatureValid: time.Minute,
+		StatisticsTimeout:   time.Minute,
+		ProcessedOpTimeout:  30 * time.Second,
+	}
+}
+
+func TestConfig(t *testing.T) {
+	Convey("Validate", t, func() {
+		config := validConfig()
+		So(config.Validate(), ShouldBeNil)
+
+		Convey("required urls", func() {
+			config.StellarCoreURL = ""
+			So(config.Validate(), ShouldNotBeNil)
+		})
+		Convey("tls pair", func() {
+			config.TLSCert = "cert.pem"
+			So(config.Validate(), ShouldNotBeNil)
+			config.TLSKey = "key.pem"
+			So(config.Validate(), ShouldBeNil)
+		})
+		Convey("master key for ingest", func() {
+			config.Ingest = true
+			So(config.Validate(), ShouldNotBeNil)
+			config.BankMasterKey = "GMASTER"
+			So(config.Validate(), ShouldBeNil)
+		})
+		Convey("processed op timeout", func() {
+			config.ProcessedOpTimeout = 2 * time.Minute
+			So(config.Validate(), ShouldNotBeNil)
+		})
+		Convey("negative restrictions", func() {
+			// outcome restrictions are disabled by negative value
+			config.AnonymousUserRestrictions.MaxDailyOutcome = -1
+			config.AnonymousUserRestrictions.MaxMonthlyOutcome = -1
+			config.AnonymousUserRestrictions.MaxAnnualOutcome = -1
+			So(config.Validate(), ShouldBeNil)
+			config.AnonymousUserRestrictions.MaxBalance = -1
+			So(config.Validate(), ShouldNotBeNil)
+		})
+	})
+	Convey("Reload", t, func() {
+		config := validConfig()
+		next := validConfig()
+		next.RateLimit = &throttled.RateQuota{MaxRate: throttled.PerHour(10), MaxBurst: 1}
+		next.LogLevel = logrus.DebugLevel
+		next.AnonymousUserRestrictions.MaxDailyOutcome = 100
+		next.AdminSignatureValid = 2 * time.Minute
+
+		Convey("applies safe settings", func() {
+			restartRequired := config.Reload(next)
+			So(restartRequired, ShouldBeEmpty)
+			So(config, ShouldResemble, next)
+		})
+		Convey("reports settings requiring restart", func() {
+			next.Port = 8001
+			next.DatabaseURL = "postgres://localhost/other"
+			restartRequired := config.Reload(next)
+			So(restartRequired, ShouldResemble, []string{"DatabaseURL", "Port"})
+			So(config.Port, ShouldEqual, 8000)
+			So(config.DatabaseURL, ShouldEqual, "postgres://localhost/horizon")
+			So(config.LogLevel, ShouldEqual, logrus.DebugLevel)
+			So(config.AnonymousUserRestrictions.MaxDailyOutcome, ShouldEqual, 100)
+		})
+	})
+}
@@ -1,9 +1,19 @@
 package config
 
-// AnonymousUserRestrictions holds limitations for anonymous users
+import "errors"
+
+// AnonymousUserRestrictions holds limitations for anonymous users. Negative outcome restriction means no limit.
 type AnonymousUserRestrictions struct {
 	MaxDailyOutcome   int64
 	MaxMonthlyOutcome int64
 	MaxAnnualOutcome  int64
 	MaxBalance        int64
 }
+
+// Validate checks that max balance is not negative. Unlike outcome restrictions, it can not be disabled.
+func (r AnonymousUserRestrictions) Validate() error {
+	if r.MaxBalance < 0 {
+		return errors.New("anonymous user max balance must not be negative")
+	}
+	return nil
+}
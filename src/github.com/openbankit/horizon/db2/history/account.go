@@ -8,8 +8,31 @@ import (
 	sq "github.com/lann/squirrel"
 	"encoding/json"
 	"github.com/go-errors/errors"
+	"time"
 )
 
+// Codes of reasons, account's payments can be blocked for
+const (
+	BlockReasonFraudSuspected  = "fraud_suspected"
+	BlockReasonCompliance      = "compliance"
+	BlockReasonCourtOrder      = "court_order"
+	BlockReasonCustomerRequest = "customer_request"
+	BlockReasonOther           = "other"
+)
+
+var blockReasons = map[string]bool{
+	BlockReasonFraudSuspected:  true,
+	BlockReasonCompliance:      true,
+	BlockReasonCourtOrder:      true,
+	BlockReasonCustomerRequest: true,
+	BlockReasonOther:           true,
+}
+
+// IsValidBlockReason returns true, if reason is known block reason code
+func IsValidBlockReason(reason string) bool {
+	return blockReasons[reason]
+}
+
 // Account is a row of data from the `history_accounts` table
 type Account struct {
 	TotalOrderID
@@ -18,6 +41,15 @@ type Account struct {
 	BlockIncomingPayments  bool            `db:"block_incoming_payments"`
 	BlockOutcomingPayments bool            `db:"block_outcoming_payments"`
 	LimitedAssets          null.String     `db:"limited_assets"`
+	BlockIncomingReason    string          `db:"block_incoming_reason"`
+	BlockIncomingUntil     *time.Time      `db:"block_incoming_until"`
+	BlockIncomingNote      string          `db:"block_incoming_note"`
+	BlockOutcomingReason   string          `db:"block_outcoming_reason"`
+	BlockOutcomingUntil    *time.Time      `db:"block_outcoming_until"`
+	BlockOutcomingNote     string          `db:"block_outcoming_note"`
+	// CommissionPayer is an account, which must sponsor commissions of payments to this account.
+	// Empty, if commissions are paid by senders
+	CommissionPayer string `db:"commission_payer"`
 }
 
 func NewAccount(id int64, address string, accountType xdr.AccountType) *Account {
@@ -30,6 +62,22 @@ func NewAccount(id int64, address string, accountType xdr.AccountType) *Account
 	}
 }
 
+// IsIncomingBlocked returns true, if incoming payments of the account are blocked at time t.
+// Block stops applying after it expires.
+func (r *Account) IsIncomingBlocked(t time.Time) bool {
+	return r.BlockIncomingPayments && isBlockActive(r.BlockIncomingUntil, t)
+}
+
+// IsOutcomingBlocked returns true, if outcoming payments of the account are blocked at time t.
+// Block stops applying after it expires.
+func (r *Account) IsOutcomingBlocked(t time.Time) bool {
+	return r.BlockOutcomingPayments && isBlockActive(r.BlockOutcomingUntil, t)
+}
+
+func isBlockActive(until *time.Time, t time.Time) bool {
+	return until == nil || t.Before(*until)
+}
+
 // UnmarshalDetails unmarshals the details of this effect into `dest`
 func (r *Account) UnmarshalLimitedAssets() (map[string]bool, error) {
 	result := make(map[string]bool)
@@ -130,6 +178,13 @@ func (q *Q) AccountUpdate(account *Account) error {
 		"block_incoming_payments":  account.BlockIncomingPayments,
 		"block_outcoming_payments": account.BlockOutcomingPayments,
 		"limited_assets":           account.LimitedAssets,
+		"block_incoming_reason":    account.BlockIncomingReason,
+		"block_incoming_until":     account.BlockIncomingUntil,
+		"block_incoming_note":      account.BlockIncomingNote,
+		"block_outcoming_reason":   account.BlockOutcomingReason,
+		"block_outcoming_until":    account.BlockOutcomingUntil,
+		"block_outcoming_note":     account.BlockOutcomingNote,
+		"commission_payer":         account.CommissionPayer,
 	}).Where("history_accounts.id = ?", account.ID)
 	_, err := q.Exec(sql)
 	if err != nil {
@@ -154,12 +209,15 @@ func (q *AccountsQ) Page(page db2.PageQuery) *AccountsQ {
 	return q
 }
 
-func (q *AccountsQ) Blocked() *AccountsQ {
+// BlockedAt filters accounts, which have incoming or outcoming payments blocked at time t.
+// Expired blocks are ignored.
+func (q *AccountsQ) BlockedAt(t time.Time) *AccountsQ {
 	if q.Err != nil {
 		return q
 	}
 
-	q.sql = q.sql.Where("(block_outcoming_payments = true OR block_outcoming_payments = true)")
+	q.sql = q.sql.Where("((ha.block_incoming_payments = true AND (ha.block_incoming_until IS NULL OR ha.block_incoming_until > ?))"+
+		" OR (ha.block_outcoming_payments = true AND (ha.block_outcoming_until IS NULL OR ha.block_outcoming_until > ?)))", t, t)
 	return q
 }
 
@@ -2,16 +2,27 @@ package history
 
 import sq "github.com/lann/squirrel"
 
-// GetAccountLimits returns limits row by account and asset.
+// GetAccountLimits returns limits row by account and asset, applied to payments with all counterparties.
 func (q *Q) GetAccountLimits(
 	dest interface{},
 	address string,
 	assetCode string,
+) error {
+	return q.GetAccountLimitsForCounterparty(dest, address, assetCode, AnyCounterpartyType)
+}
+
+// GetAccountLimitsForCounterparty returns limits row by account and asset, applied to payments with counterparty of specified type.
+func (q *Q) GetAccountLimitsForCounterparty(
+	dest interface{},
+	address string,
+	assetCode string,
+	counterpartyType int16,
 ) error {
 	sql := SelectAccountLimitsTemplate.Where(
-		"a.address = ? AND a.asset_code = ?",
+		"a.address = ? AND a.asset_code = ? AND a.counterparty_type = ?",
 		address,
 		assetCode,
+		counterpartyType,
 	)
 
 	err := q.Get(dest, sql)
@@ -33,9 +44,9 @@ func (q *Q) GetLimitsByAccount(dest *[]AccountLimits, address string) error {
 
 // CreateAccountLimits inserts new account_limits row
 func (q *Q) CreateAccountLimits(limits AccountLimits) error {
-	sql := CreateAccountLimitsTemplate.Values(limits.Account, limits.AssetCode,
-		limits.MaxOperationOut, limits.DailyMaxOut, limits.MonthlyMaxOut,
-		limits.MaxOperationIn, limits.DailyMaxIn, limits.MonthlyMaxIn)
+	sql := CreateAccountLimitsTemplate.Values(limits.Account, limits.AssetCode, limits.CounterpartyType,
+		limits.MaxOperationOut, limits.DailyMaxOut, limits.WeeklyMaxOut, limits.MonthlyMaxOut, limits.AnnualMaxOut,
+		limits.MaxOperationIn, limits.DailyMaxIn, limits.WeeklyMaxIn, limits.MonthlyMaxIn, limits.AnnualMaxIn)
 	_, err := q.Exec(sql)
 
 	return err
@@ -45,11 +56,15 @@ func (q *Q) CreateAccountLimits(limits AccountLimits) error {
 func (q *Q) UpdateAccountLimits(limits AccountLimits) error {
 	sql := UpdateAccountLimitsTemplate.Set("max_operation_out", limits.MaxOperationOut)
 	sql = sql.Set("daily_max_out", limits.DailyMaxOut)
+	sql = sql.Set("weekly_max_out", limits.WeeklyMaxOut)
 	sql = sql.Set("monthly_max_out", limits.MonthlyMaxOut)
+	sql = sql.Set("annual_max_out", limits.AnnualMaxOut)
 	sql = sql.Set("max_operation_in", limits.MaxOperationIn)
 	sql = sql.Set("daily_max_in", limits.DailyMaxIn)
+	sql = sql.Set("weekly_max_in", limits.WeeklyMaxIn)
 	sql = sql.Set("monthly_max_in", limits.MonthlyMaxIn)
-	sql = sql.Where("address = ? and asset_code = ?", limits.Account, limits.AssetCode)
+	sql = sql.Set("annual_max_in", limits.AnnualMaxIn)
+	sql = sql.Where("address = ? and asset_code = ? and counterparty_type = ?", limits.Account, limits.AssetCode, limits.CounterpartyType)
 
 	_, err := q.Exec(sql)
 
@@ -63,12 +78,17 @@ var SelectAccountLimitsTemplate = sq.Select("a.*").From("account_limits a")
 var CreateAccountLimitsTemplate = sq.Insert("account_limits").Columns(
 	"address",
 	"asset_code",
+	"counterparty_type",
 	"max_operation_out",
 	"daily_max_out",
+	"weekly_max_out",
 	"monthly_max_out",
+	"annual_max_out",
 	"max_operation_in",
 	"daily_max_in",
+	"weekly_max_in",
 	"monthly_max_in",
+	"annual_max_in",
 )
 
 // UpdateAccountLimitsTemplate is a prepared statement for insertion into the account_limits
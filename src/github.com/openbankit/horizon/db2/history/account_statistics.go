@@ -68,6 +68,12 @@ func (stats *AccountStatistics) GetKeyParams() []interface{} {
 	}
 }
 
+// AccountAssetPair is an account and asset code account_statistics rows are stored for
+type AccountAssetPair struct {
+	Account   string `db:"address"`
+	AssetCode string `db:"asset_code"`
+}
+
 // AccountStatisticsQ is a helper struct to aid in configuring queries that loads
 // slices of Ledger structs.
 type AccountStatisticsQ struct {
@@ -192,6 +198,84 @@ func (q *Q) GetStatisticsByAccountAndAsset(dest map[xdr.AccountType]AccountStati
 	return nil
 }
 
+// GetStatisticsAccountAssetPairs selects distinct account/asset pairs from `account_statistics`.
+// Empty address or assetCode are ignored
+func (q *Q) GetStatisticsAccountAssetPairs(dest *[]AccountAssetPair, address string, assetCode string) error {
+	sql := sq.Select("DISTINCT a.address, a.asset_code").From("account_statistics a")
+	if address != "" {
+		sql = sql.Where("a.address = ?", address)
+	}
+	if assetCode != "" {
+		sql = sql.Where("a.asset_code = ?", assetCode)
+	}
+	return q.Select(dest, sql.OrderBy("a.address, a.asset_code"))
+}
+
+// AccountStatistics provides a helper to filter rows from the `account_statistics` table
+// with pre-defined filters. See `AccountStatisticsQ` methods for the available filters.
+func (q *Q) AccountStatistics() *AccountStatisticsQ {
+	return &AccountStatisticsQ{
+		parent: q,
+		sql:    selectAccountStatisticsTemplate,
+	}
+}
+
+// ForAccount filters statistics by account's address
+func (q *AccountStatisticsQ) ForAccount(address string) *AccountStatisticsQ {
+	q.sql = q.sql.Where("a.address = ?", address)
+	return q
+}
+
+// ForAsset filters statistics by asset code
+func (q *AccountStatisticsQ) ForAsset(assetCode string) *AccountStatisticsQ {
+	q.sql = q.sql.Where("a.asset_code = ?", assetCode)
+	return q
+}
+
+// Select loads the results of the query specified by `q` into `dest`.
+func (q *AccountStatisticsQ) Select(dest interface{}) error {
+	if q.Err != nil {
+		return q.Err
+	}
+
+	q.Err = q.parent.Select(dest, q.sql)
+	return q.Err
+}
+
+// InsertAccountStatistics inserts new row into `account_statistics`
+func (q *Q) InsertAccountStatistics(stats *AccountStatistics) error {
+	_, err := q.Exec(AccountStatisticsInsert.Values(stats.GetParams()...))
+	return err
+}
+
+// UpdateAccountStatistics updates counters of `account_statistics` row
+func (q *Q) UpdateAccountStatistics(stats *AccountStatistics) error {
+	params := stats.GetParams()
+	values := make(map[string]interface{}, len(AccountStatisticsUpdateParams))
+	for i, column := range AccountStatisticsUpdateParams {
+		values[column] = params[i]
+	}
+	sql := updateAccountStatisticsTemplate.SetMap(values).Where(AccountStatisticsUpdateWhere, stats.GetKeyParams()...)
+	_, err := q.Exec(sql)
+	return err
+}
+
+// IsZero returns true, if all counters of statistics are zero
+func (stats *AccountStatistics) IsZero() bool {
+	return stats.DailyIncome == 0 && stats.DailyOutcome == 0 &&
+		stats.WeeklyIncome == 0 && stats.WeeklyOutcome == 0 &&
+		stats.MonthlyIncome == 0 && stats.MonthlyOutcome == 0 &&
+		stats.AnnualIncome == 0 && stats.AnnualOutcome == 0
+}
+
+// CountersEqual returns true, if income and outcome counters of stats and other are equal
+func (stats *AccountStatistics) CountersEqual(other *AccountStatistics) bool {
+	return stats.DailyIncome == other.DailyIncome && stats.DailyOutcome == other.DailyOutcome &&
+		stats.WeeklyIncome == other.WeeklyIncome && stats.WeeklyOutcome == other.WeeklyOutcome &&
+		stats.MonthlyIncome == other.MonthlyIncome && stats.MonthlyOutcome == other.MonthlyOutcome &&
+		stats.AnnualIncome == other.AnnualIncome && stats.AnnualOutcome == other.AnnualOutcome
+}
+
 // ClearObsoleteStats checks last update time and erases obsolete data
 func (stats *AccountStatistics) ClearObsoleteStats(now time.Time) {
 	log.WithField("now", now).WithField("updated_at", stats.UpdatedAt).Debug("Clearing obsolete")
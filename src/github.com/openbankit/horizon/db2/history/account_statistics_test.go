@@ -100,4 +100,34 @@ func TestAccountStatistics(t *testing.T) {
 			assert.Equal(t, stat, value)
 		}
 	})
+	Convey("GetStatisticsAccountAssetPairs", t, func() {
+		q := &Q{tt.HorizonRepo()}
+		pairAccount, err := keypair.Random()
+		So(err, ShouldBeNil)
+		inserter := sqx.BatchInsertFromInsert(tt.HorizonRepo(), AccountStatisticsInsert)
+		for _, asset := range []string{"EUR", "UAH"} {
+			for _, t := range []xdr.AccountType{xdr.AccountTypeAccountAnonymousUser, xdr.AccountTypeAccountBank} {
+				newStat := CreateRandomAccountStats(pairAccount.Address(), t, asset)
+				err := inserter.Insert(&newStat)
+				So(err, ShouldBeNil)
+			}
+		}
+		err = inserter.Flush()
+		So(err, ShouldBeNil)
+
+		var pairs []AccountAssetPair
+		err = q.GetStatisticsAccountAssetPairs(&pairs, pairAccount.Address(), "")
+		So(err, ShouldBeNil)
+		So(pairs, ShouldResemble, []AccountAssetPair{
+			{Account: pairAccount.Address(), AssetCode: "EUR"},
+			{Account: pairAccount.Address(), AssetCode: "UAH"},
+		})
+
+		var assetPairs []AccountAssetPair
+		err = q.GetStatisticsAccountAssetPairs(&assetPairs, pairAccount.Address(), "UAH")
+		So(err, ShouldBeNil)
+		So(assetPairs, ShouldResemble, []AccountAssetPair{
+			{Account: pairAccount.Address(), AssetCode: "UAH"},
+		})
+	})
 }
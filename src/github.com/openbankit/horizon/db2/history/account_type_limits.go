@@ -0,0 +1,147 @@
+package history
+
+import (
+	sq "github.com/lann/squirrel"
+	"github.com/openbankit/go-base/xdr"
+)
+
+// ToAccountLimits returns default limits as limits of the account
+func (l AccountTypeLimits) ToAccountLimits(address string) AccountLimits {
+	return AccountLimits{
+		Account:          address,
+		AssetCode:        l.AssetCode,
+		CounterpartyType: l.CounterpartyType,
+		MaxOperationOut:  l.MaxOperationOut,
+		DailyMaxOut:      l.DailyMaxOut,
+		WeeklyMaxOut:     l.WeeklyMaxOut,
+		MonthlyMaxOut:    l.MonthlyMaxOut,
+		AnnualMaxOut:     l.AnnualMaxOut,
+		MaxOperationIn:   l.MaxOperationIn,
+		DailyMaxIn:       l.DailyMaxIn,
+		WeeklyMaxIn:      l.WeeklyMaxIn,
+		MonthlyMaxIn:     l.MonthlyMaxIn,
+		AnnualMaxIn:      l.AnnualMaxIn,
+	}
+}
+
+// GetAccountTypeLimits returns default limits row by account type and asset, applied to payments with all counterparties.
+func (q *Q) GetAccountTypeLimits(
+	dest interface{},
+	accountType xdr.AccountType,
+	assetCode string,
+) error {
+	return q.GetAccountTypeLimitsForCounterparty(dest, accountType, assetCode, AnyCounterpartyType)
+}
+
+// GetAccountTypeLimitsForCounterparty returns default limits row by account type and asset, applied to payments with
+// counterparty of specified type.
+func (q *Q) GetAccountTypeLimitsForCounterparty(
+	dest interface{},
+	accountType xdr.AccountType,
+	assetCode string,
+	counterpartyType int16,
+) error {
+	sql := SelectAccountTypeLimitsTemplate.Where(
+		"atl.account_type = ? AND atl.asset_code = ? AND atl.counterparty_type = ?",
+		int32(accountType),
+		assetCode,
+		counterpartyType,
+	)
+
+	return q.Get(dest, sql)
+}
+
+// GetCounterpartyLimitsByAccountType selects default limits of account type for asset, which are applied only to
+// payments with counterparties of specific types
+func (q *Q) GetCounterpartyLimitsByAccountType(dest *[]AccountTypeLimits, accountType xdr.AccountType, assetCode string) error {
+	sql := SelectAccountTypeLimitsTemplate.Where(
+		"atl.account_type = ? AND atl.asset_code = ? AND atl.counterparty_type <> ?",
+		int32(accountType),
+		assetCode,
+		AnyCounterpartyType,
+	).OrderBy("atl.counterparty_type ASC")
+
+	return q.Select(dest, sql)
+}
+
+// GetAllAccountTypeLimits selects all rows from `account_type_limits`
+func (q *Q) GetAllAccountTypeLimits(dest *[]AccountTypeLimits) error {
+	sql := SelectAccountTypeLimitsTemplate.OrderBy("atl.account_type ASC", "atl.asset_code ASC", "atl.counterparty_type ASC")
+	var limits []AccountTypeLimits
+	err := q.Select(&limits, sql)
+
+	if err == nil {
+		*dest = limits
+	}
+
+	return err
+}
+
+// CreateAccountTypeLimits inserts new account_type_limits row
+func (q *Q) CreateAccountTypeLimits(limits AccountTypeLimits) error {
+	sql := CreateAccountTypeLimitsTemplate.Values(int32(limits.AccountType), limits.AssetCode, limits.CounterpartyType,
+		limits.MaxOperationOut, limits.DailyMaxOut, limits.WeeklyMaxOut, limits.MonthlyMaxOut, limits.AnnualMaxOut,
+		limits.MaxOperationIn, limits.DailyMaxIn, limits.WeeklyMaxIn, limits.MonthlyMaxIn, limits.AnnualMaxIn)
+	_, err := q.Exec(sql)
+
+	return err
+}
+
+// UpdateAccountTypeLimits updates account_type_limits row
+func (q *Q) UpdateAccountTypeLimits(limits AccountTypeLimits) error {
+	sql := UpdateAccountTypeLimitsTemplate.Set("max_operation_out", limits.MaxOperationOut)
+	sql = sql.Set("daily_max_out", limits.DailyMaxOut)
+	sql = sql.Set("weekly_max_out", limits.WeeklyMaxOut)
+	sql = sql.Set("monthly_max_out", limits.MonthlyMaxOut)
+	sql = sql.Set("annual_max_out", limits.AnnualMaxOut)
+	sql = sql.Set("max_operation_in", limits.MaxOperationIn)
+	sql = sql.Set("daily_max_in", limits.DailyMaxIn)
+	sql = sql.Set("weekly_max_in", limits.WeeklyMaxIn)
+	sql = sql.Set("monthly_max_in", limits.MonthlyMaxIn)
+	sql = sql.Set("annual_max_in", limits.AnnualMaxIn)
+	sql = sql.Where("account_type = ? and asset_code = ? and counterparty_type = ?", int32(limits.AccountType),
+		limits.AssetCode, limits.CounterpartyType)
+
+	_, err := q.Exec(sql)
+
+	return err
+}
+
+// DeleteAccountTypeLimits deletes account_type_limits row. Returns false, if row does not exist
+func (q *Q) DeleteAccountTypeLimits(accountType xdr.AccountType, assetCode string, counterpartyType int16) (bool, error) {
+	sql := DeleteAccountTypeLimitsTemplate.Where("account_type = ? and asset_code = ? and counterparty_type = ?",
+		int32(accountType), assetCode, counterpartyType)
+	result, err := q.Exec(sql)
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := result.RowsAffected()
+	return rows != 0, err
+}
+
+// SelectAccountTypeLimitsTemplate is a prepared statement for SELECT from the account_type_limits
+var SelectAccountTypeLimitsTemplate = sq.Select("atl.*").From("account_type_limits atl")
+
+// CreateAccountTypeLimitsTemplate is a prepared statement for insertion into the account_type_limits
+var CreateAccountTypeLimitsTemplate = sq.Insert("account_type_limits").Columns(
+	"account_type",
+	"asset_code",
+	"counterparty_type",
+	"max_operation_out",
+	"daily_max_out",
+	"weekly_max_out",
+	"monthly_max_out",
+	"annual_max_out",
+	"max_operation_in",
+	"daily_max_in",
+	"weekly_max_in",
+	"monthly_max_in",
+	"annual_max_in",
+)
+
+// UpdateAccountTypeLimitsTemplate is a prepared statement for update of the account_type_limits
+var UpdateAccountTypeLimitsTemplate = sq.Update("account_type_limits")
+
+// DeleteAccountTypeLimitsTemplate is a prepared statement for deletion from the account_type_limits
+var DeleteAccountTypeLimitsTemplate = sq.Delete("account_type_limits")
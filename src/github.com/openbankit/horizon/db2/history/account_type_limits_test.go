@@ -0,0 +1,96 @@
+package history
+
+import (
+	"testing"
+
+	"github.com/openbankit/go-base/xdr"
+	"github.com/openbankit/horizon/test"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestAccountTypeLimitsQ(t *testing.T) {
+	tt := test.Start(t).Scenario("base")
+	defer tt.Finish()
+
+	q := &Q{tt.HorizonRepo()}
+	Convey("Account type limits:", t, func() {
+		expected := AccountTypeLimits{
+			AccountType:      xdr.AccountTypeAccountRegisteredUser,
+			AssetCode:        "EUAH",
+			CounterpartyType: AnyCounterpartyType,
+			MaxOperationOut:  1,
+			DailyMaxOut:      2,
+			WeeklyMaxOut:     7,
+			MonthlyMaxOut:    3,
+			AnnualMaxOut:     8,
+			MaxOperationIn:   4,
+			DailyMaxIn:       5,
+			WeeklyMaxIn:      9,
+			MonthlyMaxIn:     6,
+			AnnualMaxIn:      -1,
+		}
+		Convey("Not found", func() {
+			var actual AccountTypeLimits
+			err := q.GetAccountTypeLimits(&actual, expected.AccountType, expected.AssetCode)
+			So(q.NoRows(err), ShouldBeTrue)
+			isDeleted, err := q.DeleteAccountTypeLimits(expected.AccountType, expected.AssetCode, expected.CounterpartyType)
+			So(err, ShouldBeNil)
+			So(isDeleted, ShouldBeFalse)
+		})
+		Convey("Create, update, delete", func() {
+			err := q.CreateAccountTypeLimits(expected)
+			So(err, ShouldBeNil)
+			var actual AccountTypeLimits
+			err = q.GetAccountTypeLimits(&actual, expected.AccountType, expected.AssetCode)
+			So(err, ShouldBeNil)
+			So(actual, ShouldResemble, expected)
+
+			expected.DailyMaxOut = 10
+			err = q.UpdateAccountTypeLimits(expected)
+			So(err, ShouldBeNil)
+			err = q.GetAccountTypeLimits(&actual, expected.AccountType, expected.AssetCode)
+			So(err, ShouldBeNil)
+			So(actual, ShouldResemble, expected)
+
+			var all []AccountTypeLimits
+			err = q.GetAllAccountTypeLimits(&all)
+			So(err, ShouldBeNil)
+			So(all, ShouldResemble, []AccountTypeLimits{expected})
+
+			isDeleted, err := q.DeleteAccountTypeLimits(expected.AccountType, expected.AssetCode, expected.CounterpartyType)
+			So(err, ShouldBeNil)
+			So(isDeleted, ShouldBeTrue)
+		})
+		Convey("Counterparty limits", func() {
+			counterpartyLimits := expected
+			counterpartyLimits.CounterpartyType = int16(xdr.AccountTypeAccountMerchant)
+			counterpartyLimits.DailyMaxOut = 11
+			err := q.CreateAccountTypeLimits(expected)
+			So(err, ShouldBeNil)
+			err = q.CreateAccountTypeLimits(counterpartyLimits)
+			So(err, ShouldBeNil)
+
+			var actual AccountTypeLimits
+			err = q.GetAccountTypeLimits(&actual, expected.AccountType, expected.AssetCode)
+			So(err, ShouldBeNil)
+			So(actual, ShouldResemble, expected)
+			err = q.GetAccountTypeLimitsForCounterparty(&actual, expected.AccountType, expected.AssetCode,
+				counterpartyLimits.CounterpartyType)
+			So(err, ShouldBeNil)
+			So(actual, ShouldResemble, counterpartyLimits)
+
+			var all []AccountTypeLimits
+			err = q.GetCounterpartyLimitsByAccountType(&all, expected.AccountType, expected.AssetCode)
+			So(err, ShouldBeNil)
+			So(all, ShouldResemble, []AccountTypeLimits{counterpartyLimits})
+
+			isDeleted, err := q.DeleteAccountTypeLimits(expected.AccountType, expected.AssetCode,
+				counterpartyLimits.CounterpartyType)
+			So(err, ShouldBeNil)
+			So(isDeleted, ShouldBeTrue)
+			err = q.GetAccountTypeLimits(&actual, expected.AccountType, expected.AssetCode)
+			So(err, ShouldBeNil)
+			So(actual, ShouldResemble, expected)
+		})
+	})
+}
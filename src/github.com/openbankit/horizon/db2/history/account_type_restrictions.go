@@ -0,0 +1,70 @@
+package history
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/openbankit/go-base/xdr"
+	"github.com/openbankit/horizon/accounttypes"
+)
+
+// AccountTypeRestrictions describes which account types are allowed to send payments to which.
+// Stored in options as json object, where key is account type of sender and value - list of allowed
+// destination account types.
+type AccountTypeRestrictions Options
+
+// NewAccountTypeRestrictions creates restrictions populated with default payment matrix
+func NewAccountTypeRestrictions() *AccountTypeRestrictions {
+	result := AccountTypeRestrictions(Options{
+		Name: OPTIONS_ACCOUNT_TYPE_RESTRICTIONS,
+	})
+	result.SetRestrictions(accounttype.GetDefaultPaymentRestrictions())
+	return &result
+}
+
+func (r *AccountTypeRestrictions) GetRestrictions() (map[xdr.AccountType][]xdr.AccountType, error) {
+	var rawRestrictions map[string][]xdr.AccountType
+	err := json.Unmarshal([]byte(r.Data), &rawRestrictions)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[xdr.AccountType][]xdr.AccountType, len(rawRestrictions))
+	for rawFrom, to := range rawRestrictions {
+		from, err := strconv.ParseInt(rawFrom, 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		result[xdr.AccountType(from)] = to
+	}
+	return result, nil
+}
+
+func (r *AccountTypeRestrictions) SetRestrictions(restrictions map[xdr.AccountType][]xdr.AccountType) error {
+	rawRestrictions := make(map[string][]xdr.AccountType, len(restrictions))
+	for from, to := range restrictions {
+		rawRestrictions[strconv.FormatInt(int64(from), 10)] = to
+	}
+
+	data, err := json.Marshal(rawRestrictions)
+	if err != nil {
+		return err
+	}
+	r.Data = string(data)
+	return nil
+}
+
+// IsAllowed returns true, if payments from account type `from` to account type `to` are allowed
+func (r *AccountTypeRestrictions) IsAllowed(from, to xdr.AccountType) (bool, error) {
+	restrictions, err := r.GetRestrictions()
+	if err != nil {
+		return false, err
+	}
+
+	for _, allowed := range restrictions[from] {
+		if allowed == to {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -2,15 +2,26 @@ package history
 
 import (
 	sq "github.com/lann/squirrel"
+	"github.com/openbankit/horizon/db2"
 	"github.com/openbankit/horizon/log"
 )
 
+// AuditLogQ is a helper struct to aid in configuring queries that loads
+// slices of AuditLog.
+type AuditLogQ struct {
+	Err    error
+	parent *Q
+	sql    sq.SelectBuilder
+}
+
 // CreateAuditLogEntry adds row to audit_log
 func (q *Q) CreateAuditLogEntry(auditLog *AuditLog) error {
 	if auditLog == nil {
 		log.Warn("Tring to insern nil in audit log")
+		return nil
 	}
-	sql := createAuditLogEntry.Values(auditLog.Actor, auditLog.Subject, auditLog.Action, auditLog.Meta)
+	sql := createAuditLogEntry.Values(auditLog.Actor, auditLog.Subject, auditLog.Action, auditLog.Meta,
+		auditLog.TxHash, nullIfEmpty(auditLog.Before), nullIfEmpty(auditLog.After))
 	_, err := q.Exec(sql)
 
 	return err
@@ -27,10 +38,84 @@ func (q *Q) DeleteAuditLog() error {
 	return err
 }
 
+// AuditLogs provides a helper to filter rows from the `audit_log`
+// table with pre-defined filters.
+func (q *Q) AuditLogs() *AuditLogQ {
+	return &AuditLogQ{
+		parent: q,
+		sql:    selectAuditLog,
+	}
+}
+
+// ForActor filters the audit log to entries created by specific actor
+func (q *AuditLogQ) ForActor(actor string) *AuditLogQ {
+	q.sql = q.sql.Where("aud.actor = ?", actor)
+	return q
+}
+
+// ForSubject filters the audit log to entries for specific subject
+func (q *AuditLogQ) ForSubject(subject string) *AuditLogQ {
+	q.sql = q.sql.Where("aud.subject = ?", subject)
+	return q
+}
+
+// ForTransaction filters the audit log to entries caused by specific transaction
+func (q *AuditLogQ) ForTransaction(txHash string) *AuditLogQ {
+	q.sql = q.sql.Where("aud.tx_hash = ?", txHash)
+	return q
+}
+
+// ForAction filters the audit log to entries with specific action performed
+func (q *AuditLogQ) ForAction(action string) *AuditLogQ {
+	q.sql = q.sql.Where("aud.action = ?", action)
+	return q
+}
+
+// CreatedAt filters the audit log to entries created in specified time range
+func (q *AuditLogQ) CreatedAt(createdAt db2.CloseAtQuery) *AuditLogQ {
+	if q.Err != nil {
+		return q
+	}
+	q.sql, q.Err = createdAt.ApplyTo(q.sql, "aud.created_at")
+	return q
+}
+
+// Page specifies the paging constraints for the query being built by `q`.
+func (q *AuditLogQ) Page(page db2.PageQuery) *AuditLogQ {
+	if q.Err != nil {
+		return q
+	}
+
+	q.sql, q.Err = page.ApplyTo(q.sql, "aud.id")
+	return q
+}
+
+// Select loads the results of the query specified by `q` into `dest`.
+func (q *AuditLogQ) Select(dest interface{}) error {
+	if q.Err != nil {
+		return q.Err
+	}
+
+	q.Err = q.parent.Select(dest, q.sql)
+	return q.Err
+}
+
 var selectAuditLog = sq.Select("aud.*").From("audit_log aud")
+
+// nullIfEmpty returns json null for empty snapshot
+func nullIfEmpty(snapshot string) string {
+	if snapshot == "" {
+		return "null"
+	}
+	return snapshot
+}
+
 var createAuditLogEntry = sq.Insert("audit_log").Columns(
 	"actor",
 	"subject",
 	"action",
 	"meta",
+	"tx_hash",
+	"before_state",
+	"after_state",
 )
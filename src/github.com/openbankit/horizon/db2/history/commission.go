@@ -4,6 +4,8 @@ import (
 	"github.com/openbankit/horizon/log"
 	"encoding/json"
 	"github.com/go-errors/errors"
+	"sort"
+	"time"
 )
 
 func NewCommission(key CommissionKey, flatFee, percentFee int64) (*Commission, error) {
@@ -17,17 +19,131 @@ func NewCommission(key CommissionKey, flatFee, percentFee int64) (*Commission, e
 		KeyValue:   hashData,
 		FlatFee:    flatFee,
 		PercentFee: percentFee,
+		Tiers:      "[]",
 	}, nil
 }
 
+// CommissionTier sets percent fee charged for payments with amount greater or equal to FromAmount
+type CommissionTier struct {
+	FromAmount int64 `json:"from_amount"`
+	PercentFee int64 `json:"percent_fee"`
+}
+
+type ByFromAmount []CommissionTier
+
+func (a ByFromAmount) Len() int           { return len(a) }
+func (a ByFromAmount) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+func (a ByFromAmount) Less(i, j int) bool { return a[i].FromAmount < a[j].FromAmount }
+
+// GetTiers returns commission tiers sorted by FromAmount
+func (c *Commission) GetTiers() ([]CommissionTier, error) {
+	var tiers []CommissionTier
+	if c.Tiers == "" {
+		return tiers, nil
+	}
+	err := json.Unmarshal([]byte(c.Tiers), &tiers)
+	if err != nil {
+		return nil, errors.Wrap(err, 1)
+	}
+	sort.Sort(ByFromAmount(tiers))
+	return tiers, nil
+}
+
+// SetTiers sorts tiers by FromAmount and stores them into commission
+func (c *Commission) SetTiers(tiers []CommissionTier) error {
+	sorted := make([]CommissionTier, len(tiers))
+	copy(sorted, tiers)
+	sort.Sort(ByFromAmount(sorted))
+	rawTiers, err := json.Marshal(sorted)
+	if err != nil {
+		return errors.Wrap(err, 1)
+	}
+	c.Tiers = string(rawTiers)
+	return nil
+}
+
+// rawTiers returns tiers json to be stored in db
+func (c *Commission) rawTiers() string {
+	if c.Tiers == "" {
+		return "[]"
+	}
+	return c.Tiers
+}
+
+// GetPercentFee returns percent fee of the tier amount falls into. If amount is below all tiers, returns PercentFee
+func (c *Commission) GetPercentFee(amount int64) (int64, error) {
+	tiers, err := c.GetTiers()
+	if err != nil {
+		return 0, err
+	}
+	percentFee := c.PercentFee
+	for _, tier := range tiers {
+		if amount < tier.FromAmount {
+			break
+		}
+		percentFee = tier.PercentFee
+	}
+	return percentFee, nil
+}
+
+// ApplyBounds returns fee bounded by MinFee and MaxFee
+func (c *Commission) ApplyBounds(fee int64) int64 {
+	if fee < c.MinFee {
+		fee = c.MinFee
+	}
+	if c.MaxFee > 0 && fee > c.MaxFee {
+		fee = c.MaxFee
+	}
+	return fee
+}
+
+// IsActive returns true, if commission is active at time t
+func (c *Commission) IsActive(t time.Time) bool {
+	if c.ValidFrom != nil && t.Before(*c.ValidFrom) {
+		return false
+	}
+	return c.ValidUntil == nil || t.Before(*c.ValidUntil)
+}
+
 func (c Commission) Equals(o Commission) bool {
 	if c.KeyHash != o.KeyHash || c.FlatFee != o.FlatFee || c.PercentFee != o.PercentFee {
 		return false
 	}
+	if c.MinFee != o.MinFee || c.MaxFee != o.MaxFee || !c.tiersEqual(o) {
+		return false
+	}
+	if !timeEquals(c.ValidFrom, o.ValidFrom) || !timeEquals(c.ValidUntil, o.ValidUntil) {
+		return false
+	}
 	cKey := c.GetKey()
 	return cKey.Equals(o.GetKey())
 }
 
+// tiersEqual compares decoded tiers, as jsonb does not preserve formatting
+func (c Commission) tiersEqual(o Commission) bool {
+	cTiers, err := c.GetTiers()
+	if err != nil {
+		return false
+	}
+	oTiers, err := o.GetTiers()
+	if err != nil || len(cTiers) != len(oTiers) {
+		return false
+	}
+	for i := range cTiers {
+		if cTiers[i] != oTiers[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func timeEquals(l, r *time.Time) bool {
+	if l == nil || r == nil {
+		return r == l
+	}
+	return l.Equal(*r)
+}
+
 // UnmarshalDetails unmarshals the details of this effect into `dest`
 func (r *Commission) UnmarshalKeyDetails(dest interface{}) error {
 
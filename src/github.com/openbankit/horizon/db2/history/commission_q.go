@@ -9,6 +9,7 @@ import (
 	sq "github.com/lann/squirrel"
 	"sort"
 	"strings"
+	"time"
 )
 
 // CommissionQ is a helper struct to aid in configuring queries that loads
@@ -58,6 +59,12 @@ func (q *CommissionQ) ForAsset(asset details.Asset) *CommissionQ {
 	return q
 }
 
+// ActiveAt filters the query to only commissions active at specified time
+func (q *CommissionQ) ActiveAt(t time.Time) *CommissionQ {
+	q.sql = q.sql.Where("(com.valid_from IS NULL OR com.valid_from <= ?) AND (com.valid_until IS NULL OR com.valid_until > ?)", t, t)
+	return q
+}
+
 // Page specifies the paging constraints for the query being built by `q`.
 func (q *CommissionQ) Page(page db2.PageQuery) *CommissionQ {
 	if q.Err != nil {
@@ -89,7 +96,8 @@ func (q *Q) InsertCommission(commission *Commission) (err error) {
 		return
 	}
 
-	insert := insertCommission.Values(commission.KeyHash, commission.KeyValue, commission.FlatFee, commission.PercentFee)
+	insert := insertCommission.Values(commission.KeyHash, commission.KeyValue, commission.FlatFee, commission.PercentFee,
+		commission.MinFee, commission.MaxFee, commission.rawTiers(), commission.ValidFrom, commission.ValidUntil)
 	_, err = q.Exec(insert)
 	if err != nil {
 		log.WithStack(err).WithError(err).WithField("commission", *commission).Error("Failed to insert commission")
@@ -105,7 +113,12 @@ func (q *Q) UpdateCommission(commission *Commission) (bool, error) {
 		"key_value":   commission.KeyValue,
 		"flat_fee":    commission.FlatFee,
 		"percent_fee": commission.PercentFee,
-	}).Where("key_hash = ?", commission.KeyHash)
+		"min_fee":     commission.MinFee,
+		"max_fee":     commission.MaxFee,
+		"tiers":       commission.rawTiers(),
+		"valid_from":  commission.ValidFrom,
+		"valid_until": commission.ValidUntil,
+	}).Where("id = ?", commission.ID)
 	result, err := q.Exec(update)
 	if err != nil {
 		log.WithStack(err).WithField("commission", *commission).WithError(err).Error("Failed to update commission")
@@ -119,8 +132,9 @@ func (q *Q) UpdateCommission(commission *Commission) (bool, error) {
 	return rows > 0, nil
 }
 
-func (q *Q) DeleteCommission(hash string) (bool, error) {
-	deleteQ := deleteCommission.Where("key_hash = ?", hash)
+// DeleteCommission deletes commission by id
+func (q *Q) DeleteCommission(id int64) (bool, error) {
+	deleteQ := deleteCommission.Where("id = ?", id)
 	result, err := q.Exec(deleteQ)
 	if err != nil {
 		log.WithStack(err).WithError(err).Error("Failed to delete commission")
@@ -163,8 +177,13 @@ func (q *Q) CommissionByKey(keys map[string]CommissionKey) (resultingCommissions
 	return resultingCommissions, nil
 }
 
-func (q *Q) CommissionByHash(hash string) (*Commission, error) {
-	sql := selectCommission.Where("com.key_hash = ?", hash)
+// CommissionByHash selects commission by hash of key and validity period. Commissions with the same key and
+// different validity periods are different rules.
+func (q *Q) CommissionByHash(hash string, validFrom, validUntil *time.Time) (*Commission, error) {
+	sql := selectCommission.Where(
+		"com.key_hash = ? AND com.valid_from IS NOT DISTINCT FROM ? AND com.valid_until IS NOT DISTINCT FROM ?",
+		hash, validFrom, validUntil,
+	)
 	var storedCommissions []Commission
 	err := q.Select(&storedCommissions, sql)
 	if err != nil {
@@ -183,13 +202,24 @@ func (q *Q) DeleteCommissions() error {
 	return err
 }
 
-func (q *Q) GetHighestWeightCommission(keys map[string]CommissionKey) (resultingCommissions []Commission, err error) {
+// GetHighestWeightCommission returns commissions with highest weight among active at `now`
+func (q *Q) GetHighestWeightCommission(keys map[string]CommissionKey, now time.Time) (resultingCommissions []Commission, err error) {
 	rawCommissions, err := q.CommissionByKey(keys)
 	if err != nil {
 		return
 	}
 	log.WithField("len", len(rawCommissions)).Debug("Got commissions")
-	return filterByWeight(rawCommissions), nil
+	return filterByWeight(filterActive(rawCommissions, now)), nil
+}
+
+func filterActive(rawCommissions []Commission, now time.Time) []Commission {
+	result := make([]Commission, 0, len(rawCommissions))
+	for _, commission := range rawCommissions {
+		if commission.IsActive(now) {
+			result = append(result, commission)
+		}
+	}
+	return result
 }
 
 type ByWeight []Commission
@@ -229,6 +259,7 @@ func getHashes(keys map[string]CommissionKey) []interface{} {
 }
 
 var selectCommission = sq.Select("com.*").From("commission com")
-var insertCommission = sq.Insert("commission").Columns("key_hash", "key_value", "flat_fee", "percent_fee")
+var insertCommission = sq.Insert("commission").Columns("key_hash", "key_value", "flat_fee", "percent_fee", "min_fee", "max_fee", "tiers",
+	"valid_from", "valid_until")
 var updateCommission = sq.Update("commission")
 var deleteCommission = sq.Delete("commission")
@@ -8,6 +8,7 @@ import (
 	. "github.com/smartystreets/goconvey/convey"
 	"github.com/stretchr/testify/assert"
 	"math/rand"
+	"time"
 )
 
 func TestCommissionQ(t *testing.T) {
@@ -25,27 +26,76 @@ func TestCommissionQ(t *testing.T) {
 		commission, err := NewCommission(commissionKey, rand.Int63(), rand.Int63())
 		So(err, ShouldBeNil)
 		Convey("By hash returns nil - if no commission", func() {
-			stored, err := h.CommissionByHash(commission.KeyHash)
+			stored, err := h.CommissionByHash(commission.KeyHash, nil, nil)
 			So(err, ShouldBeNil)
 			So(stored, ShouldBeNil)
 		})
 		err = h.InsertCommission(commission)
 		So(err, ShouldBeNil)
 		Convey("Returns correct value", func() {
-			stored, err := h.CommissionByHash(commission.KeyHash)
+			stored, err := h.CommissionByHash(commission.KeyHash, nil, nil)
 			So(err, ShouldBeNil)
 			So(stored.FlatFee, ShouldEqual, commission.FlatFee)
 			So(stored.PercentFee, ShouldEqual, commission.PercentFee)
 			So(stored.KeyHash, ShouldEqual, commission.KeyHash)
 		})
 
-		isDeleted, err := h.DeleteCommission(commission.KeyHash)
+		stored, err := h.CommissionByHash(commission.KeyHash, nil, nil)
+		So(err, ShouldBeNil)
+		isDeleted, err := h.DeleteCommission(stored.ID)
 		So(err, ShouldBeNil)
 		So(isDeleted, ShouldBeTrue)
 		Convey("Return nil after deleted", func() {
-			stored, err := h.CommissionByHash(commission.KeyHash)
+			stored, err := h.CommissionByHash(commission.KeyHash, nil, nil)
 			So(err, ShouldBeNil)
 			So(stored, ShouldBeNil)
 		})
 	})
+	Convey("Validity period is part of commission's identity:", t, func() {
+		commissionKey := CommissionKey{
+			From: account.Address(),
+		}
+		standing, err := NewCommission(commissionKey, 100, 0)
+		So(err, ShouldBeNil)
+		err = h.InsertCommission(standing)
+		So(err, ShouldBeNil)
+
+		validFrom := time.Date(2016, 10, 1, 0, 0, 0, 0, time.UTC)
+		validUntil := time.Date(2016, 11, 1, 0, 0, 0, 0, time.UTC)
+		scheduled, err := NewCommission(commissionKey, 10, 0)
+		So(err, ShouldBeNil)
+		scheduled.ValidFrom = &validFrom
+		scheduled.ValidUntil = &validUntil
+		err = h.InsertCommission(scheduled)
+		So(err, ShouldBeNil)
+
+		// same key and validity period can not be inserted twice
+		So(h.InsertCommission(scheduled), ShouldNotBeNil)
+
+		storedStanding, err := h.CommissionByHash(standing.KeyHash, nil, nil)
+		So(err, ShouldBeNil)
+		So(storedStanding.FlatFee, ShouldEqual, 100)
+		storedScheduled, err := h.CommissionByHash(scheduled.KeyHash, &validFrom, &validUntil)
+		So(err, ShouldBeNil)
+		So(storedScheduled.FlatFee, ShouldEqual, 10)
+		storedOther, err := h.CommissionByHash(scheduled.KeyHash, &validFrom, nil)
+		So(err, ShouldBeNil)
+		So(storedOther, ShouldBeNil)
+
+		storedScheduled.FlatFee = 20
+		updated, err := h.UpdateCommission(storedScheduled)
+		So(err, ShouldBeNil)
+		So(updated, ShouldBeTrue)
+		storedStanding, err = h.CommissionByHash(standing.KeyHash, nil, nil)
+		So(err, ShouldBeNil)
+		So(storedStanding.FlatFee, ShouldEqual, 100)
+
+		isDeleted, err := h.DeleteCommission(storedScheduled.ID)
+		So(err, ShouldBeNil)
+		So(isDeleted, ShouldBeTrue)
+		storedStanding, err = h.CommissionByHash(standing.KeyHash, nil, nil)
+		So(err, ShouldBeNil)
+		So(storedStanding, ShouldNotBeNil)
+		So(h.DeleteCommissions(), ShouldBeNil)
+	})
 }
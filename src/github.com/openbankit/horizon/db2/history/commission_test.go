@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"math/rand"
 	"testing"
+	"time"
 )
 
 func TestCommissionHash(t *testing.T) {
@@ -151,6 +152,22 @@ func TestCommissionStore(t *testing.T) {
 		filtered = filterByWeight(rawCommissions)
 		assert.Equal(t, 2, len(filtered))
 	})
+	Convey("filter active", t, func() {
+		now := time.Now()
+		past := now.Add(-time.Hour)
+		future := now.Add(time.Hour)
+		rawCommissions := []Commission{
+			Commission{FlatFee: 1},
+			Commission{FlatFee: 2, ValidFrom: &past, ValidUntil: &future},
+			Commission{FlatFee: 3, ValidFrom: &future},
+			Commission{FlatFee: 4, ValidUntil: &past},
+			Commission{FlatFee: 5, ValidUntil: &now},
+		}
+		filtered := filterActive(rawCommissions, now)
+		assert.Equal(t, rawCommissions[:2], filtered)
+		filtered = filterActive(rawCommissions, future)
+		assert.Equal(t, []Commission{rawCommissions[0], rawCommissions[2]}, filtered)
+	})
 }
 
 func TestCommissionSelector(t *testing.T) {
@@ -238,6 +255,27 @@ func TestCommissionSelector(t *testing.T) {
 		assert.Equal(t, 1, len(comms))
 		assert.True(t, commission.Equals(comms[0]))
 	})
+	Convey("active at", t, func() {
+		validFrom := time.Now().Add(time.Hour)
+		validUntil := validFrom.Add(time.Hour)
+		promoAccountType := accountType + 2
+		promo, err := NewCommission(CommissionKey{
+			FromType: &promoAccountType,
+		}, amount.One, 0)
+		assert.Nil(t, err)
+		promo.ValidFrom = &validFrom
+		promo.ValidUntil = &validUntil
+		err = q.InsertCommission(promo)
+		assert.Nil(t, err)
+		var comms []Commission
+		err = q.Commissions().ForAccountType(promoAccountType).ActiveAt(time.Now()).Select(&comms)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(comms))
+		err = q.Commissions().ForAccountType(promoAccountType).ActiveAt(validFrom).Select(&comms)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(comms))
+		assert.True(t, promo.Equals(comms[0]))
+	})
 	//err = q.deleteCommissions()
 	assert.Nil(t, err)
 	keys := CreateCommissionKeys(getRandomAccountId(t), getRandomAccountId(t), int32(1), int32(2), details.Asset{
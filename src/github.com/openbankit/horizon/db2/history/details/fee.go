@@ -11,6 +11,14 @@ type Fee struct {
 	AmountCharged *string `json:"amount_changed,omitempty"`
 	FlatFee       *string `json:"flat_fee,omitempty"`
 	PercentFee    *string `json:"percent_fee,omitempty"`
+	// Sponsor is set, if commission of the operation was paid by another account instead of operation source
+	Sponsor *FeeSponsor `json:"sponsor,omitempty"`
+}
+
+// FeeSponsor is an account, which paid commission of the operation, and the amount paid
+type FeeSponsor struct {
+	Account string `json:"account"`
+	Amount  string `json:"amount"`
 }
 
 var FeeTypeNames = map[xdr.OperationFeeType]string{
@@ -33,6 +41,13 @@ func (f *Fee) ToMap() (details map[string]interface{}) {
 	if f.PercentFee != nil {
 		details["percent_fee"] = *f.PercentFee
 	}
+
+	if f.Sponsor != nil {
+		details["sponsor"] = map[string]interface{}{
+			"account": f.Sponsor.Account,
+			"amount":  f.Sponsor.Amount,
+		}
+	}
 	return
 }
 
@@ -14,19 +14,39 @@ import (
 // portion of the horizon database.
 type QInterface interface {
 	// Account limits
-	// GetAccountLimits returns limits row by account and asset.
+	// GetAccountLimits returns limits row by account and asset, applied to payments with all counterparties.
 	GetAccountLimits(dest interface{}, address string, assetCode string) error
+	// GetAccountLimitsForCounterparty returns limits row by account and asset, applied to payments with counterparty of specified type
+	GetAccountLimitsForCounterparty(dest interface{}, address string, assetCode string, counterpartyType int16) error
+	// GetLimitsByAccount returns all limits rows of the account
+	GetLimitsByAccount(dest *[]AccountLimits, address string) error
 	// Inserts new account limits instance
 	CreateAccountLimits(limits AccountLimits) error
 	// Updates account's limits
 	UpdateAccountLimits(limits AccountLimits) error
 
+	// Account type limits
+	// GetAccountTypeLimits returns default limits row by account type and asset, applied to payments with all counterparties.
+	GetAccountTypeLimits(dest interface{}, accountType xdr.AccountType, assetCode string) error
+	// GetAccountTypeLimitsForCounterparty returns default limits row by account type and asset, applied to payments with counterparty of specified type
+	GetAccountTypeLimitsForCounterparty(dest interface{}, accountType xdr.AccountType, assetCode string, counterpartyType int16) error
+	// GetCounterpartyLimitsByAccountType selects default limits of account type for asset, which are applied only to payments with counterparties of specific types
+	GetCounterpartyLimitsByAccountType(dest *[]AccountTypeLimits, accountType xdr.AccountType, assetCode string) error
+	// Inserts new account type limits instance
+	CreateAccountTypeLimits(limits AccountTypeLimits) error
+	// Updates account type limits
+	UpdateAccountTypeLimits(limits AccountTypeLimits) error
+	// Deletes account type limits
+	DeleteAccountTypeLimits(accountType xdr.AccountType, assetCode string, counterpartyType int16) (bool, error)
+
 	// Account statistics
 	// GetStatisticsByAccountAndAsset selects rows from `account_statistics` by address and asset code
 	// Now is used to clear obsolete stats
 	GetStatisticsByAccountAndAsset(dest map[xdr.AccountType]AccountStatistics, addy string, assetCode string, now time.Time) error
 	// Returns account's statistics for assetCode and counterparty type
 	GetAccountStatistics(address string, assetCode string, counterPartyType xdr.AccountType) (AccountStatistics, error)
+	// Returns distinct account/asset pairs statistics is stored for. Empty address or assetCode are ignored
+	GetStatisticsAccountAssetPairs(dest *[]AccountAssetPair, address string, assetCode string) error
 
 	// Asset
 	// Returns asset for specified xdr.Asset
@@ -49,16 +69,19 @@ type QInterface interface {
 	AccountUpdate(account *Account) error
 
 	// Commission
-	// selects commission by hash
-	CommissionByHash(hash string) (*Commission, error)
+	// selects commission by hash and validity period
+	CommissionByHash(hash string, validFrom, validUntil *time.Time) (*Commission, error)
 	// Inserts new commission
 	InsertCommission(commission *Commission) (err error)
-	// Deletes commission
-	DeleteCommission(hash string) (bool, error)
+	// Deletes commission by id
+	DeleteCommission(id int64) (bool, error)
 	// update commission
 	UpdateCommission(commission *Commission) (bool, error)
+	// CreateAuditLogEntry adds row to audit_log
+	CreateAuditLogEntry(auditLog *AuditLog) error
+
 	// get highest weight commission
-	GetHighestWeightCommission(keys map[string]CommissionKey) (resultingCommissions []Commission, err error)
+	GetHighestWeightCommission(keys map[string]CommissionKey, now time.Time) (resultingCommissions []Commission, err error)
 
 
 	// Tries to get operation by id. If does not exists returns sql.ErrNoRows
@@ -70,6 +93,14 @@ type QInterface interface {
 	OptionsInsert(options *Options) (err error)
 	OptionsUpdate(options *Options) (bool, error)
 	OptionsDelete(name string) (bool, error)
+
+	// Savepoints
+	// Creates savepoint in the current transaction
+	Savepoint(name string) error
+	// Undoes all changes made after savepoint was created
+	RollbackToSavepoint(name string) error
+	// Destroys savepoint, keeping changes made after it
+	ReleaseSavepoint(name string) error
 }
 
 // Q is default implementation of QInterface
@@ -102,14 +133,42 @@ func (q *Q) OldestOutdatedLedgers(dest interface{}, currentVersion int) error {
 // AccountLimits contains limits for account set by the admin of a bank and
 // is a row of data from the `account_limits` table
 type AccountLimits struct {
-	Account         string `db:"address"`
-	AssetCode       string `db:"asset_code"`
-	MaxOperationOut int64  `db:"max_operation_out"`
-	DailyMaxOut     int64  `db:"daily_max_out"`
-	MonthlyMaxOut   int64  `db:"monthly_max_out"`
-	MaxOperationIn  int64  `db:"max_operation_in"`
-	DailyMaxIn      int64  `db:"daily_max_in"`
-	MonthlyMaxIn    int64  `db:"monthly_max_in"`
+	Account   string `db:"address"`
+	AssetCode string `db:"asset_code"`
+	// limits are applied only to payments with counterparty of this type. AnyCounterpartyType - to all payments
+	CounterpartyType int16 `db:"counterparty_type"`
+	MaxOperationOut  int64 `db:"max_operation_out"`
+	DailyMaxOut      int64 `db:"daily_max_out"`
+	WeeklyMaxOut     int64 `db:"weekly_max_out"`
+	MonthlyMaxOut    int64 `db:"monthly_max_out"`
+	AnnualMaxOut     int64 `db:"annual_max_out"`
+	MaxOperationIn   int64 `db:"max_operation_in"`
+	DailyMaxIn       int64 `db:"daily_max_in"`
+	WeeklyMaxIn      int64 `db:"weekly_max_in"`
+	MonthlyMaxIn     int64 `db:"monthly_max_in"`
+	AnnualMaxIn      int64 `db:"annual_max_in"`
+}
+
+// AnyCounterpartyType is used as counterparty type of limits applied to payments with all counterparties
+const AnyCounterpartyType int16 = -1
+
+// AccountTypeLimits contains default limits for all accounts of specified type set by the admin of a bank and
+// is a row of data from the `account_type_limits` table
+type AccountTypeLimits struct {
+	AccountType xdr.AccountType `db:"account_type"`
+	AssetCode   string          `db:"asset_code"`
+	// limits are applied only to payments with counterparty of this type. AnyCounterpartyType - to all payments
+	CounterpartyType int16 `db:"counterparty_type"`
+	MaxOperationOut  int64 `db:"max_operation_out"`
+	DailyMaxOut      int64 `db:"daily_max_out"`
+	WeeklyMaxOut     int64 `db:"weekly_max_out"`
+	MonthlyMaxOut    int64 `db:"monthly_max_out"`
+	AnnualMaxOut     int64 `db:"annual_max_out"`
+	MaxOperationIn   int64 `db:"max_operation_in"`
+	DailyMaxIn       int64 `db:"daily_max_in"`
+	WeeklyMaxIn      int64 `db:"weekly_max_in"`
+	MonthlyMaxIn     int64 `db:"monthly_max_in"`
+	AnnualMaxIn      int64 `db:"annual_max_in"`
 }
 
 // AccountLimitsQ is a helper struct to aid in configuring queries that loads
@@ -126,16 +185,27 @@ type Commission struct {
 	KeyValue   string `db:"key_value"`
 	FlatFee    int64  `db:"flat_fee"`
 	PercentFee int64  `db:"percent_fee"`
+	// MinFee and MaxFee bound total fee charged. Fee is not capped, if MaxFee is 0
+	MinFee int64 `db:"min_fee"`
+	MaxFee int64 `db:"max_fee"`
+	// Tiers is json encoded list of CommissionTier
+	Tiers string `db:"tiers"`
+	// ValidFrom and ValidUntil bound period commission is active in. Nil means no bound
+	ValidFrom  *time.Time `db:"valid_from"`
+	ValidUntil *time.Time `db:"valid_until"`
 	weight     int
 }
 
 type AuditLog struct {
 	Id        int64     `db:"id"`
-	Actor     string    `db:"actor"`      //public key of the actor, performing task
-	Subject   string    `db:"subject"`    //subject to change
-	Action    string    `db:"action"`     //action performed on subject
-	Meta      string    `db:"meta"`       //meta information about audit event
-	CreatedAt time.Time `db:"created_at"` // time log was created
+	Actor     string    `db:"actor"`        //public key of the actor, performing task
+	Subject   string    `db:"subject"`      //subject to change
+	Action    string    `db:"action"`       //action performed on subject
+	Meta      string    `db:"meta"`         //meta information about audit event
+	CreatedAt time.Time `db:"created_at"`   // time log was created
+	TxHash    string    `db:"tx_hash"`      // hash of transaction with administrative operation
+	Before    string    `db:"before_state"` // json snapshot of subject before change
+	After     string    `db:"after_state"`  // json snapshot of subject after change
 }
 
 type Asset struct {
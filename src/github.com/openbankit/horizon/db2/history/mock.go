@@ -24,6 +24,28 @@ func (m *QMock) GetAccountLimits(dest interface{}, address string, assetCode str
 	return a.Error(1)
 }
 
+// GetAccountLimitsForCounterparty returns limits row by account, asset and counterparty type.
+func (m *QMock) GetAccountLimitsForCounterparty(dest interface{}, address string, assetCode string, counterpartyType int16) error {
+	a := m.Called(address, assetCode, counterpartyType)
+	rawLimits := a.Get(0)
+	if rawLimits != nil {
+		limits := rawLimits.(AccountLimits)
+		destLimits := dest.(*AccountLimits)
+		*destLimits = limits
+	}
+	return a.Error(1)
+}
+
+// GetLimitsByAccount returns all limits rows of the account
+func (m *QMock) GetLimitsByAccount(dest *[]AccountLimits, address string) error {
+	a := m.Called(address)
+	rawLimits := a.Get(0)
+	if rawLimits != nil {
+		*dest = rawLimits.([]AccountLimits)
+	}
+	return a.Error(1)
+}
+
 // Inserts new account limits instance
 func (m *QMock) CreateAccountLimits(limits AccountLimits) error {
 	return m.Called(limits).Error(0)
@@ -34,6 +56,56 @@ func (m *QMock) UpdateAccountLimits(limits AccountLimits) error {
 	return m.Called(limits).Error(0)
 }
 
+// GetAccountTypeLimits returns default limits row by account type and asset.
+func (m *QMock) GetAccountTypeLimits(dest interface{}, accountType xdr.AccountType, assetCode string) error {
+	a := m.Called(accountType, assetCode)
+	rawLimits := a.Get(0)
+	if rawLimits != nil {
+		limits := rawLimits.(AccountTypeLimits)
+		destLimits := dest.(*AccountTypeLimits)
+		*destLimits = limits
+	}
+	return a.Error(1)
+}
+
+// GetAccountTypeLimitsForCounterparty returns default limits row by account type, asset and counterparty type.
+func (m *QMock) GetAccountTypeLimitsForCounterparty(dest interface{}, accountType xdr.AccountType, assetCode string, counterpartyType int16) error {
+	a := m.Called(accountType, assetCode, counterpartyType)
+	rawLimits := a.Get(0)
+	if rawLimits != nil {
+		limits := rawLimits.(AccountTypeLimits)
+		destLimits := dest.(*AccountTypeLimits)
+		*destLimits = limits
+	}
+	return a.Error(1)
+}
+
+// GetCounterpartyLimitsByAccountType selects default limits of account type for asset applied to specific counterparty types
+func (m *QMock) GetCounterpartyLimitsByAccountType(dest *[]AccountTypeLimits, accountType xdr.AccountType, assetCode string) error {
+	a := m.Called(accountType, assetCode)
+	rawLimits := a.Get(0)
+	if rawLimits != nil {
+		*dest = rawLimits.([]AccountTypeLimits)
+	}
+	return a.Error(1)
+}
+
+// Inserts new account type limits instance
+func (m *QMock) CreateAccountTypeLimits(limits AccountTypeLimits) error {
+	return m.Called(limits).Error(0)
+}
+
+// Updates account type limits
+func (m *QMock) UpdateAccountTypeLimits(limits AccountTypeLimits) error {
+	return m.Called(limits).Error(0)
+}
+
+// Deletes account type limits
+func (m *QMock) DeleteAccountTypeLimits(accountType xdr.AccountType, assetCode string, counterpartyType int16) (bool, error) {
+	a := m.Called(accountType, assetCode, counterpartyType)
+	return a.Bool(0), a.Error(1)
+}
+
 // GetStatisticsByAccountAndAsset selects rows from `account_statistics` by address and asset code
 func (m *QMock) GetStatisticsByAccountAndAsset(dest map[xdr.AccountType]AccountStatistics, addy string, assetCode string, now time.Time) error {
 	a := m.Called(addy, assetCode, now)
@@ -89,7 +161,7 @@ func (m *QMock) AccountByAddress(dest interface{}, addy string) error {
 }
 
 // selects commission by id
-func (m *QMock) CommissionByHash(hash string) (*Commission, error) {
+func (m *QMock) CommissionByHash(hash string, validFrom, validUntil *time.Time) (*Commission, error) {
 	log.Panic("Not implemented")
 	return nil, nil
 }
@@ -101,7 +173,7 @@ func (m *QMock) InsertCommission(commission *Commission) (err error) {
 }
 
 // Deletes commission
-func (m *QMock) DeleteCommission(hash string) (bool, error) {
+func (m *QMock) DeleteCommission(id int64) (bool, error) {
 	log.Panic("Not implemented")
 	return false, nil
 }
@@ -112,6 +184,11 @@ func (m *QMock) UpdateCommission(commission *Commission) (bool, error) {
 	return false, nil
 }
 
+// CreateAuditLogEntry adds row to audit_log
+func (m *QMock) CreateAuditLogEntry(auditLog *AuditLog) error {
+	return m.Called(auditLog).Error(0)
+}
+
 func (m *QMock) AccountUpdate(account *Account) error {
 	return m.Called(account).Error(0)
 }
@@ -126,13 +203,22 @@ func (m *QMock) GetAccountStatistics(address string, assetCode string, counterPa
 	return a.Get(0).(AccountStatistics), a.Error(1)
 }
 
+func (m *QMock) GetStatisticsAccountAssetPairs(dest *[]AccountAssetPair, address string, assetCode string) error {
+	a := m.Called(address, assetCode)
+	rawPairs := a.Get(0)
+	if rawPairs != nil {
+		*dest = rawPairs.([]AccountAssetPair)
+	}
+	return a.Error(1)
+}
+
 func (m *QMock) AssetByParams(dest interface{}, assetType int, code string, issuer string) error {
 	a := m.Called(dest, assetType, code, issuer)
 	return a.Error(0)
 }
 
-func (m *QMock) GetHighestWeightCommission(keys map[string]CommissionKey) (resultingCommissions []Commission, err error) {
-	a := m.Called(keys)
+func (m *QMock) GetHighestWeightCommission(keys map[string]CommissionKey, now time.Time) (resultingCommissions []Commission, err error) {
+	a := m.Called(keys, now)
 	return a.Get(0).([]Commission), a.Error(1)
 }
 
@@ -163,6 +249,18 @@ func (m *QMock) OptionsDelete(name string) (bool, error) {
 	return a.Bool(0), a.Error(1)
 }
 
+func (m *QMock) Savepoint(name string) error {
+	return m.Called(name).Error(0)
+}
+
+func (m *QMock) RollbackToSavepoint(name string) error {
+	return m.Called(name).Error(0)
+}
+
+func (m *QMock) ReleaseSavepoint(name string) error {
+	return m.Called(name).Error(0)
+}
+
 func CreateRandomAccountStats(account string, counterpartyType xdr.AccountType, asset string) AccountStatistics {
 	return CreateRandomAccountStatsWithMinValue(account, counterpartyType, asset, 0)
 }
@@ -1,7 +1,8 @@
 package history
 
 const (
-	OPTIONS_MAX_REVERSAL_DURATION string = "max_reversal_duration"
+	OPTIONS_MAX_REVERSAL_DURATION     string = "max_reversal_duration"
+	OPTIONS_ACCOUNT_TYPE_RESTRICTIONS string = "account_type_restrictions"
 )
 
 type Options struct {
@@ -13,3 +14,8 @@ func (o *Options) MaxReversalDuration() *MaxReversalDuration {
 	result := MaxReversalDuration(*o)
 	return &result
 }
+
+func (o *Options) AccountTypeRestrictions() *AccountTypeRestrictions {
+	result := AccountTypeRestrictions(*o)
+	return &result
+}
@@ -0,0 +1,67 @@
+package history
+
+import (
+	"fmt"
+	"time"
+
+	sq "github.com/lann/squirrel"
+	"github.com/openbankit/go-base/xdr"
+)
+
+// PaymentFlow is aggregated amount of payments in asset sent from accounts of one type to accounts of another type
+type PaymentFlow struct {
+	AssetCode  string          `db:"asset_code"`
+	FromType   xdr.AccountType `db:"from_type"`
+	ToType     xdr.AccountType `db:"to_type"`
+	IsReversal bool            `db:"is_reversal"`
+	Amount     int64           `db:"amount"`
+	// Fee is commission charged for payments minus commission returned by reversals
+	Fee   int64 `db:"fee"`
+	Count int64 `db:"count"`
+}
+
+// GetPaymentFlows aggregates payments, path payments, external payments and payment reversals closed
+// within [start, end) by asset, type of sender and type of receiver. Nil start or end are ignored.
+// For reversals sender is the receiver of reversed payment.
+func (q *Q) GetPaymentFlows(dest *[]PaymentFlow, start, end *time.Time) error {
+	isReversal := fmt.Sprintf("hop.type = %d", xdr.OperationTypePaymentReversal)
+	movements := sq.Select(
+		"hop.details->>'asset_code' AS asset_code",
+		"COALESCE(hop.details->>'from', hop.details->>'source_account') AS from_address",
+		"COALESCE(hop.details->>'to', hop.details->>'exchangeAgent', hop.details->>'payment_source') AS to_address",
+		isReversal+" AS is_reversal",
+		"ROUND((hop.details->>'amount')::numeric * 10000000) AS amount",
+		"ROUND(CASE WHEN "+isReversal+" THEN -COALESCE((hop.details->>'commission')::numeric, 0) "+
+			"ELSE COALESCE((hop.details->'fee'->>'amount_changed')::numeric, 0) END * 10000000) AS fee",
+	).
+		From("history_operations hop").
+		Join("history_transactions ht ON ht.id = hop.transaction_id").
+		Join("history_ledgers hl ON hl.sequence = ht.ledger_sequence").
+		Where(sq.Eq{"hop.type": []xdr.OperationType{
+			xdr.OperationTypePayment,
+			xdr.OperationTypePathPayment,
+			xdr.OperationTypeExternalPayment,
+			xdr.OperationTypePaymentReversal,
+		}})
+
+	// closed_at is stored in UTC without time zone
+	if start != nil {
+		movements = movements.Where("hl.closed_at >= ?", start.UTC())
+	}
+	if end != nil {
+		movements = movements.Where("hl.closed_at < ?", end.UTC())
+	}
+
+	movementsSql, args, err := movements.ToSql()
+	if err != nil {
+		return err
+	}
+
+	return q.SelectRaw(dest, "SELECT m.asset_code, fa.account_type AS from_type, ta.account_type AS to_type, m.is_reversal, "+
+		"SUM(m.amount)::bigint AS amount, SUM(m.fee)::bigint AS fee, COUNT(*) AS count "+
+		"FROM ("+movementsSql+") m "+
+		"JOIN history_accounts fa ON fa.address = m.from_address "+
+		"JOIN history_accounts ta ON ta.address = m.to_address "+
+		"GROUP BY m.asset_code, fa.account_type, ta.account_type, m.is_reversal "+
+		"ORDER BY m.asset_code, fa.account_type, ta.account_type, m.is_reversal", args...)
+}
@@ -176,6 +176,37 @@ func (r *Repo) Rollback() error {
 	return err
 }
 
+// Savepoint creates savepoint with `name` in the current transaction. Changes made
+// after it can be undone with RollbackToSavepoint without aborting the transaction.
+func (r *Repo) Savepoint(name string) error {
+	if r.tx == nil {
+		return errors.New("not in transaction")
+	}
+
+	_, err := r.ExecRaw("SAVEPOINT " + name)
+	return err
+}
+
+// RollbackToSavepoint undoes all changes made after savepoint with `name` was created
+func (r *Repo) RollbackToSavepoint(name string) error {
+	if r.tx == nil {
+		return errors.New("not in transaction")
+	}
+
+	_, err := r.ExecRaw("ROLLBACK TO SAVEPOINT " + name)
+	return err
+}
+
+// ReleaseSavepoint destroys savepoint with `name`, keeping changes made after it
+func (r *Repo) ReleaseSavepoint(name string) error {
+	if r.tx == nil {
+		return errors.New("not in transaction")
+	}
+
+	_, err := r.ExecRaw("RELEASE SAVEPOINT " + name)
+	return err
+}
+
 // Select runs `query`, setting the results found on `dest`.
 func (r *Repo) Select(dest interface{}, query sq.Sqlizer) error {
 	sql, args, err := r.build(query)
@@ -1,7 +1,20 @@
 // Code generated by go-bindata.
 // sources:
 // latest.sql
+// migrations/10_account_type_limits.sql
+// migrations/11_account_limits_periods.sql
+// migrations/12_commission_tiers.sql
+// migrations/13_commission_validity.sql
+// migrations/14_audit_log.sql
+// migrations/15_audit_log_snapshots.sql
+// migrations/16_account_block_details.sql
+// migrations/17_statistics_storage.sql
+// migrations/18_txsub_open_submissions.sql
+// migrations/19_webhooks.sql
 // migrations/1_initial_schema.sql
+// migrations/20_commission_payer.sql
+// migrations/21_account_type_limits_counterparty.sql
+// migrations/22_commission_validity_unique.sql
 // migrations/2_index_participants_by_toid.sql
 // migrations/3_aggregate_expenses_for_accounts.sql
 // migrations/7_account_limits.sql
@@ -96,6 +109,206 @@ func latestSql() (*asset, error) {
 	return a, nil
 }
 
+var _migrations10_account_type_limitsSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x94\x91\x41\x6b\x83\x40\x10\x85\xef\xfb\x2b\xde\x51\x69\x3c\xa4\xd7\x9c\x6c\xdd\x42\xa9\x4d\x82\xe8\x21\xa7\x65\xbb\x59\xcc\x40\xdc\x95\x75\x6c\xeb\xbf\x2f\x36\xa5\x68\xa1\x24\xce\x6d\x66\xde\xc7\x3c\xe6\x25\x09\xee\x1a\xaa\x83\x66\x8b\xaa\x15\xe2\xb1\x90\x69\x29\x51\xa6\x0f\xb9\x84\x36\xc6\xf7\x8e\x15\x0f\xad\x55\x67\x6a\x88\x3b\x44\x02\xc0\x6c\x33\xf6\x00\x39\xb6\xb5\x0d\xd8\xee\x4a\x6c\xab\x3c\x5f\x5d\x74\x5d\x67\x59\x19\x7f\xb4\xf8\x29\x73\xd2\x41\x1b\xb6\x01\xef\x3a\x0c\xe4\xea\x68\x7d\x1f\xff\xa1\x1a\xfd\xa9\x7c\x6b\x83\x66\xf2\x4e\xf9\x9e\xf1\x46\x35\x39\xfe\x95\x21\x93\x4f\x69\x95\x97\x48\xd6\x97\x3b\x47\x4d\xe7\x41\x7d\x73\x3d\x8f\x83\xab\x44\xe3\x1d\x9f\x66\xcc\x55\x62\xe6\x8a\x1c\x16\xb8\x1a\xd5\x4b\x5d\x91\xbb\x85\xd8\x17\xcf\xaf\x69\x71\xc0\x8b\x3c\x44\xd3\x54\x56\x93\xdf\xc7\x22\xde\x08\x31\x8d\x3a\xf3\x1f\x4e\x88\xac\xd8\xed\xff\x8f\x7a\x23\xbe\x06\x00\x12\xd5\xe0\x25\x1d\x02\x00\x00")
+
+func migrations10_account_type_limitsSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations10_account_type_limitsSql,
+		"migrations/10_account_type_limits.sql",
+	)
+}
+
+func migrations10_account_type_limitsSql() (*asset, error) {
+	bytes, err := migrations10_account_type_limitsSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/10_account_type_limits.sql", size: 541, mode: os.FileMode(420), modTime: time.Unix(1792281067, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrations11_account_limits_periodsSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xdc\x93\xc1\x4b\xc3\x30\x14\xc6\xef\xf9\x2b\xde\xd1\x61\x76\xf0\x5c\x11\xe2\x92\xe1\x30\x6d\x47\x4c\x91\x9d\x42\x6c\xc3\x08\x6b\xd3\xd2\xa4\xcc\xfe\xf7\x32\xd9\x61\xeb\x6a\x99\x5e\x14\xcf\x1f\xbf\xf7\xf8\xbe\xef\xbd\xf9\x1c\x6e\x2b\xbb\x6d\x75\x30\x90\x35\x08\x11\x2e\x99\x00\x49\x1e\x39\x03\x9d\xe7\x75\xe7\x82\x2a\x6d\x65\x83\x47\x00\x84\x52\x58\xa4\x3c\x8b\x13\xf8\x54\x4c\xdb\xe8\x36\xf4\x2a\xf4\x8d\x01\x5f\xe9\xb2\xb4\x2e\x40\x92\x4a\x48\x32\xce\x81\xb2\x25\xc9\xb8\x84\xf9\x1d\x3e\x87\xf7\xc6\xec\xca\x5e\x55\xfa\x5d\xd5\x5d\x80\x37\xbb\xbd\x8e\xd3\xce\x75\xba\xfc\x3e\x77\xb2\xcf\xba\x1f\xad\x9b\xc4\xa2\x89\xd4\x80\x8a\x74\x0d\x8b\x34\x79\x91\x82\xac\x12\x39\x90\x55\xb3\x33\xfd\x24\x7f\xc8\x7c\x2d\x56\x31\x11\x1b\x78\x66\x1b\xb8\xd1\x45\xd1\x1a\xef\x31\x68\xef\x4d\x50\x79\x5d\x18\x7c\x59\xc7\x2c\x1a\xef\xf2\xa0\x1d\x47\xff\xf3\x4e\xd0\xe9\x69\xd3\x7a\xef\xae\x09\xe4\xd8\xd6\x48\x22\x78\xa0\x9e\xfb\xc6\x5f\xb3\xd6\x4d\xa0\xd6\x45\x08\x51\xc6\x99\x64\xb0\x14\x69\x3c\x6c\xff\xf5\x89\x09\x36\xf2\x6c\xf7\x0f\x7f\xe1\xee\x66\x53\x13\x06\xa6\x2f\x3c\xe0\x5f\x8a\xfb\x63\x00\x73\x46\xbf\x0b\xf2\x04\x00\x00")
+
+func migrations11_account_limits_periodsSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations11_account_limits_periodsSql,
+		"migrations/11_account_limits_periods.sql",
+	)
+}
+
+func migrations11_account_limits_periodsSql() (*asset, error) {
+	bytes, err := migrations11_account_limits_periodsSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/11_account_limits_periods.sql", size: 1266, mode: os.FileMode(420), modTime: time.Unix(1792281829, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrations12_commission_tiersSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x94\xce\xb1\xaa\xc2\x30\x14\x87\xf1\x3d\x4f\xf1\xdf\x3a\xdc\x16\xee\xde\x29\x9a\x3a\x1d\x5b\x29\xc9\x24\x22\xad\xc4\x72\x84\x24\x92\x04\xec\xe3\x3b\x08\x22\x55\x04\xd7\x8f\x6f\xf8\x55\x15\xfe\x1c\x4f\x71\xc8\x16\xe6\x2a\x84\x24\xdd\xf4\xd0\x72\x45\x0d\x4e\xc1\x39\x4e\x89\x83\x17\x80\x54\x0a\xeb\x8e\xcc\xb6\x85\x63\x7f\x3c\x5b\x8b\x91\x27\xf6\x19\x6d\xa7\xd1\x1a\x22\xa8\x66\x23\x0d\x69\xfc\x97\x8b\x7f\x98\x7f\xfa\x33\xdb\x98\x70\x49\xc1\x8f\xef\x73\xb1\x3f\x14\xb5\x10\xaf\x6e\x15\x6e\xfe\x8b\x5c\xf5\xdd\xee\x49\x79\xd0\xcb\x65\x1e\xe6\x4f\x39\xb3\x8d\xa9\x16\xf7\x01\x00\x82\x89\x26\x63\x24\x01\x00\x00")
+
+func migrations12_commission_tiersSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations12_commission_tiersSql,
+		"migrations/12_commission_tiers.sql",
+	)
+}
+
+func migrations12_commission_tiersSql() (*asset, error) {
+	bytes, err := migrations12_commission_tiersSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/12_commission_tiers.sql", size: 292, mode: os.FileMode(420), modTime: time.Unix(1792281951, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrations13_commission_validitySql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x7c\xce\xb1\x0a\xc2\x30\x14\x85\xe1\xfd\x3e\xc5\xd9\x6d\x9f\xa0\x53\x35\xdd\xa2\x95\xd2\xce\x12\x34\xea\x85\xdc\xa4\x34\xb7\x16\x7c\x7a\xc1\x49\xb0\x3a\x1e\x0e\x3f\x7c\x65\x89\x8d\xf0\x6d\x72\xea\x31\x8c\x44\xb5\xed\x9b\x0e\x7d\xbd\xb5\x0d\xce\x49\x84\x73\xe6\x14\x09\xa8\x8d\xc1\xae\xb5\xc3\xfe\x80\x87\x0b\x7c\x39\x5d\xa7\x24\x50\x16\x9f\xd5\xc9\x88\x85\xf5\xfe\x9e\x78\xa6\xe8\x8b\xb5\x62\x8e\xca\xe1\x67\x52\x11\x7d\x62\x4c\x5a\xe2\x1f\x8e\xe9\xda\xe3\xb7\xa7\x58\x7d\xe6\xa8\x1c\x2a\x7a\x0d\x00\x9e\x83\xc3\x69\xeb\x00\x00\x00")
+
+func migrations13_commission_validitySqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations13_commission_validitySql,
+		"migrations/13_commission_validity.sql",
+	)
+}
+
+func migrations13_commission_validitySql() (*asset, error) {
+	bytes, err := migrations13_commission_validitySqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/13_commission_validity.sql", size: 235, mode: os.FileMode(420), modTime: time.Unix(1792282018, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrations14_audit_logSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8c\x92\x41\x4f\x83\x30\x14\xc7\xef\xfd\x14\xef\x36\x88\xdb\xcd\x78\xd9\x09\xa5\x1a\x22\xc2\x82\x90\xb8\x13\x79\x94\x86\x75\x19\xed\x52\xde\x24\xd3\xf8\xdd\x8d\x80\x8c\x68\xb6\xac\xb7\xe6\xff\xef\x2f\x7d\xbf\xbc\xc5\x02\x6e\x6a\x55\x59\x24\x09\xd9\x9e\xb1\x87\x84\x7b\x29\x87\xd4\xbb\x0f\x39\xe0\xa1\x54\x94\xef\x4c\x05\x0e\x03\x00\x50\x25\x8c\xa7\x50\x55\x23\xad\xc2\xdd\xbc\x8b\x50\x90\xb1\x43\x24\x36\x68\x51\x90\xb4\xf0\x8e\xf6\xa8\x74\xe5\xdc\xdd\xba\x10\xc5\x29\x44\x59\x18\xf6\xfd\xe6\x50\x6c\xa5\xa0\xab\xfb\x28\x48\x19\x7d\x3d\xbf\x96\x84\xc3\x77\x60\xdb\x18\x5d\x8c\x39\xf8\xfc\xd1\xcb\xc2\x14\x66\x9f\x5f\xb3\xbe\x2c\xac\x44\x92\x65\x8e\x04\x40\xaa\x96\x0d\x61\xbd\x87\x56\xd1\xa6\xbb\xc2\x87\xd1\xf2\xff\x7b\x6d\x5a\xc7\xed\x01\xab\x24\x78\xf1\x92\x35\x3c\xf3\xb5\xa3\x4a\x97\xb9\xcb\xd1\x64\x10\xf9\xfc\xed\x64\x32\x2f\x8e\x79\xef\x2a\x8e\x26\x7e\xb3\xd7\x20\x7a\x82\x82\xac\x94\xe0\x74\xb9\xbb\xbc\x40\xf8\xb5\x77\x9e\x31\x34\xe6\x83\xb8\x8b\xb4\xc9\xf8\xe7\x81\xa7\xd2\xcf\x70\xd3\xb5\xf1\x4d\xab\x19\xf3\x93\x78\xf5\x77\x6d\x96\xec\x7b\x00\xb7\x06\x13\x28\x5f\x02\x00\x00")
+
+func migrations14_audit_logSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations14_audit_logSql,
+		"migrations/14_audit_log.sql",
+	)
+}
+
+func migrations14_audit_logSql() (*asset, error) {
+	bytes, err := migrations14_audit_logSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/14_audit_log.sql", size: 607, mode: os.FileMode(420), modTime: time.Unix(1792282058, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrations15_audit_log_snapshotsSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8c\x90\xc1\x4e\xc3\x30\x10\x44\xef\xfb\x15\x73\x6b\x2b\xda\x1b\xe2\x92\x93\xa9\x0d\xaa\x64\x1c\x14\x6c\x89\x9b\xe5\x14\x37\x09\x0a\x36\x72\x5c\xa0\x7f\x8f\x90\x5a\x35\x44\x20\xf5\x3a\xbb\x6f\x77\x66\x56\x2b\x5c\xbd\x75\x4d\x72\xd9\xc3\xbc\x13\x31\xa9\x45\x05\xcd\x6e\xa5\x80\xdb\xbf\x74\xd9\xf6\xb1\x21\x80\x71\x8e\x75\x29\xcd\x83\x42\xfe\xb2\xad\x1b\x5a\x6c\x5b\x97\xdc\x36\xfb\x84\x0f\x97\x0e\x5d\x68\xe6\x37\xd7\x0b\xa8\x52\x43\x19\x29\xc1\xc5\x1d\x33\x52\x63\x36\x5b\xfe\xe6\x6b\xbf\x8b\xc9\xdb\x21\xff\xfc\x7c\x1d\x62\xa8\xff\x80\xc2\xbe\xef\xa7\xa0\xdb\x65\x9f\x2e\xe1\x0a\xa2\x75\x25\x98\x16\xd8\x28\x2e\x9e\xcf\x41\x6c\x7d\xb0\x27\xfb\xa5\x3a\xeb\x30\x4f\x1b\x75\x8f\x3a\x27\xef\x31\x3f\x6e\x2c\x0a\xa2\x71\x3d\x3c\x7e\x86\xff\x0b\xe2\x55\xf9\x78\x32\x7a\x3c\xb0\x9c\xc8\xe3\xe0\xd3\xd9\x28\x5b\x41\xdf\x03\x00\x20\x83\xec\xcb\x95\x01\x00\x00")
+
+func migrations15_audit_log_snapshotsSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations15_audit_log_snapshotsSql,
+		"migrations/15_audit_log_snapshots.sql",
+	)
+}
+
+func migrations15_audit_log_snapshotsSql() (*asset, error) {
+	bytes, err := migrations15_audit_log_snapshotsSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/15_audit_log_snapshots.sql", size: 405, mode: os.FileMode(420), modTime: time.Unix(1792282241, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrations16_account_block_detailsSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xac\x91\x31\x4f\xc3\x30\x10\x85\x77\xff\x8a\xb7\x15\x44\xbb\x21\x96\x4e\x01\x87\xc9\x24\xa8\x4a\xe6\xc8\x58\x56\x62\xd1\xdc\x55\xce\x85\x52\x7e\x3d\xa2\x0c\x66\x00\x07\x21\x46\x4b\xdf\xdd\xf3\x77\x6f\xb3\xc1\xd5\x18\xfa\x68\xc5\xa3\x3d\x28\x55\x98\xa6\xdc\xa1\x29\x6e\x4d\x89\x21\x4c\xc2\xf1\xd4\x59\xe7\x78\x26\x99\x14\x50\x68\x8d\xbb\xda\xb4\x0f\x15\x9e\xf6\xec\x9e\xbb\x40\x8e\xc7\x40\x7d\x17\xbd\x9d\x98\xe0\x06\x1b\xad\x13\x1f\xf1\x62\xe3\x29\x50\x7f\x71\x73\x7d\x09\x5d\xde\x17\xad\x69\xb0\x5a\xa1\xaa\x1b\x54\xad\x31\xeb\xec\xb6\x99\x24\xec\x21\x61\xf4\x93\xd8\xf1\x80\x63\x90\xe1\xfc\xc4\x1b\x93\xcf\xcf\x12\x8b\x87\xf8\x57\xf9\x75\x2c\xcf\xf2\x9f\x16\x69\xdd\x1f\x34\xd2\x70\xd6\x63\xab\xd4\xd7\xea\x34\x1f\x69\xb1\x3c\xbd\xab\x1f\x7f\xb8\xd9\x67\x7b\xeb\x3c\x74\xb6\x59\x60\x3e\x3e\xfd\x3d\x92\xc4\x72\x61\x89\xca\xa4\x25\x88\x58\xfc\x56\xbd\x0f\x00\x5f\xd6\x13\xda\xc5\x02\x00\x00")
+
+func migrations16_account_block_detailsSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations16_account_block_detailsSql,
+		"migrations/16_account_block_details.sql",
+	)
+}
+
+func migrations16_account_block_detailsSql() (*asset, error) {
+	bytes, err := migrations16_account_block_detailsSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/16_account_block_details.sql", size: 709, mode: os.FileMode(420), modTime: time.Unix(1792282717, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrations17_statistics_storageSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8c\x51\x41\x4f\xf2\x40\x14\xbc\xef\xaf\x98\x1b\x25\xdf\xc7\xc5\x84\x13\xa7\x4a\x57\x43\xac\x0b\x96\x36\x91\x53\xb3\xc5\x67\x59\x63\xb7\x75\xf7\x05\x44\xe3\x7f\x37\xa5\x04\x88\xa9\x89\x7b\xda\xcd\x9b\x99\x9d\x37\x33\x1a\xe1\x5f\x65\x4a\xa7\x99\x90\x35\x42\x4c\x13\x19\xa6\x12\x4b\xf9\x90\x49\x35\x95\xf0\xac\xd9\x78\x36\x6b\x9f\x7b\xae\x9d\x2e\x29\xdf\x92\xf3\xa6\xb6\xb9\xa7\xb7\xc9\x89\x90\x86\xd7\x71\x1f\x1a\x81\x00\x00\xab\x2b\xc2\xf1\xac\x37\xda\xe9\x35\x93\xc3\x56\xbb\xbd\xb1\x65\x70\x35\x1e\x0f\xa1\xe6\x29\x54\x16\xc7\xff\x0f\x84\x67\x43\xaf\x4f\xbe\x23\xbc\xf8\xda\x16\xa7\x39\x22\x79\x13\x66\x71\x8a\xc1\xe7\xd7\xa0\x03\x1f\x1d\xb5\x57\x14\xa6\x34\x96\x7f\xa8\xd1\x7b\x63\x1c\xf9\x5c\x33\xc0\xa6\x22\xcf\xba\x6a\xb0\x33\xbc\x39\x3c\xf1\x51\x5b\xea\xa4\x16\xc9\xec\x3e\x4c\x56\xb8\x93\xab\xa0\x35\x3d\x14\xc3\xf3\x92\x33\x15\xc9\xc7\xbe\x48\x8a\x7d\x7e\xf1\xc5\x5c\xf5\x05\x91\x2d\x67\xea\x16\x05\x3b\x22\x04\x67\x74\x2b\x7f\x59\x42\x54\xef\xac\x10\x51\x32\x5f\xfc\x9a\xe9\xa4\x1b\xff\xb9\xa3\xef\x01\x00\x68\x47\x19\xba\xe4\x01\x00\x00")
+
+func migrations17_statistics_storageSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations17_statistics_storageSql,
+		"migrations/17_statistics_storage.sql",
+	)
+}
+
+func migrations17_statistics_storageSql() (*asset, error) {
+	bytes, err := migrations17_statistics_storageSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/17_statistics_storage.sql", size: 484, mode: os.FileMode(420), modTime: time.Unix(1792283569, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrations18_txsub_open_submissionsSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8c\x91\x41\x4f\x83\x40\x10\x85\xef\xfb\x2b\xde\x11\xa2\xbd\x19\x2f\x9c\x50\x88\x21\x22\x34\x08\x89\x3d\x91\x05\x26\xb0\x07\x16\xdc\x1d\xac\xf5\xd7\x1b\x88\x36\x95\xd8\xa6\x7b\xdb\xe4\x7d\xbb\xf3\xcd\xdb\x6c\x70\xd3\xab\xd6\x48\x26\x14\xa3\x10\x8f\x59\xe8\xe7\x21\x72\xff\x21\x0e\xc1\x9f\x76\xaa\xca\x61\x24\x5d\xda\xa9\xea\x95\xb5\x6a\xd0\x16\x8e\x00\x80\x4e\xda\x0e\xc7\x53\x77\xd2\xc8\x9a\xc9\x38\xf7\x77\x2e\x92\x34\x47\x52\xc4\xf1\xed\x92\x94\x4d\x63\xc8\xda\x75\x12\x1f\xd2\x1c\x94\x6e\xff\x21\x2c\xbd\x4f\xa4\x6b\x5a\x00\x54\xaa\x55\x9a\xd7\x91\x79\x20\x66\x6a\x4a\xc9\x00\xab\x9e\x2c\xcb\x7e\xc4\x5e\x71\xb7\x5c\xf1\x35\x68\x5a\x41\xdb\x2c\x7a\xf1\xb3\x1d\x9e\xc3\x9d\x33\xcf\xef\x0a\xd7\x3b\x3a\x47\x49\x10\xbe\x9d\x71\x2e\xab\x43\xf9\xab\x91\x26\xe7\x16\x53\xbc\x46\xc9\x13\x2a\x36\x44\x70\x7e\xe2\xae\x77\xed\xfb\x7f\x8c\xae\xfc\xe4\x94\x99\x55\x4e\xeb\x0c\x86\xbd\x16\x22\xc8\xd2\xed\xc5\x3a\x3d\xf1\x3d\x00\xaa\x50\xad\xd0\x04\x02\x00\x00")
+
+func migrations18_txsub_open_submissionsSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations18_txsub_open_submissionsSql,
+		"migrations/18_txsub_open_submissions.sql",
+	)
+}
+
+func migrations18_txsub_open_submissionsSql() (*asset, error) {
+	bytes, err := migrations18_txsub_open_submissionsSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/18_txsub_open_submissions.sql", size: 516, mode: os.FileMode(420), modTime: time.Unix(1792285855, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrations19_webhooksSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xcc\x95\x51\x8f\x9a\x4e\x14\xc5\xdf\xf9\x14\xf7\x4d\xc9\xdf\x4d\x76\xfd\xef\x6e\x9a\xf8\x44\x65\xb6\x31\xb5\xb8\x45\x49\xba\x4f\x64\x80\x5b\x9c\x14\x07\x32\x73\xd5\xb5\x9f\xbe\x51\x50\x28\x02\x92\xa6\x0f\xf5\xcd\xcc\x9c\x7b\x67\xee\xf9\x9d\xe1\xee\x0e\xfe\xdb\x88\x58\x71\x42\xf0\x32\xc3\x98\xba\xcc\x5a\x31\x58\x59\x1f\xe7\x0c\xf6\x18\xac\xd3\xf4\x87\xaf\xb7\x81\x0e\x95\xc8\x48\xa4\x52\xc3\xd0\x00\x00\x10\x11\x94\xbf\x40\xc4\x1a\x95\xe0\xc9\xe8\xb4\xb6\x55\xc9\x79\x05\x20\x5c\x73\xc5\x43\x42\x05\x3b\xae\x0e\x42\xc6\xc3\x87\xfb\xf1\xa3\x09\xce\x62\x05\x8e\x37\x9f\xe7\x12\x8d\xa1\x42\x6a\x95\x8c\x9f\x9e\xea\x0a\x1e\x86\xe9\x56\x52\x9b\xe2\xf9\xd1\xcc\xf7\xe1\x0e\x25\xf9\x74\xc8\x50\xf7\xac\x1c\x2a\xe4\x84\x91\xcf\x8f\xc5\x49\x6c\x50\x13\xdf\x64\xb0\x17\xb4\x3e\xfd\x85\x9f\xa9\xc4\x8b\x06\x6c\xf6\x62\x79\xf3\x15\xc8\x74\x3f\x2c\x7a\xbe\xba\xb3\x2f\x96\xfb\x06\x9f\xd9\xdb\x50\x44\xa6\x61\x4e\x5a\x26\x1b\x61\x22\x76\xa8\x04\x36\x8e\xb5\x61\xb4\x55\x2b\xfc\xe3\xe6\x40\xc4\x42\x52\x79\x1a\x97\xbd\x30\x97\x39\x53\xb6\x6c\xb3\x4f\x44\x26\x2c\x1c\xb0\xd9\x9c\xad\x18\x4c\xad\xe5\xd4\xb2\x59\x75\x56\x95\x33\x5c\xcf\xeb\x61\xfc\xa1\x3e\xaf\x72\xc2\xad\xaa\xff\xc7\x75\x51\xc6\x0f\x49\xca\x2b\xb7\x25\x7c\xa7\x3a\x14\xc4\x69\xab\x01\xba\x8e\xf3\x6c\x5e\x3b\x31\xc8\x50\x46\x42\xc6\x83\x82\x14\x22\xdc\x64\x54\x16\x12\x92\x30\x46\x75\x2d\xbc\xcf\x05\x12\xdf\xc9\x2f\x54\x27\x0a\x6e\x42\x90\xeb\x12\xae\xc9\x47\xa5\x52\xd5\x74\xa7\xf2\x78\x83\x06\xce\xe0\x4f\x59\x2b\x10\x2a\xeb\xb4\x55\xb9\x89\xa6\xe7\xcc\xbe\x7a\x0c\x66\x8e\xcd\xbe\x35\x10\xea\x07\x07\xff\x64\xf5\x91\x9e\xeb\x65\xf0\x96\x33\xe7\x13\x04\xa4\x10\x61\x58\xe3\x74\x74\x41\xcb\x9c\x9c\xdb\x75\xf5\xa9\x3b\xd0\xa7\xe3\x09\x96\x51\xdd\xbc\x8e\xe8\xf1\xc8\x4f\x90\x08\x55\xdf\xf0\x15\xad\x0f\x97\x84\xd4\xc2\xd7\x2f\xa2\x0d\x6f\x64\xff\x77\xf2\x1f\x4b\xe8\xcd\x68\xf5\x09\x46\xbe\xe7\x3b\x17\x49\x35\x0c\x7f\xfd\xe5\xad\xf3\x56\xda\x7f\x24\xae\xea\xda\xef\xb8\x55\x30\xe9\x42\xfc\xd8\xa9\xfa\x35\xb5\xd3\xbd\x34\x0c\xdb\x5d\xbc\x76\x80\x37\x69\xde\x70\x26\xbc\x71\xb9\xda\x57\x4f\x8c\x5f\x03\x00\xdb\xb1\xb9\x04\xc2\x07\x00\x00")
+
+func migrations19_webhooksSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations19_webhooksSql,
+		"migrations/19_webhooks.sql",
+	)
+}
+
+func migrations19_webhooksSql() (*asset, error) {
+	bytes, err := migrations19_webhooksSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/19_webhooks.sql", size: 1986, mode: os.FileMode(420), modTime: time.Unix(1792286400, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _migrations1_initial_schemaSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xc4\x5a\x6d\x6f\xdb\x38\x12\xfe\x9e\x5f\x41\xf4\x8b\x1d\x9c\x73\xd7\xa2\x87\xa2\x97\xa0\x05\xdc\x44\xb9\x1a\xe7\xc8\xad\x2d\x5f\x5b\x1c\x0e\x02\x2d\xd1\xb6\x36\xb2\xa8\x92\x54\x5e\xba\xd8\xff\xbe\x43\xea\xc5\x7a\xa3\x24\x27\x72\x76\xb1\xc0\x6e\xa4\xe1\xcc\x3c\x33\xc3\x67\x86\x94\xcf\xce\xd0\xdf\x76\xde\x86\x61\x41\xd0\x32\x3c\x39\x3b\x83\x7f\xd1\x17\xca\xc5\x86\x91\xc5\xd7\x29\x72\xb1\xc0\x2b\xcc\x09\x72\xa3\x9d\x7a\x7d\xb2\x30\x2c\xc4\x05\xc8\xef\x48\x20\x6c\xe1\xed\x08\x8d\x04\xfa\x80\x5e\x5f\xa8\x57\x3e\x75\x6e\xab\x4f\x1d\xdf\x93\xd2\x24\x70\xa8\xeb\x05\x1b\x78\x31\x58\x5a\xd7\xef\x07\x17\xa9\xba\xc0\xc5\xcc\xb5\x1d\x1a\xac\x29\xdb\x81\x84\xcd\x05\x83\xff\x70\x90\xa4\x41\xa2\x63\x4b\x40\xf5\x3a\x0a\x1c\xe1\xd1\xc0\x5e\x81\x26\x22\xdf\xaf\xb1\xcf\x49\xc1\x0c\x28\xb0\x77\x84\x73\xbc\x51\x02\xf7\x98\x05\xa0\xeb\xe2\x24\x81\x67\xe2\x1d\x39\x47\xa1\x1f\x6e\xf8\x4f\xff\x02\x59\x8f\x21\xfc\x69\x7c\xb7\x0c\x73\x31\x99\x99\x17\x68\x01\x96\x76\xf8\x1c\x9d\x5d\xa0\xd9\x7d\x40\x18\xfc\x9f\x42\x7e\x39\x37\xc6\x96\xb1\x97\x44\x93\x6b\x64\xce\x2c\x78\x30\x59\x58\x8b\x54\x21\xfa\x36\xb1\x3e\xa3\xc5\xe5\x67\xe3\x66\x8c\xc2\x8d\xed\x40\x04\x7d\x2a\xad\x17\xcc\xef\xb5\x94\x1c\xb9\x9c\xdd\xdc\x18\xa6\xd5\xe0\x46\x2c\x80\x60\x69\x45\x09\x9a\x2c\xd0\xe0\xcb\xf4\x1f\xe1\x46\x26\x2f\x64\xd4\x21\x6e\xc4\xb0\x8f\x7c\x1c\x6c\x22\x88\xc7\xa0\xec\xc7\x96\x0b\xca\x48\x7f\x51\x88\xf5\x15\x83\x10\xad\x7c\xcf\xd1\x07\xa0\xe8\xc2\xd3\xf0\x27\x66\x25\x7c\x59\xb2\x48\x80\x2e\x04\xb5\x84\xe4\x73\x59\x71\x9c\x08\x8e\xe8\x1a\x0d\x6f\xc9\xe3\x08\xdd\x61\x3f\x22\xa7\x28\xc4\x1e\xe3\x2a\x24\xaa\x0c\x09\x66\xce\xd6\x0e\xb1\xd8\x42\xd5\xc4\x5e\x8f\x8a\x29\x94\x62\x2e\x59\xe3\xc8\x87\xd2\xc7\x2b\x9f\xf0\x10\x3b\x44\x96\xf3\xa0\xf4\xf6\xde\x13\x5b\x9b\x7a\x6e\xae\x42\x8b\x71\xf7\xa4\x67\x8f\x36\x76\x1c\x1a\x05\x82\xa7\xf0\xad\xf1\xa7\xa9\xb1\x07\x9f\xc4\x2e\x8b\x00\x88\x65\x66\xcf\xf3\xf9\x50\xeb\x2a\x5a\xd1\xf0\x04\xc1\x3f\x9e\x8b\x56\xde\xc6\x0b\x84\xca\x94\xb9\x9c\x4e\x47\xea\x39\x76\x5d\x06\xfb\x04\xb6\x16\x66\xd8\x11\x84\x41\x60\xd8\x23\x84\x6b\xf8\xee\x9f\xa7\x89\x48\xac\xc9\x56\x01\x05\x0d\x64\x03\x52\x45\x2d\x2b\xb5\xe7\x3d\xd8\xdb\x6a\xe7\x86\xf8\x51\x52\x03\x47\x2b\x4a\x7d\x82\x83\x4c\x1a\x5d\x19\xd7\xe3\xe5\xd4\x42\xd7\xe3\xe9\xc2\xc8\xaf\x05\xae\x78\xca\x62\xdf\xdb\x79\x82\xb8\x36\xe6\x2a\xbb\xbf\x71\x1a\xac\x4e\x4e\x2b\x15\x9e\xc4\x84\xac\xd7\xc4\xe9\x3b\xd0\x89\xd2\x24\xce\xa5\xf0\xdb\xba\xb8\xa7\x72\x34\x24\xc0\xbc\x92\xcd\x74\x92\xaf\x28\x73\x09\x7b\xa5\x89\x7c\x43\x52\x5c\x22\xb0\xe7\xb7\x06\xc5\x27\x2e\xac\xed\x39\x28\x89\xd2\x24\x28\x9c\xfc\x8c\x80\xf8\x75\x8e\xc6\xc2\xf6\x16\xf3\x6d\x7d\x1d\x96\xe4\x43\x46\xee\x3c\x1a\x71\xbb\x75\x61\x12\x23\x86\x03\x8e\xe3\x9e\xa1\xb2\x92\xf9\x91\x56\xd4\xeb\x92\x85\x7d\x56\xba\xc9\x3b\x3e\xe5\xb2\x0a\x05\x92\x7d\x0f\x9a\xd9\x2e\x44\x72\xfb\xcb\x0e\x28\x9f\xa0\x5f\x34\x20\xe5\x35\x8c\x60\xd1\xba\x28\x96\x8d\x42\xb7\xb3\x6c\x56\x47\xc9\x9f\xbb\x90\x32\x08\x8b\x7d\x07\xf9\x00\x44\x15\x2c\x6f\xca\x15\x45\x81\xea\x00\xb7\x17\xf0\xfa\x82\x5c\x13\x62\x87\xb0\x37\xeb\xdf\xca\x51\xc1\x06\x11\x1d\x53\xc8\xd7\xc0\x38\x84\xdd\xe9\x44\x76\xf8\xc1\x16\x0f\x36\x6c\x68\x9b\x7b\xbf\xaa\x52\xfa\x52\xde\xa7\x2d\xc4\x4c\x78\x8e\x17\xe2\xde\x79\xb5\xde\xc6\x9e\x65\xeb\x31\x75\xdf\xee\xed\x04\x72\x28\x7e\x50\x01\xc1\xfc\x99\x86\x61\x61\x7c\x5d\x1a\xe6\x65\x43\x24\xf2\xe0\x53\xe9\x6e\x36\x14\x82\x85\x35\x9e\x5b\x71\xfb\x7f\xa3\x1e\x4c\x4c\x50\xa6\x1a\xf6\xa7\x1f\xc9\x23\x73\x86\x6e\x26\xe6\x7f\xc7\xd3\xa5\x91\xfd\x3d\xfe\xbe\xff\xfb\x72\x0c\x83\x03\x7a\xd3\x0b\x50\x34\xfb\x66\x1a\x57\x60\xbb\x05\xf1\x78\x6a\x19\xf3\x03\x01\x67\xba\x5b\xc4\xff\xee\xb9\xad\x58\x8e\x55\xa8\x6d\x23\x40\x9e\x1e\xb5\x63\x42\x18\x82\x0f\x31\x2e\xd5\x8f\x9e\xd9\x8e\xe2\x47\x9c\x46\xcc\x21\x69\xa9\x6b\xb8\x3f\xe5\xa9\xc1\xe0\xfc\xbc\x22\xd1\x61\x53\xe4\xe1\x1d\x8f\x16\x74\x56\x54\xec\x35\xb4\x50\xb7\xb6\x3e\x01\xcf\x21\x05\x9d\x67\xfd\xd2\x42\x8b\x95\x97\x22\x86\x03\xc1\x3e\x93\x1a\x5a\xac\x55\xc9\x41\xb7\xa0\x81\x1e\x72\x4b\x8e\x57\xb2\x29\x45\xe4\xfd\xeb\x3c\x8e\x25\x53\x58\xcb\x90\xd7\x95\x41\x9a\xc9\xa0\x56\x76\x6f\x5a\x3f\xaf\x60\x6d\x6b\xd6\xcd\x7a\x7f\xc9\xb4\x06\x73\x0f\x09\xee\x88\x0f\x4e\x21\x41\x1e\x2a\x54\xfd\x20\x67\x27\x38\x5c\x6a\x5e\xee\x88\x3c\xf8\xd6\xbe\x92\x51\xd0\xbd\xe6\xde\x26\xc0\x22\x02\xd5\x35\x61\xff\xd7\xbb\xd3\xff\xfd\x7f\xcf\xc2\xbf\xff\x51\xc7\xc3\x20\x51\x1a\xe2\xc8\x8e\xc6\x27\xc6\x2a\x67\x67\xba\x02\x08\x43\x23\xab\xef\x75\x55\xd5\x24\xc8\x20\x9c\xf6\x0a\x12\x07\xc7\x6c\x88\xe2\x7b\x28\xe0\x0d\x51\x64\x98\xdf\x4c\xb0\xbd\x92\xad\x93\xd8\xee\xb4\xdf\xe3\xed\x32\x33\xa7\x6d\xdd\x1d\xc5\xf2\x97\xb3\xe9\xf2\xc6\x94\x29\x95\xd7\x00\x29\xca\x00\xe2\x7d\x87\xfd\xe1\xa0\xd3\x40\x01\xe1\x60\x64\xe3\xf8\x70\xa0\xad\x30\x7a\x6f\x28\xb4\xcd\xea\x20\x1c\x2d\xec\xd7\x84\xa4\x25\x14\xe1\x2d\x79\xdc\x5f\x06\x99\x0b\x6b\x3e\x9e\x98\x0d\x68\xab\x84\x77\x60\x02\x55\x29\x8d\xaf\xae\x72\xd6\xba\xf8\x88\xbe\xcc\x27\x37\xe3\xf9\x0f\xf4\x1f\xe3\x07\x1a\x7a\xee\xe1\x3d\xf8\x88\x48\x75\x36\x9b\xb0\x36\xfa\xd9\x8a\x76\x95\x0d\x28\x29\xa4\x89\x79\x65\x7c\x7f\x42\xa3\x52\xeb\x72\xfa\xe4\x4d\x5f\x6d\xdb\x5a\x2e\x26\xe6\xbf\xd1\x4a\x30\x38\x70\x0e\x13\xe1\x51\xa5\x2f\xd4\x79\x2a\xdb\x5b\x6f\x6e\xaa\x5e\xd9\xc9\xc7\x72\x87\xad\x73\x2d\x6e\xa8\xbd\x39\x17\xab\xeb\xe6\x5e\xa9\x97\x8f\xaa\x6d\xbb\xb6\xc6\x6d\xe0\xe0\xc7\xf8\xfd\x73\xdd\x5e\x9a\x13\x98\xb2\x12\xef\x4b\xba\xf3\x18\xd2\x6b\xb7\x82\xfb\x75\xc7\xec\x51\x7a\x83\xa6\xf3\x7c\x4f\xab\x7d\xfa\x0c\xec\xd9\xd5\xdb\xfd\x54\x3f\xaa\xbd\x28\x68\x41\x40\x43\x3b\x3c\x0a\x88\x44\x71\x1e\x87\xa6\xff\x3d\x09\x56\x15\x4d\x76\xa3\x07\x09\xef\x1b\x50\x51\x77\x1e\x53\x7a\x57\x59\x00\x51\xef\x5e\x7e\xf7\x1e\xc5\xc7\x8a\x81\x6e\xdb\xb6\xc6\x5b\x2f\x70\xc9\x83\x5d\xfe\x1a\x60\x83\xde\xe4\xca\xbf\x57\xd7\x5b\xad\xe5\x71\x64\x9f\x26\x8a\xec\x1d\x0b\x1e\x00\xa4\xe7\xf0\x37\x19\x6a\x77\x3f\x4e\x41\x81\x7b\x35\x0a\xd5\x77\x21\xc8\xa5\x27\x3a\x44\x05\xd4\xa2\x6f\x9f\x8d\xb9\xa1\xfd\xc6\xf2\x01\x4e\x6d\x11\x41\xb3\xb9\xfe\x4b\x4a\x2c\xd2\x1c\xd8\x84\xa1\x24\x5c\x39\xb6\xf7\xd3\x7d\x1a\x4d\xb4\xf2\xa3\x14\x6a\x29\x87\x64\xef\x4a\x95\xd9\x1d\xfc\x31\x5c\xaf\xb3\xd3\xca\x21\x99\x64\x77\x10\x47\x2d\xe9\x82\x9d\xa7\x30\xa0\x5e\x5d\xe9\x23\xc3\x91\x53\x50\xf9\xa6\xd1\x8a\xa5\xb4\xa0\x3b\xb2\xdc\x27\xa6\x97\xc9\x4c\xfe\x9b\x56\x1b\xac\x9c\x6c\x77\x44\x75\x5f\xcf\x5e\x06\x5a\xed\x77\xbb\x36\x8c\x75\x8b\xba\x83\x4d\x07\xd9\x97\x01\x98\xdd\x43\xb5\x81\xd2\x1e\x4c\x8a\xaa\xf7\x57\xf8\x47\xe7\x86\xb2\xa9\xda\xa1\xef\x50\x86\x28\x2a\x2d\x5e\x73\x1f\x83\x22\x9a\xec\x75\x01\x54\x5c\x71\x18\xb8\x23\xf5\xcc\xaa\x95\x4e\x40\xea\x3a\xa7\x9a\xe9\xc5\xc3\x91\x0e\x0b\x89\x62\xcd\xbc\xfa\xc4\xe3\x42\x35\x21\xfa\x7c\xe4\xa7\xe3\xa3\x6f\x97\xaa\xb1\x27\x0f\xea\x20\xec\x92\x6c\x36\x4a\x8f\xba\xf6\x8a\xd2\xdb\x7e\x0a\xaa\xc1\x40\xeb\x08\x36\x1c\xa6\x9f\xed\xce\x3e\x7e\x44\x03\x4e\xfd\xe4\xb7\x36\xaa\x14\x07\xe7\xe7\xf2\x36\xf9\xf4\x74\x84\xf4\x82\x0e\x75\xbb\x09\x7a\x9c\x47\x84\xe9\x45\x57\x34\xda\x6c\x45\x27\xf3\x05\xd1\x66\x07\x0a\xa2\x25\x17\xd2\xd1\x5b\xed\x27\x98\xa2\xdf\xbe\xcd\x65\x4f\xf7\x13\x49\x04\xd3\x77\xe8\x13\x41\x54\x26\xf2\xbf\xae\xbc\xa2\xf7\xc1\x89\xcb\x68\x88\xd4\x0f\xc7\xea\xcb\xc5\xc1\xdc\x81\x7c\x5d\xb4\x08\x16\x37\x54\xd3\xa2\x1c\x47\x74\x12\xeb\xae\x39\x6d\x6d\x4d\x32\x69\x55\x35\xc9\x64\x27\x9f\x4c\xe8\xcf\x00\x00\x00\xff\xff\x47\xfc\xd6\x1f\x94\x2a\x00\x00")
 
 func migrations1_initial_schemaSqlBytes() ([]byte, error) {
@@ -116,6 +329,66 @@ func migrations1_initial_schemaSql() (*asset, error) {
 	return a, nil
 }
 
+var _migrations20_commission_payerSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x84\xcd\xb1\xae\x82\x30\x14\x06\xe0\xbd\x4f\xf1\x6f\xdc\x1b\xc3\x66\x5c\x98\xaa\xc5\xe9\x08\x86\xb4\x33\x39\x69\x08\x74\xa0\x25\xa7\x55\xc3\xdb\xbb\xba\x18\x9f\xe0\xab\x6b\x1c\xd6\x30\x0b\x97\x09\x6e\x53\x4a\x93\x6d\x07\x58\x7d\xa6\x16\x4b\xc8\x25\xc9\x3e\xb2\xf7\xe9\x11\x4b\x56\x80\x36\x06\x97\x9e\xdc\xad\x83\x4f\xeb\x1a\x72\x0e\x29\x8e\x1b\xef\x93\xc0\x2f\x2c\xec\xcb\x24\x78\xb2\xec\x21\xce\x7f\xa7\xe3\x3f\x4c\x7b\xd5\x8e\x2c\xaa\x0a\x5d\x6f\xd1\x39\xa2\x46\xa9\x4f\xd6\xa4\x57\xfc\x09\x9b\xa1\xbf\x7f\x93\x1b\xf5\x1e\x00\x28\x18\x34\x08\xc6\x00\x00\x00")
+
+func migrations20_commission_payerSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations20_commission_payerSql,
+		"migrations/20_commission_payer.sql",
+	)
+}
+
+func migrations20_commission_payerSql() (*asset, error) {
+	bytes, err := migrations20_commission_payerSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/20_commission_payer.sql", size: 198, mode: os.FileMode(420), modTime: time.Unix(1792287149, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrations21_account_type_limits_counterpartySql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xcc\x91\xcd\x4a\x03\x31\x14\x85\xf7\xf7\x29\xce\xd2\x62\x67\xe1\x7a\x44\x88\xcd\x2d\x16\x33\x49\x89\x19\xa4\xab\x21\x8c\x41\x06\xe7\x8f\x49\x44\xe6\xed\xc5\xae\x0a\x06\xea\xd2\xf5\xfd\x38\xf7\xfc\x14\x05\x6e\x87\xee\x7d\xf1\x29\xa0\x9e\x89\x84\x72\x6c\xe1\xc4\xa3\x62\xf8\xb6\x9d\x3e\xc7\xd4\xa4\x75\x0e\x4d\xdf\x0d\x5d\x8a\x04\x08\x29\xb1\x33\xaa\xae\x34\xce\xe7\xb0\xcc\x7e\x49\xeb\x99\x42\x1c\x7c\xdf\x77\x63\x82\x36\x0e\xba\x56\x0a\x92\xf7\xa2\x56\x0e\xc5\x5d\x79\x4d\x1c\xd2\x9a\x23\x76\x46\xbf\x38\x2b\x0e\xda\xe5\x98\x66\xfe\x08\xeb\x75\xa5\x1f\x93\x47\x7b\xa8\x84\x3d\xe1\x99\x4f\xb8\xb9\x84\xb6\xf0\x31\x86\xd4\xb4\xd3\x5b\xd8\xfe\x0e\xb1\x29\x89\x2e\x6b\x91\xd3\xd7\x48\x24\x59\xb1\x63\xec\xad\xa9\xb2\x1f\x5f\x9f\xd8\x72\xa6\x91\xfb\x87\xff\x1a\x7d\xf3\x67\x57\xf9\xb1\x4b\xfa\x1e\x00\x6f\xba\x45\x11\x3d\x02\x00\x00")
+
+func migrations21_account_type_limits_counterpartySqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations21_account_type_limits_counterpartySql,
+		"migrations/21_account_type_limits_counterparty.sql",
+	)
+}
+
+func migrations21_account_type_limits_counterpartySql() (*asset, error) {
+	bytes, err := migrations21_account_type_limits_counterpartySqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/21_account_type_limits_counterparty.sql", size: 573, mode: os.FileMode(420), modTime: time.Unix(1792288309, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _migrations22_commission_validity_uniqueSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xbc\x90\x41\x4f\x83\x40\x10\x85\xef\xfb\x2b\xde\xad\x25\x42\x7f\x40\xd1\x03\x61\x47\x25\xa9\x8b\xd2\x12\xbd\x11\x0a\x5b\x99\xd8\xdd\x6d\x60\x95\xd4\x5f\x6f\x4c\x6b\x24\x26\x26\x9e\x3c\xce\xf7\xe6\x4d\xde\x9b\x28\xc2\x85\xe1\xe7\xbe\xf6\x1a\xe5\x41\x08\x59\xe4\xf7\xc8\x94\xa4\x27\x34\xce\x18\x1e\x06\x76\xb6\xda\x1e\xab\xae\x1e\xba\x58\xa4\x05\x25\x1b\x42\xa9\xb2\x87\x92\x7e\xdd\x43\xae\x26\x14\xe5\x3a\x53\x37\xd8\xfa\x5e\x6b\xcc\x5f\xf4\xe9\x56\x28\x80\x34\x4f\x56\xb4\x4e\x69\xfe\x56\xef\xb9\xad\x76\xbd\x33\x21\x66\x11\xdb\x1d\x5b\xf6\xc7\xd9\x72\xe9\xd9\xe8\xc1\xd7\xe6\x80\x91\x7d\x87\xcf\x11\xef\xce\xea\x20\xfc\x69\x7e\xb5\x9e\xf7\x21\x66\x7f\x31\x07\xb1\x10\xd3\xe2\xd2\x8d\x56\x08\x49\x2b\xda\x10\xae\x8b\xfc\x6e\x9a\xbe\x39\xe7\x9f\x20\xab\x47\xdd\xe3\xf1\x96\x0a\x42\xb3\xf8\x6a\x84\xab\x93\xf0\x0d\x12\x25\xd1\x2c\xb8\xc5\xe5\x59\xe1\x36\xfe\xbf\x0f\x07\xb1\xf8\x18\x00\xc0\x69\x03\x03\xde\x01\x00\x00")
+
+func migrations22_commission_validity_uniqueSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations22_commission_validity_uniqueSql,
+		"migrations/22_commission_validity_unique.sql",
+	)
+}
+
+func migrations22_commission_validity_uniqueSql() (*asset, error) {
+	bytes, err := migrations22_commission_validity_uniqueSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/22_commission_validity_unique.sql", size: 478, mode: os.FileMode(420), modTime: time.Unix(1792288387, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _migrations2_index_participants_by_toidSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8c\x8f\xb1\x0a\xc2\x30\x10\x86\xf7\x7b\x8a\x1b\x15\xe9\x13\x74\x12\x1b\xa4\x4b\x2a\xd5\x82\x5b\x48\xdb\x60\x6e\x30\x17\x92\x03\xe9\xdb\x2b\x3a\xd8\xda\xc5\xf5\xf8\xf8\xfe\xfb\x8a\x02\x77\x77\xba\x25\x2b\x0e\xbb\x08\x70\x68\xd5\xfe\xa2\xb0\xd6\x95\xba\xa2\xe7\x68\xfa\xc9\x78\xa6\x11\x1b\x8d\x9e\xb2\x70\x9a\x0c\x47\xf7\xe2\x89\x83\x89\x36\x09\x0d\x14\x6d\x90\x8c\xdd\xb9\xd6\x47\xec\x25\x39\x87\x9b\x35\x4b\xe3\xb6\xfc\xd1\xcb\x47\x2f\x4b\xbd\x24\x1b\xb2\x1d\xfe\x1c\x98\xd3\xef\x09\x98\x27\x55\xfc\x08\x00\x55\xdb\x9c\xd6\x49\xe5\xe2\xfe\xfd\xa5\x84\x67\x00\x00\x00\xff\xff\x33\xec\x54\x7a\x15\x01\x00\x00")
 
 func migrations2_index_participants_by_toidSqlBytes() ([]byte, error) {
@@ -309,7 +582,20 @@ func AssetNames() []string {
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
 	"latest.sql": latestSql,
+	"migrations/10_account_type_limits.sql": migrations10_account_type_limitsSql,
+	"migrations/11_account_limits_periods.sql": migrations11_account_limits_periodsSql,
+	"migrations/12_commission_tiers.sql": migrations12_commission_tiersSql,
+	"migrations/13_commission_validity.sql": migrations13_commission_validitySql,
+	"migrations/14_audit_log.sql": migrations14_audit_logSql,
+	"migrations/15_audit_log_snapshots.sql": migrations15_audit_log_snapshotsSql,
+	"migrations/16_account_block_details.sql": migrations16_account_block_detailsSql,
+	"migrations/17_statistics_storage.sql": migrations17_statistics_storageSql,
+	"migrations/18_txsub_open_submissions.sql": migrations18_txsub_open_submissionsSql,
+	"migrations/19_webhooks.sql": migrations19_webhooksSql,
 	"migrations/1_initial_schema.sql": migrations1_initial_schemaSql,
+	"migrations/20_commission_payer.sql": migrations20_commission_payerSql,
+	"migrations/21_account_type_limits_counterparty.sql": migrations21_account_type_limits_counterpartySql,
+	"migrations/22_commission_validity_unique.sql": migrations22_commission_validity_uniqueSql,
 	"migrations/2_index_participants_by_toid.sql": migrations2_index_participants_by_toidSql,
 	"migrations/3_aggregate_expenses_for_accounts.sql": migrations3_aggregate_expenses_for_accountsSql,
 	"migrations/7_account_limits.sql": migrations7_account_limitsSql,
@@ -361,7 +647,20 @@ type bintree struct {
 var _bintree = &bintree{nil, map[string]*bintree{
 	"latest.sql": &bintree{latestSql, map[string]*bintree{}},
 	"migrations": &bintree{nil, map[string]*bintree{
+		"10_account_type_limits.sql": &bintree{migrations10_account_type_limitsSql, map[string]*bintree{}},
+		"11_account_limits_periods.sql": &bintree{migrations11_account_limits_periodsSql, map[string]*bintree{}},
+		"12_commission_tiers.sql": &bintree{migrations12_commission_tiersSql, map[string]*bintree{}},
+		"13_commission_validity.sql": &bintree{migrations13_commission_validitySql, map[string]*bintree{}},
+		"14_audit_log.sql": &bintree{migrations14_audit_logSql, map[string]*bintree{}},
+		"15_audit_log_snapshots.sql": &bintree{migrations15_audit_log_snapshotsSql, map[string]*bintree{}},
+		"16_account_block_details.sql": &bintree{migrations16_account_block_detailsSql, map[string]*bintree{}},
+		"17_statistics_storage.sql": &bintree{migrations17_statistics_storageSql, map[string]*bintree{}},
+		"18_txsub_open_submissions.sql": &bintree{migrations18_txsub_open_submissionsSql, map[string]*bintree{}},
+		"19_webhooks.sql": &bintree{migrations19_webhooksSql, map[string]*bintree{}},
 		"1_initial_schema.sql": &bintree{migrations1_initial_schemaSql, map[string]*bintree{}},
+		"20_commission_payer.sql": &bintree{migrations20_commission_payerSql, map[string]*bintree{}},
+		"21_account_type_limits_counterparty.sql": &bintree{migrations21_account_type_limits_counterpartySql, map[string]*bintree{}},
+		"22_commission_validity_unique.sql": &bintree{migrations22_commission_validity_uniqueSql, map[string]*bintree{}},
 		"2_index_participants_by_toid.sql": &bintree{migrations2_index_participants_by_toidSql, map[string]*bintree{}},
 		"3_aggregate_expenses_for_accounts.sql": &bintree{migrations3_aggregate_expenses_for_accountsSql, map[string]*bintree{}},
 		"7_account_limits.sql": &bintree{migrations7_account_limitsSql, map[string]*bintree{}},
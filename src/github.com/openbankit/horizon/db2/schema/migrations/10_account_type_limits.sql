@@ -0,0 +1,17 @@
+-- +migrate Up
+
+CREATE TABLE account_type_limits (
+    account_type      integer NOT NULL,
+    asset_code        character varying(12) NOT NULL,
+    max_operation_out bigint NOT NULL DEFAULT -1,
+    daily_max_out     bigint NOT NULL DEFAULT -1,
+    monthly_max_out   bigint NOT NULL DEFAULT -1,
+    max_operation_in  bigint NOT NULL DEFAULT -1,
+    daily_max_in      bigint NOT NULL DEFAULT -1,
+    monthly_max_in    bigint NOT NULL DEFAULT -1,
+    PRIMARY KEY(account_type, asset_code)
+);
+
+-- +migrate Down
+
+DROP TABLE account_type_limits;
@@ -0,0 +1,34 @@
+-- +migrate Up
+
+ALTER TABLE account_limits
+  ADD COLUMN counterparty_type smallint NOT NULL DEFAULT -1,
+  ADD COLUMN weekly_max_out bigint NOT NULL DEFAULT -1,
+  ADD COLUMN annual_max_out bigint NOT NULL DEFAULT -1,
+  ADD COLUMN weekly_max_in bigint NOT NULL DEFAULT -1,
+  ADD COLUMN annual_max_in bigint NOT NULL DEFAULT -1;
+ALTER TABLE account_limits DROP CONSTRAINT account_limits_pkey;
+ALTER TABLE account_limits ADD PRIMARY KEY (address, asset_code, counterparty_type);
+
+ALTER TABLE account_type_limits
+  ADD COLUMN weekly_max_out bigint NOT NULL DEFAULT -1,
+  ADD COLUMN annual_max_out bigint NOT NULL DEFAULT -1,
+  ADD COLUMN weekly_max_in bigint NOT NULL DEFAULT -1,
+  ADD COLUMN annual_max_in bigint NOT NULL DEFAULT -1;
+
+-- +migrate Down
+
+ALTER TABLE account_type_limits
+  DROP COLUMN weekly_max_out,
+  DROP COLUMN annual_max_out,
+  DROP COLUMN weekly_max_in,
+  DROP COLUMN annual_max_in;
+
+DELETE FROM account_limits WHERE counterparty_type <> -1;
+ALTER TABLE account_limits DROP CONSTRAINT account_limits_pkey;
+ALTER TABLE account_limits ADD PRIMARY KEY (address, asset_code);
+ALTER TABLE account_limits
+  DROP COLUMN counterparty_type,
+  DROP COLUMN weekly_max_out,
+  DROP COLUMN annual_max_out,
+  DROP COLUMN weekly_max_in,
+  DROP COLUMN annual_max_in;
@@ -0,0 +1,13 @@
+-- +migrate Up
+
+ALTER TABLE commission
+  ADD COLUMN min_fee bigint NOT NULL DEFAULT 0,
+  ADD COLUMN max_fee bigint NOT NULL DEFAULT 0,
+  ADD COLUMN tiers jsonb NOT NULL DEFAULT '[]';
+
+-- +migrate Down
+
+ALTER TABLE commission
+  DROP COLUMN min_fee,
+  DROP COLUMN max_fee,
+  DROP COLUMN tiers;
@@ -0,0 +1,11 @@
+-- +migrate Up
+
+ALTER TABLE commission
+  ADD COLUMN valid_from timestamp with time zone,
+  ADD COLUMN valid_until timestamp with time zone;
+
+-- +migrate Down
+
+ALTER TABLE commission
+  DROP COLUMN valid_from,
+  DROP COLUMN valid_until;
@@ -0,0 +1,19 @@
+-- +migrate Up
+
+CREATE TABLE audit_log (
+    id          bigserial,
+    actor       character varying(64) NOT NULL,
+    subject     character varying(64) NOT NULL,
+    action      character varying(64) NOT NULL,
+    meta        jsonb NOT NULL DEFAULT '{}',
+    created_at  timestamp with time zone NOT NULL DEFAULT now(),
+    PRIMARY KEY(id)
+);
+
+CREATE INDEX audit_log_by_actor ON audit_log USING btree (actor);
+CREATE INDEX audit_log_by_subject ON audit_log USING btree (subject, action);
+CREATE INDEX audit_log_by_created_at ON audit_log USING btree (created_at);
+
+-- +migrate Down
+
+DROP TABLE audit_log;
@@ -0,0 +1,15 @@
+-- +migrate Up
+
+ALTER TABLE audit_log
+  ADD COLUMN tx_hash character varying(64) NOT NULL DEFAULT '',
+  ADD COLUMN before_state jsonb NOT NULL DEFAULT 'null',
+  ADD COLUMN after_state jsonb NOT NULL DEFAULT 'null';
+
+CREATE INDEX audit_log_by_tx_hash ON audit_log USING btree (tx_hash);
+
+-- +migrate Down
+
+ALTER TABLE audit_log
+  DROP COLUMN tx_hash,
+  DROP COLUMN before_state,
+  DROP COLUMN after_state;
@@ -0,0 +1,19 @@
+-- +migrate Up
+
+ALTER TABLE history_accounts
+  ADD COLUMN block_incoming_reason character varying(64) DEFAULT '' NOT NULL,
+  ADD COLUMN block_incoming_until timestamp with time zone,
+  ADD COLUMN block_incoming_note text DEFAULT '' NOT NULL,
+  ADD COLUMN block_outcoming_reason character varying(64) DEFAULT '' NOT NULL,
+  ADD COLUMN block_outcoming_until timestamp with time zone,
+  ADD COLUMN block_outcoming_note text DEFAULT '' NOT NULL;
+
+-- +migrate Down
+
+ALTER TABLE history_accounts
+  DROP COLUMN block_incoming_reason,
+  DROP COLUMN block_incoming_until,
+  DROP COLUMN block_incoming_note,
+  DROP COLUMN block_outcoming_reason,
+  DROP COLUMN block_outcoming_until,
+  DROP COLUMN block_outcoming_note;
@@ -0,0 +1,18 @@
+-- +migrate Up
+
+CREATE SEQUENCE statistics_storage_version_seq;
+
+CREATE TABLE statistics_storage (
+    name        character varying(255) NOT NULL,
+    fields      jsonb NOT NULL DEFAULT '{}',
+    version     bigint NOT NULL,
+    expires_at  timestamp with time zone,
+    PRIMARY KEY(name)
+);
+
+CREATE INDEX statistics_storage_by_expires_at ON statistics_storage USING btree (expires_at);
+
+-- +migrate Down
+
+DROP TABLE statistics_storage;
+DROP SEQUENCE statistics_storage_version_seq;
@@ -0,0 +1,16 @@
+-- +migrate Up
+
+CREATE TABLE txsub_open_submissions (
+    hash          character(64) NOT NULL,
+    address       character varying(64) NOT NULL,
+    sequence      bigint NOT NULL,
+    submitted_at  timestamp with time zone NOT NULL,
+    PRIMARY KEY(hash)
+);
+
+CREATE INDEX txsub_open_submissions_by_address ON txsub_open_submissions USING btree (address);
+CREATE INDEX txsub_open_submissions_by_submitted_at ON txsub_open_submissions USING btree (submitted_at);
+
+-- +migrate Down
+
+DROP TABLE txsub_open_submissions;
@@ -0,0 +1,51 @@
+-- +migrate Up
+
+CREATE TABLE webhook_subscriptions (
+    id           bigserial,
+    url          character varying(1024) NOT NULL,
+    secret       character varying(255) NOT NULL,
+    account      character varying(64),
+    event_types  character varying(255) NOT NULL,
+    created_at   timestamp with time zone NOT NULL DEFAULT now(),
+    PRIMARY KEY(id)
+);
+
+CREATE TABLE webhook_deliveries (
+    id               bigserial,
+    subscription_id  bigint NOT NULL REFERENCES webhook_subscriptions (id) ON DELETE CASCADE,
+    event_id         character varying(128) NOT NULL,
+    event_type       character varying(32) NOT NULL,
+    payload          text NOT NULL,
+    status           character varying(16) NOT NULL DEFAULT 'pending',
+    attempts         integer NOT NULL DEFAULT 0,
+    next_attempt_at  timestamp with time zone NOT NULL,
+    last_error       text NOT NULL DEFAULT '',
+    created_at       timestamp with time zone NOT NULL DEFAULT now(),
+    delivered_at     timestamp with time zone,
+    PRIMARY KEY(id)
+);
+
+CREATE UNIQUE INDEX webhook_deliveries_by_event ON webhook_deliveries USING btree (subscription_id, event_id);
+CREATE INDEX webhook_deliveries_by_next_attempt_at ON webhook_deliveries USING btree (status, next_attempt_at);
+
+CREATE TABLE webhook_dead_letters (
+    id               bigserial,
+    delivery_id      bigint NOT NULL,
+    subscription_id  bigint NOT NULL,
+    url              character varying(1024) NOT NULL,
+    event_id         character varying(128) NOT NULL,
+    event_type       character varying(32) NOT NULL,
+    payload          text NOT NULL,
+    attempts         integer NOT NULL,
+    last_error       text NOT NULL,
+    failed_at        timestamp with time zone NOT NULL DEFAULT now(),
+    PRIMARY KEY(id)
+);
+
+CREATE INDEX webhook_dead_letters_by_subscription ON webhook_dead_letters USING btree (subscription_id);
+
+-- +migrate Down
+
+DROP TABLE webhook_dead_letters;
+DROP TABLE webhook_deliveries;
+DROP TABLE webhook_subscriptions;
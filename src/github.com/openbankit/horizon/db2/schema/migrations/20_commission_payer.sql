@@ -0,0 +1,9 @@
+-- +migrate Up
+
+ALTER TABLE history_accounts
+  ADD COLUMN commission_payer character varying(64) DEFAULT '' NOT NULL;
+
+-- +migrate Down
+
+ALTER TABLE history_accounts
+  DROP COLUMN commission_payer;
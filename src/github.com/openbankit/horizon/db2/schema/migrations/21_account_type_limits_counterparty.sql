@@ -0,0 +1,13 @@
+-- +migrate Up
+
+ALTER TABLE account_type_limits
+  ADD COLUMN counterparty_type smallint NOT NULL DEFAULT -1;
+ALTER TABLE account_type_limits DROP CONSTRAINT account_type_limits_pkey;
+ALTER TABLE account_type_limits ADD PRIMARY KEY (account_type, asset_code, counterparty_type);
+
+-- +migrate Down
+
+DELETE FROM account_type_limits WHERE counterparty_type <> -1;
+ALTER TABLE account_type_limits DROP CONSTRAINT account_type_limits_pkey;
+ALTER TABLE account_type_limits ADD PRIMARY KEY (account_type, asset_code);
+ALTER TABLE account_type_limits DROP COLUMN counterparty_type;
@@ -0,0 +1,11 @@
+-- +migrate Up
+
+DROP INDEX commission_by_hash;
+CREATE UNIQUE INDEX commission_by_hash ON commission USING btree (key_hash,
+  COALESCE(valid_from, '-infinity'::timestamp with time zone), COALESCE(valid_until, 'infinity'::timestamp with time zone));
+
+-- +migrate Down
+
+DELETE FROM commission c USING commission newer WHERE c.key_hash = newer.key_hash AND c.id < newer.id;
+DROP INDEX commission_by_hash;
+CREATE UNIQUE INDEX commission_by_hash ON commission USING btree (key_hash);
@@ -98,6 +98,7 @@ func (i *System) ReingestRange(start, end int32) (int, error) {
 		CurrentVersion,
 	)
 	is.ClearExisting = true
+	is.CommissionAccount = i.CommissionAccount
 	err := is.Run()
 	return is.Ingested, err
 }
@@ -158,6 +159,7 @@ func (i *System) runOnce() {
 			i.Metrics,
 			CurrentVersion,
 		)
+		is.CommissionAccount = i.CommissionAccount
 
 		err = is.Run()
 
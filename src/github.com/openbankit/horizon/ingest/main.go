@@ -39,6 +39,9 @@ type System struct {
 	// Network is the passphrase for the network being imported
 	Network string
 
+	// CommissionAccount is the bank's commission account, used to find sponsored commissions
+	CommissionAccount string
+
 	tick            *time.Ticker
 	historySequence int32
 	coreSequence    int32
@@ -91,6 +94,7 @@ func RunOnce(network string, core, horizon *db2.Repo, historyAccountCache *cache
 		i.Metrics,
 		CurrentVersion,
 	)
+	is.CommissionAccount = i.CommissionAccount
 
 	err = is.Run()
 
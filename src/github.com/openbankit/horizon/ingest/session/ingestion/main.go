@@ -5,6 +5,7 @@ import (
 	"github.com/openbankit/horizon/db2"
 	"github.com/openbankit/horizon/db2/history"
 	"github.com/openbankit/horizon/db2/sqx"
+	"github.com/openbankit/horizon/webhook"
 	sq "github.com/lann/squirrel"
 )
 
@@ -29,6 +30,9 @@ type Ingestion struct {
 	// cache
 	statisticsCache          *cache.AccountStatistics
 	HistoryAccountCache      *cache.HistoryAccount
+
+	// webhook subscriptions loaded within current transaction, nil if not loaded yet
+	subscriptions []webhook.Subscription
 }
 
 func New(db *db2.Repo, historyAccountCache *cache.HistoryAccount, currentVersion int) *Ingestion {
@@ -65,6 +69,7 @@ func (ingest *Ingestion) Start() (err error) {
 	}
 
 	ingest.createInsertBuilders()
+	ingest.subscriptions = nil
 
 	return
 }
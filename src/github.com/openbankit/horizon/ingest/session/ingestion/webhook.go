@@ -0,0 +1,26 @@
+package ingestion
+
+import (
+	"github.com/openbankit/horizon/webhook"
+)
+
+// Webhook enqueues deliveries of the event to matching webhook subscriptions within ingestion
+// transaction, so that the event is delivered once the ledger is committed.
+func (ingest *Ingestion) Webhook(event webhook.Event) error {
+	q := &webhook.Q{Repo: ingest.DB}
+	if ingest.subscriptions == nil {
+		subscriptions := make([]webhook.Subscription, 0)
+		err := q.Subscriptions(&subscriptions)
+		if err != nil {
+			return err
+		}
+		ingest.subscriptions = subscriptions
+	}
+
+	if len(ingest.subscriptions) == 0 {
+		return nil
+	}
+
+	_, err := q.Enqueue(ingest.subscriptions, event)
+	return err
+}
@@ -16,6 +16,9 @@ type Session struct {
 	// when the session is run.
 	ClearExisting bool
 
+	// CommissionAccount is the bank's commission account, used to find sponsored commissions
+	CommissionAccount string
+
 	// Metrics is a reference to where the session should record its metric information
 	Metrics *IngesterMetrics
 
@@ -40,5 +40,10 @@ func (is *Session) ingestPayment(sourceAddress, destAddress string, sourceAmount
 		return err
 	}
 
-	return is.Ingestion.UpdateStatistics(destAddress, destAsset, sourceAccount.AccountType, int64(destAmount), ledgerCloseTime, now, true)
+	err = is.Ingestion.UpdateStatistics(destAddress, destAsset, sourceAccount.AccountType, int64(destAmount), ledgerCloseTime, now, true)
+	if err != nil {
+		return err
+	}
+
+	return is.ingestPaymentEvent(sourceAddress, destAddress, sourceAmount, destAmount, sourceAsset, destAsset)
 }
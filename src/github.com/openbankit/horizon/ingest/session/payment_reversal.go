@@ -35,5 +35,10 @@ func (is *Session) ingestPaymentReversal(storedPaymentID int64, reversalSourceAd
 		return err
 	}
 
-	return is.Ingestion.UpdateStatistics(paymentSource.Address, assetCode, reversalSource.AccountType, -int64(amount), storedOp.ClosedAt, now, false)
+	err = is.Ingestion.UpdateStatistics(paymentSource.Address, assetCode, reversalSource.AccountType, -int64(amount), storedOp.ClosedAt, now, false)
+	if err != nil {
+		return err
+	}
+
+	return is.ingestPaymentReversalEvent(storedPaymentID, reversalSource.Address, paymentSource.Address, assetCode, amount)
 }
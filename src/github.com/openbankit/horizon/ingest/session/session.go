@@ -8,6 +8,7 @@ import (
 	"github.com/openbankit/go-base/amount"
 	"github.com/openbankit/go-base/xdr"
 	"github.com/openbankit/horizon/admin"
+	"github.com/openbankit/horizon/commissions"
 	"github.com/openbankit/horizon/db2/history"
 	"github.com/openbankit/horizon/db2/history/details"
 	"github.com/openbankit/horizon/ingest/participants"
@@ -182,6 +183,7 @@ func (is *Session) ingestOperation() error {
 		}
 
 		adminActionProvider := admin.NewAdminActionProvider(&history.Q{is.Ingestion.DB})
+		adminActionProvider.SetAuditInfo(is.Cursor.OperationSourceAccount().Address(), is.Cursor.Transaction().TransactionHash)
 		adminAction, err := adminActionProvider.CreateNewParser(opData)
 		if err != nil {
 			return err
@@ -190,12 +192,16 @@ func (is *Session) ingestOperation() error {
 		adminAction.Validate()
 		if adminAction.GetError() != nil {
 			logger.WithError(adminAction.GetError()).Error("Failed to validate admin action")
-			break
+		} else {
+			adminAction.Apply()
+			if adminAction.GetError() != nil {
+				logger.WithError(adminAction.GetError()).Error("Failed to apply admin action")
+			}
 		}
-		adminAction.Apply()
-		if adminAction.GetError() != nil {
-			logger.WithError(adminAction.GetError()).Error("Failed to apply admin action")
-			break
+
+		err = is.ingestAdminOperationEvent(opData, adminAction.GetError())
+		if err != nil {
+			return err
 		}
 	case xdr.OperationTypePaymentReversal:
 		// Update statistics for both accounts
@@ -339,9 +345,22 @@ func getAssetCode(a xdr.Asset) (string, error) {
 	return code, err
 }
 
-func (is *Session) feeDetails(xdrFee xdr.OperationFee) map[string]interface{} {
+// feeDetails returns details of the fee charged for i-th operation of the current transaction,
+// including commission paid by sponsor
+func (is *Session) feeDetails(xdrFee xdr.OperationFee, i int32) map[string]interface{} {
 	fee := details.Fee{}
 	fee.Populate(xdrFee)
+
+	// sponsored operations are not charged
+	if xdrFee.Type != xdr.OperationFeeTypeOpFeeNone {
+		return fee.ToMap()
+	}
+
+	tx := is.Cursor.Transaction().Envelope.Tx
+	sponsorship := commissions.GetSponsorship(tx.SourceAccount, tx.Operations, int(i), is.CommissionAccount)
+	if sponsorship != nil {
+		fee.Sponsor = sponsorship.FeeSponsor()
+	}
 	return fee.ToMap()
 }
 
@@ -353,7 +372,7 @@ func (is *Session) operationDetails() map[string]interface{} {
 	source := c.OperationSourceAccount()
 
 	fee := c.Transaction().Envelope.OperationFees[c.OperationOrder()-1]
-	opDetails["fee"] = is.feeDetails(fee)
+	opDetails["fee"] = is.feeDetails(fee, c.OperationOrder()-1)
 
 	switch c.OperationType() {
 	case xdr.OperationTypeCreateAccount:
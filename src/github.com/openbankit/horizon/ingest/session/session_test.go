@@ -0,0 +1,95 @@
+package session
+
+import (
+	"testing"
+
+	"github.com/openbankit/go-base/amount"
+	"github.com/openbankit/go-base/keypair"
+	"github.com/openbankit/go-base/xdr"
+	"github.com/openbankit/horizon/db2/core"
+	"github.com/openbankit/horizon/helpers"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestFeeDetails(t *testing.T) {
+	accountID := func() xdr.AccountId {
+		kp, err := keypair.Random()
+		So(err, ShouldBeNil)
+		id, err := helpers.ParseAccountId(kp.Address())
+		So(err, ShouldBeNil)
+		return id
+	}
+	payment := func(source *xdr.AccountId, destination xdr.AccountId, asset xdr.Asset, paymentAmount xdr.Int64) xdr.Operation {
+		return xdr.Operation{
+			SourceAccount: source,
+			Body: xdr.OperationBody{
+				Type: xdr.OperationTypePayment,
+				PaymentOp: &xdr.PaymentOp{
+					Destination: destination,
+					Asset:       asset,
+					Amount:      paymentAmount,
+				},
+			},
+		}
+	}
+
+	Convey("feeDetails", t, func() {
+		sender := accountID()
+		merchant := accountID()
+		sponsor := accountID()
+		commissionAccount := accountID()
+		asset, err := xdr.NewAsset(xdr.AssetTypeAssetTypeCreditAlphanum4, xdr.AssetAlphaNum4{
+			AssetCode: [4]byte{'E', 'U', 'R'},
+			Issuer:    commissionAccount,
+		})
+		So(err, ShouldBeNil)
+		fee := xdr.Int64(amount.One)
+
+		is := &Session{
+			Cursor: &Cursor{
+				data: &LedgerBundle{
+					Transactions: []core.Transaction{{
+						Envelope: xdr.TransactionEnvelope{
+							Tx: xdr.Transaction{
+								SourceAccount: sender,
+								Operations: []xdr.Operation{
+									payment(nil, merchant, asset, 100*amount.One),
+									payment(&sponsor, commissionAccount, asset, fee),
+								},
+							},
+						},
+					}},
+				},
+			},
+			CommissionAccount: commissionAccount.Address(),
+		}
+
+		Convey("sponsored operation", func() {
+			result := is.feeDetails(xdr.OperationFee{Type: xdr.OperationFeeTypeOpFeeNone}, 0)
+			So(result["type_i"], ShouldEqual, int32(xdr.OperationFeeTypeOpFeeNone))
+			So(result["sponsor"], ShouldResemble, map[string]interface{}{
+				"account": sponsor.Address(),
+				"amount":  amount.String(fee),
+			})
+		})
+		Convey("charged operation is not sponsored", func() {
+			percentFee := xdr.Int64(0)
+			result := is.feeDetails(xdr.OperationFee{
+				Type: xdr.OperationFeeTypeOpFeeCharged,
+				Fee: &xdr.OperationFeeFee{
+					Asset:          asset,
+					AmountToCharge: fee,
+					PercentFee:     &percentFee,
+					FlatFee:        &fee,
+				},
+			}, 0)
+			So(result["type_i"], ShouldEqual, int32(xdr.OperationFeeTypeOpFeeCharged))
+			So(result, ShouldNotContainKey, "sponsor")
+		})
+		Convey("commission account is not set", func() {
+			is.CommissionAccount = ""
+			result := is.feeDetails(xdr.OperationFee{Type: xdr.OperationFeeTypeOpFeeNone}, 0)
+			So(result, ShouldNotContainKey, "sponsor")
+		})
+	})
+}
@@ -0,0 +1,59 @@
+package session
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/openbankit/go-base/amount"
+	"github.com/openbankit/go-base/xdr"
+	"github.com/openbankit/horizon/resource/operations"
+	"github.com/openbankit/horizon/webhook"
+)
+
+// webhookLedger describes current operation for ledger webhook events
+func (is *Session) webhookLedger() webhook.Ledger {
+	return webhook.Ledger{
+		OperationID:     strconv.FormatInt(is.Cursor.OperationID(), 10),
+		TransactionHash: is.Cursor.Transaction().TransactionHash,
+		Ledger:          is.Cursor.LedgerSequence(),
+		ClosedAt:        time.Unix(is.Cursor.Ledger().CloseTime, 0).UTC(),
+	}
+}
+
+func (is *Session) ingestPaymentEvent(sourceAddress, destAddress string, sourceAmount, destAmount xdr.Int64, sourceAsset, destAsset string) error {
+	return is.Ingestion.Webhook(webhook.NewPaymentEvent(webhook.Payment{
+		Ledger:            is.webhookLedger(),
+		OperationType:     operations.TypeNames[is.Cursor.OperationType()],
+		From:              sourceAddress,
+		To:                destAddress,
+		SourceAmount:      amount.String(sourceAmount),
+		SourceAsset:       sourceAsset,
+		DestinationAmount: amount.String(destAmount),
+		DestinationAsset:  destAsset,
+	}))
+}
+
+func (is *Session) ingestPaymentReversalEvent(storedPaymentID int64, reversalSourceAddress, paymentSourceAddress, assetCode string, paymentAmount xdr.Int64) error {
+	return is.Ingestion.Webhook(webhook.NewPaymentReversalEvent(webhook.PaymentReversal{
+		Ledger:    is.webhookLedger(),
+		PaymentID: strconv.FormatInt(storedPaymentID, 10),
+		From:      reversalSourceAddress,
+		To:        paymentSourceAddress,
+		Amount:    amount.String(paymentAmount),
+		AssetCode: assetCode,
+	}))
+}
+
+func (is *Session) ingestAdminOperationEvent(opData map[string]interface{}, actionErr error) error {
+	data := webhook.AdminOperation{
+		Ledger:  is.webhookLedger(),
+		Source:  is.Cursor.OperationSourceAccount().Address(),
+		OpData:  opData,
+		Applied: actionErr == nil,
+	}
+
+	if actionErr != nil {
+		data.Error = actionErr.Error()
+	}
+	return is.Ingestion.Webhook(webhook.NewAdminOperationEvent(data))
+}
@@ -0,0 +1,154 @@
+package statistics
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/go-errors/errors"
+	"github.com/openbankit/go-base/amount"
+	"github.com/openbankit/go-base/xdr"
+	"github.com/openbankit/horizon/db2/history"
+	"github.com/openbankit/horizon/log"
+)
+
+// paymentDetails contains fields of payment operations details, used to compute movements of funds
+type paymentDetails struct {
+	From            string `json:"from"`
+	To              string `json:"to"`
+	ExchangeAgent   string `json:"exchangeAgent"`
+	Amount          string `json:"amount"`
+	SourceAmount    string `json:"source_amount"`
+	AssetCode       string `json:"asset_code"`
+	SourceAssetCode string `json:"source_asset_code"`
+	SourceAccount   string `json:"source_account"`
+	PaymentSource   string `json:"payment_source"`
+	PaymentID       int64  `json:"payment_id"`
+}
+
+// movement is a change of account's income or outcome caused by payment operation.
+// Amount is negative for reversals.
+type movement struct {
+	Account          string
+	AssetCode        string
+	CounterpartyType xdr.AccountType
+	Amount           int64
+	IsIncome         bool
+	// PerformedAt is close time of payment. For reversals it is close time of reversed payment
+	PerformedAt time.Time
+}
+
+// paymentReader converts payments, path payments, external payments and payment reversals
+// into movements of funds of both participants
+type paymentReader struct {
+	historyQ     *history.Q
+	accountTypes map[string]xdr.AccountType
+	log          *log.Entry
+}
+
+func newPaymentReader(historyQ *history.Q) *paymentReader {
+	return &paymentReader{
+		historyQ:     historyQ,
+		accountTypes: make(map[string]xdr.AccountType),
+		log:          log.WithField("service", "payment_reader"),
+	}
+}
+
+// read returns movements of funds caused by operation. Returns nil for operations, which are not payments.
+func (p *paymentReader) read(op *history.Operation) ([]movement, error) {
+	var details paymentDetails
+	err := op.UnmarshalDetails(&details)
+	if err != nil {
+		return nil, err
+	}
+
+	closedAt := op.ClosedAt.Local()
+	switch op.Type {
+	case xdr.OperationTypePayment:
+		return p.readPayment(details.From, details.To, details.Amount, details.Amount, details.AssetCode, details.AssetCode, closedAt)
+	case xdr.OperationTypePathPayment:
+		return p.readPayment(details.From, details.To, details.SourceAmount, details.Amount, details.SourceAssetCode, details.AssetCode, closedAt)
+	case xdr.OperationTypeExternalPayment:
+		return p.readPayment(details.From, details.ExchangeAgent, details.Amount, details.Amount, details.AssetCode, details.AssetCode, closedAt)
+	case xdr.OperationTypePaymentReversal:
+		return p.readReversal(details)
+	default:
+		p.log.WithField("operation_id", op.ID).WithField("type", op.Type).Warn("Unexpected operation type")
+		return nil, nil
+	}
+}
+
+func (p *paymentReader) readPayment(from, to, rawSourceAmount, rawDestAmount, sourceAsset, destAsset string, closedAt time.Time) ([]movement, error) {
+	sourceAmount, err := amount.Parse(rawSourceAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	destAmount, err := amount.Parse(rawDestAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	fromType, err := p.getAccountType(from)
+	if err != nil {
+		return nil, err
+	}
+
+	toType, err := p.getAccountType(to)
+	if err != nil {
+		return nil, err
+	}
+
+	return []movement{
+		{Account: from, AssetCode: sourceAsset, CounterpartyType: toType, Amount: int64(sourceAmount), PerformedAt: closedAt},
+		{Account: to, AssetCode: destAsset, CounterpartyType: fromType, Amount: int64(destAmount), IsIncome: true, PerformedAt: closedAt},
+	}, nil
+}
+
+// readReversal returns movements subtracting reversed amount at the time, payment was performed at
+func (p *paymentReader) readReversal(details paymentDetails) ([]movement, error) {
+	reversedAmount, err := amount.Parse(details.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	var payment history.Operation
+	err = p.historyQ.OperationByID(&payment, details.PaymentID)
+	if err != nil {
+		return nil, err
+	}
+
+	reversalSourceType, err := p.getAccountType(details.SourceAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	paymentSourceType, err := p.getAccountType(details.PaymentSource)
+	if err != nil {
+		return nil, err
+	}
+
+	closedAt := payment.ClosedAt.Local()
+	return []movement{
+		{Account: details.SourceAccount, AssetCode: details.AssetCode, CounterpartyType: paymentSourceType, Amount: -int64(reversedAmount), IsIncome: true, PerformedAt: closedAt},
+		{Account: details.PaymentSource, AssetCode: details.AssetCode, CounterpartyType: reversalSourceType, Amount: -int64(reversedAmount), PerformedAt: closedAt},
+	}, nil
+}
+
+func (p *paymentReader) getAccountType(address string) (xdr.AccountType, error) {
+	accountType, ok := p.accountTypes[address]
+	if ok {
+		return accountType, nil
+	}
+
+	var account history.Account
+	err := p.historyQ.AccountByAddress(&account, address)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, errors.Errorf("account %s does not exist in history", address)
+		}
+		return 0, err
+	}
+
+	p.accountTypes[address] = account.AccountType
+	return account.AccountType, nil
+}
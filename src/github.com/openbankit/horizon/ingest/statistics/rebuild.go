@@ -0,0 +1,216 @@
+package statistics
+
+import (
+	"sort"
+	"time"
+
+	"github.com/openbankit/go-base/xdr"
+	"github.com/openbankit/horizon/db2"
+	"github.com/openbankit/horizon/db2/history"
+	"github.com/openbankit/horizon/log"
+)
+
+// Rebuilder recomputes account statistics by replaying payments, path payments, external payments
+// and payment reversals stored in history_operations. Only operations closed in the current year
+// affect statistics, so only they are replayed.
+type Rebuilder struct {
+	historyQ *history.Q
+	account  string
+	asset    string
+	log      *log.Entry
+
+	payments *paymentReader
+	stats    map[statsKey]*history.AccountStatistics
+}
+
+type statsKey struct {
+	account          string
+	asset            string
+	counterpartyType int16
+}
+
+func newStatsKey(stats *history.AccountStatistics) statsKey {
+	return statsKey{
+		account:          stats.Account,
+		asset:            stats.AssetCode,
+		counterpartyType: stats.CounterpartyType,
+	}
+}
+
+// Difference describes mismatch between stored and rebuilt statistics. Stored is nil, if row does not exist.
+type Difference struct {
+	Stored  *history.AccountStatistics
+	Rebuilt history.AccountStatistics
+}
+
+// NewRebuilder creates new rebuilder. If account or asset is not empty, only statistics of
+// specified account or asset are rebuilt.
+func NewRebuilder(historyQ *history.Q, account, asset string) *Rebuilder {
+	return &Rebuilder{
+		historyQ: historyQ,
+		account:  account,
+		asset:    asset,
+		log:      log.WithField("service", "statistics_rebuilder"),
+	}
+}
+
+// Rebuild replays operations closed since the beginning of the year into fresh statistics
+func (r *Rebuilder) Rebuild(now time.Time) ([]history.AccountStatistics, error) {
+	r.reset()
+
+	// closed_at is stored in UTC without time zone
+	yearStart := time.Date(now.Year(), time.January, 1, 0, 0, 0, 0, now.Location()).UTC()
+	closedAt := db2.CloseAtQuery{Start: &yearStart}
+	page := db2.PageQuery{Order: db2.OrderAscending, Limit: db2.MaxPageSize}
+	for {
+		ops := r.historyQ.Operations().OnlyPayments()
+		if r.account != "" {
+			ops = ops.ForAccount(r.account)
+		}
+
+		var records []history.Operation
+		err := ops.ClosedAt(closedAt).Page(page).Select(&records)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range records {
+			err = r.replay(&records[i], now)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if uint64(len(records)) < page.Limit {
+			break
+		}
+		page.Cursor = records[len(records)-1].PagingToken()
+	}
+
+	result := make([]history.AccountStatistics, 0, len(r.stats))
+	for _, stats := range r.stats {
+		result = append(result, *stats)
+	}
+	sort.Sort(byKey(result))
+	return result, nil
+}
+
+// Diff compares rebuilt statistics with stored rows. Obsolete stored counters are cleared before comparison.
+func (r *Rebuilder) Diff(rebuilt []history.AccountStatistics, now time.Time) ([]Difference, error) {
+	statsQ := r.historyQ.AccountStatistics()
+	if r.account != "" {
+		statsQ = statsQ.ForAccount(r.account)
+	}
+
+	if r.asset != "" {
+		statsQ = statsQ.ForAsset(r.asset)
+	}
+
+	var stored []history.AccountStatistics
+	err := statsQ.Select(&stored)
+	if err != nil {
+		return nil, err
+	}
+
+	storedByKey := make(map[statsKey]*history.AccountStatistics, len(stored))
+	for i := range stored {
+		stored[i].ClearObsoleteStats(now)
+		storedByKey[newStatsKey(&stored[i])] = &stored[i]
+	}
+
+	var result []Difference
+	for i := range rebuilt {
+		key := newStatsKey(&rebuilt[i])
+		storedStats, ok := storedByKey[key]
+		delete(storedByKey, key)
+		if !ok {
+			if !rebuilt[i].IsZero() {
+				result = append(result, Difference{Rebuilt: rebuilt[i]})
+			}
+			continue
+		}
+
+		if !storedStats.CountersEqual(&rebuilt[i]) {
+			result = append(result, Difference{Stored: storedStats, Rebuilt: rebuilt[i]})
+		}
+	}
+
+	// stored rows without payments must be empty
+	for _, storedStats := range storedByKey {
+		if storedStats.IsZero() {
+			continue
+		}
+
+		empty := history.NewAccountStatistics(storedStats.Account, storedStats.AssetCode, xdr.AccountType(storedStats.CounterpartyType))
+		empty.UpdatedAt = now
+		result = append(result, Difference{Stored: storedStats, Rebuilt: empty})
+	}
+	return result, nil
+}
+
+// Save replaces stored statistics with rebuilt for each difference
+func (r *Rebuilder) Save(diffs []Difference) error {
+	for i := range diffs {
+		var err error
+		if diffs[i].Stored == nil {
+			err = r.historyQ.InsertAccountStatistics(&diffs[i].Rebuilt)
+		} else {
+			err = r.historyQ.UpdateAccountStatistics(&diffs[i].Rebuilt)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *Rebuilder) reset() {
+	r.payments = newPaymentReader(r.historyQ)
+	r.stats = make(map[statsKey]*history.AccountStatistics)
+}
+
+func (r *Rebuilder) replay(op *history.Operation, now time.Time) error {
+	movements, err := r.payments.read(op)
+	if err != nil {
+		return err
+	}
+
+	for _, movement := range movements {
+		r.update(movement, now)
+	}
+	return nil
+}
+
+func (r *Rebuilder) update(movement movement, now time.Time) {
+	if (r.account != "" && movement.Account != r.account) || (r.asset != "" && movement.AssetCode != r.asset) {
+		return
+	}
+
+	key := statsKey{account: movement.Account, asset: movement.AssetCode, counterpartyType: int16(movement.CounterpartyType)}
+	stats, ok := r.stats[key]
+	if !ok {
+		rawStats := history.NewAccountStatistics(movement.Account, movement.AssetCode, movement.CounterpartyType)
+		stats = &rawStats
+		r.stats[key] = stats
+	}
+
+	stats.Update(movement.Amount, movement.PerformedAt, now, movement.IsIncome)
+	stats.UpdatedAt = now
+}
+
+// byKey sorts statistics by account, asset and counterparty type
+type byKey []history.AccountStatistics
+
+func (s byKey) Len() int      { return len(s) }
+func (s byKey) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s byKey) Less(i, j int) bool {
+	if s[i].Account != s[j].Account {
+		return s[i].Account < s[j].Account
+	}
+
+	if s[i].AssetCode != s[j].AssetCode {
+		return s[i].AssetCode < s[j].AssetCode
+	}
+	return s[i].CounterpartyType < s[j].CounterpartyType
+}
@@ -0,0 +1,80 @@
+package statistics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/guregu/null"
+	"github.com/openbankit/go-base/xdr"
+	"github.com/openbankit/horizon/db2/history"
+	"github.com/openbankit/horizon/test"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestRebuilder(t *testing.T) {
+	tt := test.Start(t).Scenario("base")
+	defer tt.Finish()
+
+	historyQ := &history.Q{tt.HorizonRepo()}
+	from := "GAWIB7ETYGSWULO4VB7D6S42YLPGIC7TY7Y2SSJKVOTMQXV5TILYWBUA"
+	to := "GCO5BZT5V3N3SK2CD5UKDSEQJBYFSIMYDV2B75SLKWEXLRYF5GNORYCG"
+
+	Convey("Rebuild statistics", t, func() {
+		now := time.Now()
+		Convey("Old operations do not affect statistics", func() {
+			rebuilder := NewRebuilder(historyQ, "", "")
+			rebuilt, err := rebuilder.Rebuild(now)
+			So(err, ShouldBeNil)
+			So(rebuilt, ShouldBeEmpty)
+			diffs, err := rebuilder.Diff(rebuilt, now)
+			So(err, ShouldBeNil)
+			So(diffs, ShouldBeEmpty)
+		})
+		Convey("Payment is replayed for both accounts", func() {
+			rebuilder := NewRebuilder(historyQ, "", "USD")
+			rebuilder.reset()
+			payment := history.Operation{
+				Type:          xdr.OperationTypePayment,
+				DetailsString: null.StringFrom(`{"from": "` + from + `", "to": "` + to + `", "amount": "10.0000000", "asset_code": "USD"}`),
+				ClosedAt:      now,
+			}
+			err := rebuilder.replay(&payment, now)
+			So(err, ShouldBeNil)
+			// filtered out by asset
+			payment.DetailsString = null.StringFrom(`{"from": "` + from + `", "to": "` + to + `", "amount": "10.0000000", "asset_code": "EUR"}`)
+			err = rebuilder.replay(&payment, now)
+			So(err, ShouldBeNil)
+			So(len(rebuilder.stats), ShouldEqual, 2)
+
+			outcome := rebuilder.stats[statsKey{account: from, asset: "USD", counterpartyType: 6}]
+			So(outcome, ShouldNotBeNil)
+			So(outcome.DailyOutcome, ShouldEqual, 100000000)
+			So(outcome.AnnualOutcome, ShouldEqual, 100000000)
+			So(outcome.DailyIncome, ShouldEqual, 0)
+			income := rebuilder.stats[statsKey{account: to, asset: "USD", counterpartyType: 6}]
+			So(income, ShouldNotBeNil)
+			So(income.DailyIncome, ShouldEqual, 100000000)
+
+			rebuilt := []history.AccountStatistics{*outcome, *income}
+			diffs, err := rebuilder.Diff(rebuilt, now)
+			So(err, ShouldBeNil)
+			So(len(diffs), ShouldEqual, 2)
+			So(diffs[0].Stored, ShouldBeNil)
+
+			err = rebuilder.Save(diffs)
+			So(err, ShouldBeNil)
+			diffs, err = rebuilder.Diff(rebuilt, now)
+			So(err, ShouldBeNil)
+			So(diffs, ShouldBeEmpty)
+
+			// stored rows without payments are reset
+			rebuilt, err = rebuilder.Rebuild(now)
+			So(err, ShouldBeNil)
+			diffs, err = rebuilder.Diff(rebuilt, now)
+			So(err, ShouldBeNil)
+			So(len(diffs), ShouldEqual, 2)
+			So(diffs[0].Stored, ShouldNotBeNil)
+			So(diffs[0].Rebuilt.IsZero(), ShouldBeTrue)
+		})
+	})
+}
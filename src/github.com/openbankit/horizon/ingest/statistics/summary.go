@@ -0,0 +1,203 @@
+package statistics
+
+import (
+	"sort"
+	"time"
+
+	"github.com/openbankit/go-base/xdr"
+	"github.com/openbankit/horizon/db2/history"
+)
+
+// AssetIssuance is amount of asset issued by the bank and amount circulating out of bank and distribution agents
+type AssetIssuance struct {
+	AssetCode   string
+	Issued      int64
+	Circulating int64
+}
+
+// AssetTotal is total amount and number of operations in asset
+type AssetTotal struct {
+	AssetCode string
+	Amount    int64
+	Count     int64
+}
+
+// Volume is total amount of payments in asset sent from accounts of one type to accounts of another type
+type Volume struct {
+	AssetCode string
+	FromType  xdr.AccountType
+	ToType    xdr.AccountType
+	Amount    int64
+	Count     int64
+}
+
+// Summary is bank-wide statistics. Issuance is computed for all operations closed before To,
+// volume, charged fees and reversals - for operations closed within [From, To). Charged fees are commissions
+// recorded in details of payments net of commissions returned by reversals, not credits to the commission account.
+type Summary struct {
+	From        time.Time
+	To          time.Time
+	Issuance    []AssetIssuance
+	Volume      []Volume
+	ChargedFees []AssetTotal
+	Reversals   []AssetTotal
+}
+
+// SummaryBuilder computes bank-wide statistics from payments stored in history_operations
+type SummaryBuilder struct {
+	historyQ *history.Q
+}
+
+func NewSummaryBuilder(historyQ *history.Q) *SummaryBuilder {
+	return &SummaryBuilder{
+		historyQ: historyQ,
+	}
+}
+
+// Build returns summary for [from, to). Issuance is computed from all payments closed before to,
+// so cost of the call grows with history regardless of the window.
+func (b *SummaryBuilder) Build(from, to time.Time) (*Summary, error) {
+	var total []history.PaymentFlow
+	err := b.historyQ.GetPaymentFlows(&total, nil, &to)
+	if err != nil {
+		return nil, err
+	}
+
+	var window []history.PaymentFlow
+	err = b.historyQ.GetPaymentFlows(&window, &from, &to)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := summarize(total, window)
+	summary.From = from
+	summary.To = to
+	return summary, nil
+}
+
+// summarize computes issuance from total flows and volume, charged fees and reversals from flows within the window.
+// Payments from the bank issue asset, payments to the bank redeem it. Funds held by distribution agents
+// are not circulating. Reversals are aggregated in the direction funds are moved, so they are handled as payments.
+func summarize(total, window []history.PaymentFlow) *Summary {
+	issuance := make(map[string]*AssetIssuance)
+	for _, flow := range total {
+		entry, ok := issuance[flow.AssetCode]
+		if !ok {
+			entry = &AssetIssuance{AssetCode: flow.AssetCode}
+			issuance[flow.AssetCode] = entry
+		}
+
+		// payments between accounts of the same type change neither issuance nor circulation
+		if flow.FromType == flow.ToType {
+			continue
+		}
+
+		if flow.FromType == xdr.AccountTypeAccountBank {
+			entry.Issued += flow.Amount
+		} else if flow.ToType == xdr.AccountTypeAccountBank {
+			entry.Issued -= flow.Amount
+		}
+
+		entry.Circulating += circulationDelta(flow)
+	}
+
+	summary := &Summary{
+		Issuance: make([]AssetIssuance, 0, len(issuance)),
+	}
+	for _, entry := range issuance {
+		summary.Issuance = append(summary.Issuance, *entry)
+	}
+	sort.Sort(issuanceByAsset(summary.Issuance))
+
+	chargedFees := make(map[string]*AssetTotal)
+	reversals := make(map[string]*AssetTotal)
+	volume := make(map[Volume]*Volume)
+	for _, flow := range window {
+		addTotal(chargedFees, flow.AssetCode, flow.Fee, 0)
+		if flow.IsReversal {
+			addTotal(reversals, flow.AssetCode, flow.Amount, flow.Count)
+			continue
+		}
+
+		key := Volume{AssetCode: flow.AssetCode, FromType: flow.FromType, ToType: flow.ToType}
+		entry, ok := volume[key]
+		if !ok {
+			entry = &key
+			volume[key] = entry
+		}
+		entry.Amount += flow.Amount
+		entry.Count += flow.Count
+	}
+
+	summary.Volume = make([]Volume, 0, len(volume))
+	for _, entry := range volume {
+		summary.Volume = append(summary.Volume, *entry)
+	}
+	sort.Sort(volumeByKey(summary.Volume))
+	summary.ChargedFees = sortedTotals(chargedFees)
+	summary.Reversals = sortedTotals(reversals)
+	return summary
+}
+
+// circulationDelta returns change of funds held by accounts, which are neither the bank nor distribution agents
+func circulationDelta(flow history.PaymentFlow) int64 {
+	var delta int64
+	if !isBankSide(flow.ToType) {
+		delta += flow.Amount
+	}
+	if !isBankSide(flow.FromType) {
+		delta -= flow.Amount
+	}
+	return delta
+}
+
+func isBankSide(accountType xdr.AccountType) bool {
+	return accountType == xdr.AccountTypeAccountBank || accountType == xdr.AccountTypeAccountDistributionAgent
+}
+
+func addTotal(totals map[string]*AssetTotal, assetCode string, amount, count int64) {
+	entry, ok := totals[assetCode]
+	if !ok {
+		entry = &AssetTotal{AssetCode: assetCode}
+		totals[assetCode] = entry
+	}
+	entry.Amount += amount
+	entry.Count += count
+}
+
+func sortedTotals(totals map[string]*AssetTotal) []AssetTotal {
+	result := make([]AssetTotal, 0, len(totals))
+	for _, entry := range totals {
+		result = append(result, *entry)
+	}
+	sort.Sort(totalsByAsset(result))
+	return result
+}
+
+type issuanceByAsset []AssetIssuance
+
+func (s issuanceByAsset) Len() int           { return len(s) }
+func (s issuanceByAsset) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s issuanceByAsset) Less(i, j int) bool { return s[i].AssetCode < s[j].AssetCode }
+
+type totalsByAsset []AssetTotal
+
+func (s totalsByAsset) Len() int           { return len(s) }
+func (s totalsByAsset) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s totalsByAsset) Less(i, j int) bool { return s[i].AssetCode < s[j].AssetCode }
+
+// volumeByKey sorts volume by asset, type of sender and type of receiver
+type volumeByKey []Volume
+
+func (s volumeByKey) Len() int      { return len(s) }
+func (s volumeByKey) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s volumeByKey) Less(i, j int) bool {
+	if s[i].AssetCode != s[j].AssetCode {
+		return s[i].AssetCode < s[j].AssetCode
+	}
+
+	if s[i].FromType != s[j].FromType {
+		return s[i].FromType < s[j].FromType
+	}
+	return s[i].ToType < s[j].ToType
+}
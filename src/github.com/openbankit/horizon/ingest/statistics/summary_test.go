@@ -0,0 +1,56 @@
+package statistics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openbankit/go-base/xdr"
+	"github.com/openbankit/horizon/db2/history"
+	"github.com/openbankit/horizon/test"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSummaryBuilder(t *testing.T) {
+	tt := test.Start(t).Scenario("base")
+	defer tt.Finish()
+
+	historyQ := &history.Q{tt.HorizonRepo()}
+
+	Convey("Statistics summary", t, func() {
+		Convey("No payments", func() {
+			to := time.Now()
+			summary, err := NewSummaryBuilder(historyQ).Build(to.AddDate(0, 0, -1), to)
+			So(err, ShouldBeNil)
+			So(summary.Issuance, ShouldBeEmpty)
+			So(summary.Volume, ShouldBeEmpty)
+			So(summary.ChargedFees, ShouldBeEmpty)
+			So(summary.Reversals, ShouldBeEmpty)
+		})
+		Convey("Summarize flows", func() {
+			bank := xdr.AccountTypeAccountBank
+			agent := xdr.AccountTypeAccountDistributionAgent
+			user := xdr.AccountTypeAccountRegisteredUser
+			total := []history.PaymentFlow{
+				{AssetCode: "UAH", FromType: bank, ToType: agent, Amount: 1000, Count: 1},
+				{AssetCode: "UAH", FromType: agent, ToType: user, Amount: 300, Count: 2},
+				{AssetCode: "UAH", FromType: user, ToType: user, Amount: 50, Count: 1},
+				{AssetCode: "UAH", FromType: user, ToType: bank, Amount: 100, Count: 1},
+				{AssetCode: "UAH", FromType: user, ToType: agent, Amount: 20, Count: 1, IsReversal: true},
+			}
+			window := []history.PaymentFlow{
+				{AssetCode: "UAH", FromType: agent, ToType: user, Amount: 300, Fee: 3, Count: 2},
+				{AssetCode: "UAH", FromType: user, ToType: agent, Amount: 20, Fee: -1, Count: 1, IsReversal: true},
+			}
+
+			summary := summarize(total, window)
+			So(summary.Issuance, ShouldResemble, []AssetIssuance{
+				{AssetCode: "UAH", Issued: 900, Circulating: 180},
+			})
+			So(summary.Volume, ShouldResemble, []Volume{
+				{AssetCode: "UAH", FromType: agent, ToType: user, Amount: 300, Count: 2},
+			})
+			So(summary.ChargedFees, ShouldResemble, []AssetTotal{{AssetCode: "UAH", Amount: 2}})
+			So(summary.Reversals, ShouldResemble, []AssetTotal{{AssetCode: "UAH", Amount: 20, Count: 1}})
+		})
+	})
+}
@@ -0,0 +1,152 @@
+package statistics
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/openbankit/go-base/xdr"
+	"github.com/openbankit/horizon/db2"
+	"github.com/openbankit/horizon/db2/history"
+)
+
+const (
+	TurnoverByDay   = "day"
+	TurnoverByMonth = "month"
+)
+
+var ErrInvalidTurnoverGroup = errors.New("group must be one of: day, month")
+
+// TurnoverEntry is income and outcome of account in asset from/to counterparties of specified type
+// for the period starting at PeriodStart
+type TurnoverEntry struct {
+	PeriodStart      time.Time
+	AssetCode        string
+	CounterpartyType xdr.AccountType
+	Income           int64
+	Outcome          int64
+}
+
+type turnoverKey struct {
+	periodStart      time.Time
+	asset            string
+	counterpartyType xdr.AccountType
+}
+
+// TurnoverBuilder computes account turnover for arbitrary periods from payments, path payments,
+// external payments and payment reversals stored in history_operations.
+type TurnoverBuilder struct {
+	historyQ *history.Q
+	account  string
+	asset    string
+	groupBy  string
+}
+
+// NewTurnoverBuilder creates new turnover builder. If asset is not empty, only turnover in specified asset is computed.
+func NewTurnoverBuilder(historyQ *history.Q, account, asset, groupBy string) (*TurnoverBuilder, error) {
+	if groupBy != TurnoverByDay && groupBy != TurnoverByMonth {
+		return nil, ErrInvalidTurnoverGroup
+	}
+
+	return &TurnoverBuilder{
+		historyQ: historyQ,
+		account:  account,
+		asset:    asset,
+		groupBy:  groupBy,
+	}, nil
+}
+
+// Build returns turnover for operations closed within [from, to), sorted by period, asset and counterparty type.
+// Periods are aligned to days or months in location of from. Reversals decrease turnover of the period
+// they were performed in.
+func (b *TurnoverBuilder) Build(from, to time.Time) ([]TurnoverEntry, error) {
+	payments := newPaymentReader(b.historyQ)
+	entries := make(map[turnoverKey]*TurnoverEntry)
+
+	// closed_at is stored in UTC without time zone
+	start, end := from.UTC(), to.UTC()
+	closedAt := db2.CloseAtQuery{Start: &start, End: &end}
+	page := db2.PageQuery{Order: db2.OrderAscending, Limit: db2.MaxPageSize}
+	for {
+		var records []history.Operation
+		err := b.historyQ.Operations().OnlyPayments().ForAccount(b.account).ClosedAt(closedAt).Page(page).Select(&records)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range records {
+			// end of the range is exclusive
+			if !records[i].ClosedAt.Before(end) {
+				continue
+			}
+
+			movements, err := payments.read(&records[i])
+			if err != nil {
+				return nil, err
+			}
+
+			periodStart := b.periodStart(records[i].ClosedAt.In(from.Location()))
+			for _, movement := range movements {
+				b.add(entries, periodStart, movement)
+			}
+		}
+
+		if uint64(len(records)) < page.Limit {
+			break
+		}
+		page.Cursor = records[len(records)-1].PagingToken()
+	}
+
+	result := make([]TurnoverEntry, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, *entry)
+	}
+	sort.Sort(byPeriod(result))
+	return result, nil
+}
+
+func (b *TurnoverBuilder) add(entries map[turnoverKey]*TurnoverEntry, periodStart time.Time, movement movement) {
+	if movement.Account != b.account || (b.asset != "" && movement.AssetCode != b.asset) {
+		return
+	}
+
+	key := turnoverKey{periodStart: periodStart, asset: movement.AssetCode, counterpartyType: movement.CounterpartyType}
+	entry, ok := entries[key]
+	if !ok {
+		entry = &TurnoverEntry{
+			PeriodStart:      periodStart,
+			AssetCode:        movement.AssetCode,
+			CounterpartyType: movement.CounterpartyType,
+		}
+		entries[key] = entry
+	}
+
+	if movement.IsIncome {
+		entry.Income += movement.Amount
+	} else {
+		entry.Outcome += movement.Amount
+	}
+}
+
+func (b *TurnoverBuilder) periodStart(t time.Time) time.Time {
+	if b.groupBy == TurnoverByMonth {
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// byPeriod sorts turnover entries by period, asset and counterparty type
+type byPeriod []TurnoverEntry
+
+func (s byPeriod) Len() int      { return len(s) }
+func (s byPeriod) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s byPeriod) Less(i, j int) bool {
+	if !s[i].PeriodStart.Equal(s[j].PeriodStart) {
+		return s[i].PeriodStart.Before(s[j].PeriodStart)
+	}
+
+	if s[i].AssetCode != s[j].AssetCode {
+		return s[i].AssetCode < s[j].AssetCode
+	}
+	return s[i].CounterpartyType < s[j].CounterpartyType
+}
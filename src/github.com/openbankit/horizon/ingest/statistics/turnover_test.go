@@ -0,0 +1,91 @@
+package statistics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openbankit/go-base/xdr"
+	"github.com/openbankit/horizon/db2/history"
+	"github.com/openbankit/horizon/test"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestTurnoverBuilder(t *testing.T) {
+	tt := test.Start(t).Scenario("base")
+	defer tt.Finish()
+
+	historyQ := &history.Q{tt.HorizonRepo()}
+	account := "GAWIB7ETYGSWULO4VB7D6S42YLPGIC7TY7Y2SSJKVOTMQXV5TILYWBUA"
+
+	Convey("Account turnover", t, func() {
+		Convey("Invalid group", func() {
+			_, err := NewTurnoverBuilder(historyQ, account, "", "week")
+			So(err, ShouldEqual, ErrInvalidTurnoverGroup)
+		})
+		Convey("No payments in range", func() {
+			builder, err := NewTurnoverBuilder(historyQ, account, "", TurnoverByDay)
+			So(err, ShouldBeNil)
+			to := time.Now()
+			entries, err := builder.Build(to.AddDate(0, -1, 0), to)
+			So(err, ShouldBeNil)
+			So(entries, ShouldBeEmpty)
+		})
+		Convey("Payments of scenario", func() {
+			test.LoadScenario("base")
+			other := "GCO5BZT5V3N3SK2CD5UKDSEQJBYFSIMYDV2B75SLKWEXLRYF5GNORYCG"
+			counterpartyType := xdr.AccountType(6)
+			// ledgers 2, 3 and 4 are closed on 2016-07-11 at 13:58:28, 13:58:33 and 13:58:38
+			tt.InsertPayment(2, 1, account, other, "100.0000000", "USD")
+			tt.InsertPayment(3, 1, other, account, "30.0000000", "USD")
+			tt.InsertPayment(4, 1, account, other, "5.0000000", "EUR")
+			day := time.Date(2016, time.July, 11, 0, 0, 0, 0, time.UTC)
+
+			Convey("are aggregated by asset and counterparty type", func() {
+				builder, err := NewTurnoverBuilder(historyQ, account, "", TurnoverByDay)
+				So(err, ShouldBeNil)
+				entries, err := builder.Build(day, day.AddDate(0, 0, 1))
+				So(err, ShouldBeNil)
+				So(entries, ShouldHaveLength, 2)
+				So(entries[0].PeriodStart.Equal(day), ShouldBeTrue)
+				So(entries[0].AssetCode, ShouldEqual, "EUR")
+				So(entries[0].CounterpartyType, ShouldEqual, counterpartyType)
+				So(entries[0].Income, ShouldEqual, 0)
+				So(entries[0].Outcome, ShouldEqual, 50000000)
+				So(entries[1].AssetCode, ShouldEqual, "USD")
+				So(entries[1].Income, ShouldEqual, 300000000)
+				So(entries[1].Outcome, ShouldEqual, 1000000000)
+			})
+			Convey("are filtered by asset and range end is exclusive", func() {
+				builder, err := NewTurnoverBuilder(historyQ, other, "USD", TurnoverByMonth)
+				So(err, ShouldBeNil)
+				entries, err := builder.Build(day, time.Date(2016, time.July, 11, 13, 58, 33, 0, time.UTC))
+				So(err, ShouldBeNil)
+				So(entries, ShouldHaveLength, 1)
+				So(entries[0].PeriodStart.Equal(time.Date(2016, time.July, 1, 0, 0, 0, 0, time.UTC)), ShouldBeTrue)
+				So(entries[0].Income, ShouldEqual, 1000000000)
+				So(entries[0].Outcome, ShouldEqual, 0)
+			})
+		})
+		Convey("Movements are grouped by period", func() {
+			builder, err := NewTurnoverBuilder(historyQ, account, "USD", TurnoverByMonth)
+			So(err, ShouldBeNil)
+			entries := make(map[turnoverKey]*TurnoverEntry)
+			first := builder.periodStart(time.Date(2016, time.July, 11, 13, 0, 0, 0, time.UTC))
+			second := builder.periodStart(time.Date(2016, time.July, 31, 23, 59, 0, 0, time.UTC))
+			So(first, ShouldResemble, time.Date(2016, time.July, 1, 0, 0, 0, 0, time.UTC))
+			So(second, ShouldResemble, first)
+
+			builder.add(entries, first, movement{Account: account, AssetCode: "USD", CounterpartyType: xdr.AccountTypeAccountBank, Amount: 100, IsIncome: true})
+			builder.add(entries, second, movement{Account: account, AssetCode: "USD", CounterpartyType: xdr.AccountTypeAccountBank, Amount: -30, IsIncome: true})
+			builder.add(entries, second, movement{Account: account, AssetCode: "USD", CounterpartyType: xdr.AccountTypeAccountBank, Amount: 50})
+			// filtered out by asset and account
+			builder.add(entries, second, movement{Account: account, AssetCode: "EUR", CounterpartyType: xdr.AccountTypeAccountBank, Amount: 50})
+			builder.add(entries, second, movement{Account: "other", AssetCode: "USD", CounterpartyType: xdr.AccountTypeAccountBank, Amount: 50})
+			So(len(entries), ShouldEqual, 1)
+			for _, entry := range entries {
+				So(entry.Income, ShouldEqual, 70)
+				So(entry.Outcome, ShouldEqual, 50)
+			}
+		})
+	})
+}
@@ -13,6 +13,7 @@ func initCacheFromApp(app *App) {
 func initCache(history *history.Q) *cache.SharedCache {
 	return &cache.SharedCache{
 		AccountHistoryCache: cache.NewHistoryAccountWithExp(history, time.Duration(2)*time.Minute, time.Duration(10)*time.Second),
+		StatisticsSummary:   cache.NewStatisticsSummary(history, time.Duration(5)*time.Minute, time.Duration(1)*time.Minute),
 	}
 }
 
@@ -15,6 +15,7 @@ func initIngester(app *App) {
 	}
 
 	app.ingester = ingest.New(app.networkPassphrase, app.CoreRepo(nil), app.HorizonRepo(nil), app.SharedCache().AccountHistoryCache)
+	app.ingester.CommissionAccount = app.config.BankCommissionKey
 	app.ingester.Start()
 }
 
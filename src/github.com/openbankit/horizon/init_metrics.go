@@ -46,10 +46,31 @@ func initLogMetrics(app *App) {
 func initTxSubMetrics(app *App) {
 	app.submitter.Init()
 	app.metrics.Register("txsub.buffered", app.submitter.Metrics.BufferedSubmissionsGauge)
+	app.metrics.Register("txsub.buffered.priority", app.submitter.Metrics.PriorityBufferedSubmissionsGauge)
+	app.metrics.Register("txsub.rejected.queue_full", app.submitter.Metrics.QueueFullMeter)
+	app.metrics.Register("txsub.rejected.account_queue_full", app.submitter.Metrics.AccountQueueFullMeter)
 	app.metrics.Register("txsub.open", app.submitter.Metrics.OpenSubmissionsGauge)
 	app.metrics.Register("txsub.succeeded", app.submitter.Metrics.SuccessfulSubmissionsMeter)
 	app.metrics.Register("txsub.failed", app.submitter.Metrics.FailedSubmissionsMeter)
 	app.metrics.Register("txsub.total", app.submitter.Metrics.SubmissionTimer)
+	app.metrics.Register("txsub.statistics_reservations.committed", app.statsReservations.Metrics.CommittedMeter)
+	app.metrics.Register("txsub.statistics_reservations.released", app.statsReservations.Metrics.ReleasedMeter)
+}
+
+func initStatisticsReconcilerMetrics(app *App) {
+	reconciler := app.statsReconciler
+	app.metrics.Register("statistics.reconcile", reconciler.Metrics.ReconcileTimer)
+	app.metrics.Register("statistics.reconcile.checked", reconciler.Metrics.CheckedGauge)
+	app.metrics.Register("statistics.reconcile.mismatches", reconciler.Metrics.MismatchesGauge)
+	app.metrics.Register("statistics.reconcile.mismatches_total", reconciler.Metrics.MismatchesMeter)
+	app.metrics.Register("statistics.reconcile.healed", reconciler.Metrics.HealedMeter)
+	app.metrics.Register("statistics.reconcile.failed", reconciler.Metrics.FailedMeter)
+}
+
+func initWebhookMetrics(app *App) {
+	app.metrics.Register("webhooks.delivered", app.webhooks.Metrics.DeliveredMeter)
+	app.metrics.Register("webhooks.failed", app.webhooks.Metrics.FailedMeter)
+	app.metrics.Register("webhooks.dead_letters", app.webhooks.Metrics.DeadLetterMeter)
 }
 
 // initWebMetrics registers the metrics for the web server into the provided
@@ -67,4 +88,6 @@ func init() {
 	appInit.Add("web.metrics", initWebMetrics, "web.init", "metrics")
 	appInit.Add("txsub.metrics", initTxSubMetrics, "txsub", "metrics")
 	appInit.Add("ingester.metrics", initIngesterMetrics, "ingester", "metrics")
+	appInit.Add("stats-reconciler.metrics", initStatisticsReconcilerMetrics, "stats-reconciler", "metrics")
+	appInit.Add("webhooks.metrics", initWebhookMetrics, "webhooks", "metrics")
 }
@@ -6,13 +6,21 @@ import (
 )
 
 func initRedis(app *App) {
-	err := redis.Init(app.config.RedisURL)
+	// redis is optional, if statistics are stored elsewhere
+	if app.config.RedisURL != "" || app.config.StatisticsStorage == "" || app.config.StatisticsStorage == redis.StorageRedis {
+		err := redis.Init(app.config.RedisURL)
+		if err != nil {
+			log.WithField("service", "redis").WithError(err).Panic("Failed to initialize")
+		}
+		app.redis = redis.GetPool()
+	}
+
+	err := redis.InitStorage(app.config.StatisticsStorage, app.HorizonRepo(nil))
 	if err != nil {
-		log.WithField("service", "redis").WithError(err).Panic("Failed to initialize")
+		log.WithField("service", "statistics_storage").WithError(err).Panic("Failed to initialize")
 	}
-	app.redis = redis.GetPool()
 }
 
 func init() {
-	appInit.Add("redis", initRedis, "app-context", "log")
+	appInit.Add("redis", initRedis, "app-context", "log", "horizon-db")
 }
@@ -0,0 +1,21 @@
+package horizon
+
+import (
+	"github.com/openbankit/horizon/accounttypes"
+	"github.com/openbankit/horizon/db2/history"
+	"github.com/openbankit/horizon/txsub/transactions/statistics"
+)
+
+func initStatisticsReconciler(app *App) {
+	hq := &history.Q{Repo: app.HorizonRepo(nil)}
+	config := app.Config()
+	app.statsReconciler = statistics.NewReconciler(hq, accounttype.GetAll(), &config)
+
+	if config.StatisticsReconcileInterval > 0 {
+		app.statsReconciler.Start(config.StatisticsReconcileInterval, config.StatisticsReconcileHeal)
+	}
+}
+
+func init() {
+	appInit.Add("stats-reconciler", initStatisticsReconciler, "app-context", "log", "horizon-db", "redis")
+}
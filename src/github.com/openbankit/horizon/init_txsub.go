@@ -1,28 +1,56 @@
 package horizon
 
 import (
+	"github.com/openbankit/horizon/accounttypes"
 	"github.com/openbankit/horizon/db2/core"
 	"github.com/openbankit/horizon/db2/history"
+	"github.com/openbankit/horizon/log"
 	"github.com/openbankit/horizon/txsub"
 	"github.com/openbankit/horizon/txsub/results/db"
 	"github.com/openbankit/horizon/txsub/sequence"
+	"github.com/openbankit/horizon/txsub/storage"
+	"github.com/openbankit/horizon/txsub/transactions/statistics"
 	"net/http"
 )
 
 func initSubmissionSystem(app *App) {
 	cq := &core.Q{Repo: app.CoreRepo(nil)}
 	hq := &history.Q{Repo: app.HorizonRepo(nil)}
+	config := app.Config()
+	app.statsReservations = statistics.NewReservations(config.ProcessedOpTimeout)
+	statsManager := statistics.NewManager(hq, accounttype.GetAll(), &config).SetReservations(app.statsReservations)
+
+	queue := sequence.NewManager()
+	if config.SubmissionQueueSize > 0 {
+		queue.MaxSize = config.SubmissionQueueSize
+	}
+	queue.MaxAccountSize = config.SubmissionQueueAccountSize
+	queue.MaxPrioritySize = config.SubmissionQueuePrioritySize
 
 	app.submitter = &txsub.System{
 		Pending:         txsub.NewDefaultSubmissionList(),
-		Submitter:       txsub.NewDefaultSubmitter(http.DefaultClient, app.config.StellarCoreURL, cq, hq, &app.config, app.SharedCache()),
-		SubmissionQueue: sequence.NewManager(),
+		Submitter:       txsub.NewDefaultSubmitter(http.DefaultClient, config.StellarCoreURL, cq, hq, app, app.SharedCache(), statsManager),
+		SubmissionQueue: queue,
+		Statistics:      statsManager,
 		Results: &results.DB{
 			Core:    cq,
 			History: hq,
 		},
 		Sequences:         cq.SequenceProvider(),
 		NetworkPassphrase: app.networkPassphrase,
+		Priority: &txsub.AccountTypePriority{
+			Accounts: app.SharedCache().AccountHistoryCache,
+			Types:    txsub.PriorityAccountTypes,
+		},
+	}
+
+	if config.SubmissionStorage == storage.StoragePostgres {
+		shared := &storage.DB{Repo: app.HorizonRepo(nil)}
+		err := shared.CheckVersion()
+		if err != nil {
+			log.WithField("service", "txsub").WithError(err).Panic("Failed to initialize shared storage")
+		}
+		app.submitter.Shared = shared
 	}
 
 	go func() {
@@ -10,6 +10,7 @@ import (
 	"github.com/openbankit/horizon/render/problem"
 	"github.com/openbankit/horizon/txsub/sequence"
 	"github.com/PuerkitoBio/throttled"
+	"github.com/PuerkitoBio/throttled/store/memstore"
 	"github.com/PuerkitoBio/throttled/store/redigostore"
 	"github.com/rs/cors"
 	"github.com/sebest/xff"
@@ -17,13 +18,15 @@ import (
 	"github.com/zenazn/goji/web/middleware"
 	"math/rand"
 	"strconv"
+	"sync"
 )
 
 // Web contains the http server related fields for horizon: the router,
 // rate limiter, etc.
 type Web struct {
-	router      *web.Mux
-	rateLimiter *throttled.HTTPRateLimiter
+	router          *web.Mux
+	rateLimiter     *throttled.HTTPRateLimiter
+	rateLimiterLock sync.RWMutex
 
 	requestTimer metrics.Timer
 	failureMeter metrics.Meter
@@ -42,6 +45,7 @@ func initWeb(app *App) {
 	// register problems
 	problem.RegisterError(sql.ErrNoRows, problem.NotFound)
 	problem.RegisterError(sequence.ErrNoMoreRoom, problem.ServerOverCapacity)
+	problem.RegisterError(sequence.ErrAccountQueueFull, problem.AccountQueueFull)
 }
 
 // initWebMiddleware installs the middleware stack used for horizon onto the
@@ -66,11 +70,8 @@ func initWebMiddleware(app *App) {
 	})
 	r.Use(c.Handler)
 
-	if app.web.rateLimiter != nil {
-		r.Use(app.web.RateLimitMiddleware)
-	} else {
-		log.Warn("No rate limit")
-	}
+	// rate limiter is always installed, as it can be enabled by config reload
+	r.Use(app.web.RateLimitMiddleware)
 }
 
 // initWebActions installs the routing configuration of horizon onto the
@@ -80,6 +81,19 @@ func initWebActions(app *App) {
 	r.Get("/", &RootAction{})
 	r.Get("/metrics", &MetricsAction{})
 	r.Get("/options", &OptionsAction{})
+	r.Get("/account_type_restrictions", &AccountTypeRestrictionsAction{})
+	r.Get("/limits/defaults", &DefaultLimitsAction{})
+	r.Get("/audit_log", &AuditLogIndexAction{})
+	r.Post("/admin/dry_run", &AdminActionDryRunAction{})
+	r.Post("/admin/statistics/reconcile", &StatisticsReconcileAction{})
+	r.Get("/admin/statistics/reservations", &StatisticsReservationsAction{})
+	r.Get("/admin/config", &ConfigShowAction{})
+	r.Get("/admin/webhooks", &WebhookSubscriptionIndexAction{})
+	r.Post("/admin/webhooks", &WebhookSubscriptionCreateAction{})
+	r.Get("/admin/webhooks/dead_letters", &WebhookDeadLetterIndexAction{})
+	r.Delete("/admin/webhooks/:id", &WebhookSubscriptionDeleteAction{})
+	r.Post("/admin/webhooks/:id/ping", &WebhookPingAction{})
+	r.Get("/admin/statistics/summary", &StatisticsSummaryAction{})
 
 	// ledger actions
 	r.Get("/ledgers", &LedgerIndexAction{})
@@ -93,8 +107,10 @@ func initWebActions(app *App) {
 	r.Get("/accounts", &AccountIndexAction{})
 	r.Get("/accounts/:id", &AccountShowAction{})
 	r.Get("/accounts/:account_id/statistics", &AccountStatisticsAction{})
+	r.Get("/accounts/:account_id/turnover", &AccountTurnoverAction{})
 	r.Get("/accounts/:account_id/traits", &AccountTraitsAction{})
 	r.Get("/accounts/:account_id/limits", &AccountLimitsAction{})
+	r.Get("/accounts/:account_id/limits/usage", &AccountLimitsUsageAction{})
 	r.Get("/accounts/:account_id/transactions", &TransactionIndexAction{})
 	r.Get("/accounts/:account_id/operations", &OperationIndexAction{})
 	r.Get("/accounts/:account_id/payments", &PaymentsIndexAction{})
@@ -113,6 +129,7 @@ func initWebActions(app *App) {
 	r.Get("/transactions/:tx_id/operations", &OperationIndexAction{})
 	r.Get("/transactions/:tx_id/payments", &PaymentsIndexAction{})
 	r.Get("/transactions/:tx_id/effects", &EffectIndexAction{})
+	r.Get("/transactions/:tx_id/status", &TransactionStatusAction{})
 	r.Get("/traits", &AccountTraitsIndexAction{})
 
 	// operation actions
@@ -129,6 +146,7 @@ func initWebActions(app *App) {
 
 	// Transaction submission API
 	r.Post("/transactions", &TransactionCreateAction{})
+	r.Post("/transactions/validate", &TransactionValidateAction{})
 	r.Get("/paths", &PathIndexAction{})
 
 	// Commission API
@@ -145,25 +163,36 @@ func initWebActions(app *App) {
 }
 
 func initWebRateLimiter(app *App) {
-	if app.config.RateLimit == nil {
-		app.web.rateLimiter = nil
-		return
-	}
-	if app.redis == nil {
-		log.Panic("Rate limiter requires redis")
-	}
+	app.web.setRateLimiter(newWebRateLimiter(app, app.config.RateLimit))
+}
 
-	rateLimitStore, err := redigostore.New(app.redis, "throttle:", 0)
-	if err != nil {
-		log.WithField("error", err).Panic("Failed to create redis rate limiter store")
+// newWebRateLimiter creates rate limiter for the quota. Returns nil, if quota is nil.
+func newWebRateLimiter(app *App, quota *throttled.RateQuota) *throttled.HTTPRateLimiter {
+	if quota == nil {
+		log.Warn("No rate limit")
+		return nil
+	}
+	var rateLimitStore throttled.GCRAStore
+	var err error
+	if app.redis != nil {
+		rateLimitStore, err = redigostore.New(app.redis, "throttle:", 0)
+		if err != nil {
+			log.WithField("error", err).Panic("Failed to create redis rate limiter store")
+		}
+	} else {
+		// without redis limits are not shared between horizon instances
+		rateLimitStore, err = memstore.New(65536)
+		if err != nil {
+			log.WithField("error", err).Panic("Failed to create memory rate limiter store")
+		}
 	}
 
-	rateLimiter, err := throttled.NewGCRARateLimiter(rateLimitStore, *app.config.RateLimit)
+	rateLimiter, err := throttled.NewGCRARateLimiter(rateLimitStore, *quota)
 	if err != nil {
 		log.WithField("error", err).Panic("Failed to create rate limiter")
 	}
 
-	app.web.rateLimiter = &throttled.HTTPRateLimiter{
+	return &throttled.HTTPRateLimiter{
 		DeniedHandler: &RateLimitExceededAction{App: app, Action: Action{}},
 		VaryBy:        &throttled.VaryBy{Custom: remoteAddrIP},
 		RateLimiter:   rateLimiter,
@@ -174,6 +203,18 @@ func initWebRateLimiter(app *App) {
 	}
 }
 
+func (web *Web) getRateLimiter() *throttled.HTTPRateLimiter {
+	web.rateLimiterLock.RLock()
+	defer web.rateLimiterLock.RUnlock()
+	return web.rateLimiter
+}
+
+func (web *Web) setRateLimiter(rateLimiter *throttled.HTTPRateLimiter) {
+	web.rateLimiterLock.Lock()
+	defer web.rateLimiterLock.Unlock()
+	web.rateLimiter = rateLimiter
+}
+
 func remoteAddrIP(r *http.Request) string {
 	// TODO change!!!!!!!!!!!!!
 	return strconv.FormatInt(rand.Int63(), 10)
@@ -0,0 +1,19 @@
+package horizon
+
+import (
+	"time"
+
+	"github.com/openbankit/horizon/webhook"
+)
+
+func initWebhooks(app *App) {
+	app.webhooks = webhook.NewDispatcher(app.HorizonRepo(nil))
+	app.webhooks.AllowPrivate = app.Config().WebhookAllowPrivate
+	app.webhooks.Start(time.Second)
+
+	app.submitter.Observer = &webhook.TransactionResults{Q: &webhook.Q{Repo: app.HorizonRepo(nil)}}
+}
+
+func init() {
+	appInit.Add("webhooks", initWebhooks, "app-context", "log", "horizon-db", "txsub")
+}
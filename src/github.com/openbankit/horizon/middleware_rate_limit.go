@@ -6,5 +6,12 @@ import (
 )
 
 func (web *Web) RateLimitMiddleware(c *web.C, next http.Handler) http.Handler {
-	return web.rateLimiter.RateLimit(next)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rateLimiter := web.getRateLimiter()
+		if rateLimiter == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		rateLimiter.RateLimit(next).ServeHTTP(w, r)
+	})
 }
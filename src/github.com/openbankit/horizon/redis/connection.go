@@ -52,6 +52,10 @@ type ConnectionInterface interface {
 	// Removes the specified keys. A key is ignored if it does not exist.
 	Delete(key string) error
 
+	// Returns the remaining time to live of a key that has a timeout. Negative value is returned,
+	// if key does not exist or has no associated expire.
+	TTL(key string) (time.Duration, error)
+
 	Ping() error
 }
 
@@ -142,6 +146,16 @@ func (r *Connection) Delete(key string) error {
 	return err
 }
 
+// Returns the remaining time to live of a key that has a timeout. Negative value is returned,
+// if key does not exist or has no associated expire.
+func (r *Connection) TTL(key string) (time.Duration, error) {
+	ttl, err := redis.Int64(r.Do("TTL", key))
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(ttl) * time.Second, nil
+}
+
 func (r *Connection) Ping() error {
 	_, err := r.Do("PING")
 	return err
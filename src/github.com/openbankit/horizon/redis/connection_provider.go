@@ -1,9 +1,24 @@
 package redis
 
-import "github.com/openbankit/horizon/log"
+import (
+	"errors"
+
+	"github.com/openbankit/horizon/db2"
+	"github.com/openbankit/horizon/log"
+)
 
 const max_connection_reties = 10
 
+// Storages of account statistics and processed ops
+const (
+	StorageRedis    = "redis"
+	StorageMemory   = "memory"
+	StoragePostgres = "postgres"
+)
+
+// storageProvider is set, if statistics are not stored in redis
+var storageProvider ConnectionProviderInterface
+
 type ConnectionProviderInterface interface {
 	GetConnection() ConnectionInterface
 }
@@ -11,10 +26,30 @@ type ConnectionProviderInterface interface {
 type ConnectionProvider struct {
 }
 
+// NewConnectionProvider returns provider of connections to configured statistics storage
 func NewConnectionProvider() ConnectionProviderInterface {
+	if storageProvider != nil {
+		return storageProvider
+	}
 	return &ConnectionProvider{}
 }
 
+// InitStorage selects storage of account statistics and processed ops. Redis storage requires redis to be
+// initialized with Init, postgres storage uses `statistics_storage` table of horizon db.
+func InitStorage(storage string, repo *db2.Repo) error {
+	switch storage {
+	case "", StorageRedis:
+		storageProvider = nil
+	case StorageMemory:
+		storageProvider = NewMemoryStorage()
+	case StoragePostgres:
+		storageProvider = NewPostgresStorage(repo)
+	default:
+		return errors.New("Unknown statistics storage: " + storage)
+	}
+	return nil
+}
+
 func (c ConnectionProvider) GetConnection() ConnectionInterface {
 	if redisPool == nil {
 		log.Panic("Redis must be initialized")
@@ -7,6 +7,8 @@ type namespace string
 const (
 	namespace_account_stats namespace = "as:"
 	namespace_processed_op namespace = "pop:"
+	namespace_reserved_tx namespace = "rtx:"
+	namespace_account_reservations namespace = "rac:"
 )
 
 func getKey(ns namespace, keyParts... string) string {
@@ -0,0 +1,144 @@
+package redis
+
+import (
+	"sync"
+	"time"
+)
+
+// expired hashes are removed from storage not more often then purgePeriod
+const purgePeriod = time.Minute
+
+type memoryHash struct {
+	fields    map[string]int64
+	version   int64
+	expiresAt time.Time
+}
+
+func (h *memoryHash) isExpired(now time.Time) bool {
+	return !h.expiresAt.IsZero() && !h.expiresAt.After(now)
+}
+
+// MemoryStorage is an in-process statistics storage. Data is lost on restart and is not shared
+// between horizon instances.
+type MemoryStorage struct {
+	lock       sync.Mutex
+	hashes     map[string]*memoryHash
+	lastSeq    int64
+	lastPurged time.Time
+}
+
+func NewMemoryStorage() *MemoryStorage {
+	return &MemoryStorage{
+		hashes: make(map[string]*memoryHash),
+	}
+}
+
+// GetConnection returns new connection to the storage
+func (s *MemoryStorage) GetConnection() ConnectionInterface {
+	return newStorageConnection(s)
+}
+
+func (s *MemoryStorage) get(key string, now time.Time) (map[string]int64, int64, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	hash := s.getHash(key, now)
+	if hash == nil {
+		return nil, 0, nil
+	}
+
+	fields := make(map[string]int64, len(hash.fields))
+	for field, value := range hash.fields {
+		fields[field] = value
+	}
+	return fields, hash.version, nil
+}
+
+func (s *MemoryStorage) ttl(key string, now time.Time) (time.Duration, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	hash := s.getHash(key, now)
+	if hash == nil {
+		return -2 * time.Second, nil
+	}
+
+	if hash.expiresAt.IsZero() {
+		return -1 * time.Second, nil
+	}
+	return hash.expiresAt.Sub(now), nil
+}
+
+func (s *MemoryStorage) exec(watched map[string]int64, commands []storageCommand, now time.Time) (bool, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for key, version := range watched {
+		var current int64
+		if hash := s.getHash(key, now); hash != nil {
+			current = hash.version
+		}
+
+		if current != version {
+			return false, nil
+		}
+	}
+
+	for _, command := range commands {
+		hash := s.getHash(command.Key, now)
+		switch command.Type {
+		case storageCommandHMSet:
+			if hash == nil {
+				hash = &memoryHash{
+					fields: make(map[string]int64, len(command.Fields)),
+				}
+				s.hashes[command.Key] = hash
+			}
+
+			for field, value := range command.Fields {
+				hash.fields[field] = value
+			}
+		case storageCommandExpire:
+			if hash == nil {
+				continue
+			}
+			hash.expiresAt = now.Add(command.Timeout)
+		case storageCommandDelete:
+			delete(s.hashes, command.Key)
+			continue
+		}
+		s.lastSeq++
+		hash.version = s.lastSeq
+	}
+
+	s.purge(now)
+	return true, nil
+}
+
+// getHash returns not expired hash stored at key. Must be called under lock.
+func (s *MemoryStorage) getHash(key string, now time.Time) *memoryHash {
+	hash, ok := s.hashes[key]
+	if !ok {
+		return nil
+	}
+
+	if hash.isExpired(now) {
+		delete(s.hashes, key)
+		return nil
+	}
+	return hash
+}
+
+// purge removes expired hashes. Must be called under lock.
+func (s *MemoryStorage) purge(now time.Time) {
+	if now.Sub(s.lastPurged) < purgePeriod {
+		return
+	}
+
+	for key, hash := range s.hashes {
+		if hash.isExpired(now) {
+			delete(s.hashes, key)
+		}
+	}
+	s.lastPurged = now
+}
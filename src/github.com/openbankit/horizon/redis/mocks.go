@@ -79,6 +79,11 @@ func (m *ConnectionMock) Delete(key string) error {
 	return m.Called(key).Error(0)
 }
 
+func (m *ConnectionMock) TTL(key string) (time.Duration, error) {
+	a := m.Called(key)
+	return a.Get(0).(time.Duration), a.Error(1)
+}
+
 func (m *ConnectionMock) Ping() error {
 	return nil
 }
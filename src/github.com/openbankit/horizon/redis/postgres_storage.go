@@ -0,0 +1,232 @@
+package redis
+
+import (
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/openbankit/horizon/db2"
+	"github.com/openbankit/horizon/log"
+)
+
+type storedHash struct {
+	Fields    string     `db:"fields"`
+	Version   int64      `db:"version"`
+	ExpiresAt *time.Time `db:"expires_at"`
+}
+
+// PostgresStorage is a statistics storage backed by `statistics_storage` table of horizon db.
+// Data is shared between horizon instances using the same db.
+type PostgresStorage struct {
+	repo *db2.Repo
+
+	lock       sync.Mutex
+	lastPurged time.Time
+}
+
+func NewPostgresStorage(repo *db2.Repo) *PostgresStorage {
+	return &PostgresStorage{
+		repo: repo,
+	}
+}
+
+// GetConnection returns new connection to the storage
+func (s *PostgresStorage) GetConnection() ConnectionInterface {
+	return newStorageConnection(s)
+}
+
+func (s *PostgresStorage) get(key string, now time.Time) (map[string]int64, int64, error) {
+	hash, err := s.getHash(s.repo, key, now)
+	if err != nil || hash == nil {
+		return nil, 0, err
+	}
+
+	var fields map[string]int64
+	err = json.Unmarshal([]byte(hash.Fields), &fields)
+	if err != nil {
+		return nil, 0, err
+	}
+	return fields, hash.Version, nil
+}
+
+func (s *PostgresStorage) ttl(key string, now time.Time) (time.Duration, error) {
+	hash, err := s.getHash(s.repo, key, now)
+	if err != nil {
+		return 0, err
+	}
+
+	if hash == nil {
+		return -2 * time.Second, nil
+	}
+
+	if hash.ExpiresAt == nil {
+		return -1 * time.Second, nil
+	}
+	return hash.ExpiresAt.Sub(now), nil
+}
+
+func (s *PostgresStorage) exec(watched map[string]int64, commands []storageCommand, now time.Time) (bool, error) {
+	repo := s.repo.Clone()
+	err := repo.Begin()
+	if err != nil {
+		return false, err
+	}
+	defer repo.Rollback()
+
+	err = s.lockKeys(repo, watched, commands)
+	if err != nil {
+		return false, err
+	}
+
+	for key, version := range watched {
+		hash, err := s.getHash(repo, key, now)
+		if err != nil {
+			return false, err
+		}
+
+		var current int64
+		if hash != nil {
+			current = hash.Version
+		}
+
+		if current != version {
+			return false, nil
+		}
+	}
+
+	for _, command := range commands {
+		err = s.apply(repo, command, now)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	err = repo.Commit()
+	if err != nil {
+		return false, err
+	}
+
+	err = s.purge(now)
+	if err != nil {
+		log.WithField("service", "statistics_storage").WithError(err).Error("Failed to purge expired hashes")
+	}
+	return true, nil
+}
+
+func (s *PostgresStorage) apply(repo *db2.Repo, command storageCommand, now time.Time) error {
+	if command.Type == storageCommandDelete {
+		_, err := repo.ExecRaw("DELETE FROM statistics_storage WHERE name = ?", command.Key)
+		return err
+	}
+
+	hash, err := s.getHash(repo, command.Key, now)
+	if err != nil {
+		return err
+	}
+
+	switch command.Type {
+	case storageCommandHMSet:
+		if hash == nil {
+			rawFields, err := json.Marshal(command.Fields)
+			if err != nil {
+				return err
+			}
+
+			// remove expired hash, if any
+			_, err = repo.ExecRaw("DELETE FROM statistics_storage WHERE name = ?", command.Key)
+			if err != nil {
+				return err
+			}
+
+			_, err = repo.ExecRaw("INSERT INTO statistics_storage (name, fields, version) VALUES (?, ?, nextval('statistics_storage_version_seq'))",
+				command.Key, string(rawFields))
+			return err
+		}
+
+		var fields map[string]int64
+		err = json.Unmarshal([]byte(hash.Fields), &fields)
+		if err != nil {
+			return err
+		}
+
+		for field, value := range command.Fields {
+			fields[field] = value
+		}
+
+		rawFields, err := json.Marshal(fields)
+		if err != nil {
+			return err
+		}
+
+		_, err = repo.ExecRaw("UPDATE statistics_storage SET fields = ?, version = nextval('statistics_storage_version_seq') WHERE name = ?",
+			string(rawFields), command.Key)
+		return err
+	case storageCommandExpire:
+		if hash == nil {
+			return nil
+		}
+
+		_, err = repo.ExecRaw("UPDATE statistics_storage SET expires_at = ?, version = nextval('statistics_storage_version_seq') WHERE name = ?",
+			now.Add(command.Timeout), command.Key)
+		return err
+	}
+	return nil
+}
+
+// lockKeys acquires transaction level locks on all keys used in transaction. Keys are locked in the same order
+// to avoid deadlocks.
+func (s *PostgresStorage) lockKeys(repo *db2.Repo, watched map[string]int64, commands []storageCommand) error {
+	uniqueKeys := make(map[string]bool, len(watched)+len(commands))
+	for key := range watched {
+		uniqueKeys[key] = true
+	}
+	for _, command := range commands {
+		uniqueKeys[command.Key] = true
+	}
+
+	keys := make([]string, 0, len(uniqueKeys))
+	for key := range uniqueKeys {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		_, err := repo.ExecRaw("SELECT pg_advisory_xact_lock(hashtext(?))", key)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getHash returns not expired hash stored at key or nil
+func (s *PostgresStorage) getHash(repo *db2.Repo, key string, now time.Time) (*storedHash, error) {
+	var hash storedHash
+	err := repo.GetRaw(&hash, "SELECT fields, version, expires_at FROM statistics_storage WHERE name = ? AND (expires_at IS NULL OR expires_at > ?)",
+		key, now)
+	if err != nil {
+		if repo.NoRows(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &hash, nil
+}
+
+// purge removes expired hashes
+func (s *PostgresStorage) purge(now time.Time) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if now.Sub(s.lastPurged) < purgePeriod {
+		return nil
+	}
+
+	_, err := s.repo.ExecRaw("DELETE FROM statistics_storage WHERE expires_at <= ?", now)
+	if err != nil {
+		return err
+	}
+
+	s.lastPurged = now
+	return nil
+}
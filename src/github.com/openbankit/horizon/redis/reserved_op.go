@@ -0,0 +1,87 @@
+package redis
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openbankit/go-base/xdr"
+)
+
+// ReservedOp is an amount added to account statistics by operation of transaction, which is not applied
+// by stellar-core yet. Reserved ops of transaction are stored in a hash, where field name describes
+// the operation and value is its amount.
+type ReservedOp struct {
+	TxHash           string
+	Index            int
+	Account          string
+	AssetCode        string
+	CounterpartyType xdr.AccountType
+	Amount           int64
+	IsIncoming       bool
+	ReservedAt       time.Time
+}
+
+// IsSameOp returns true, if both reservations are made by the same operation in the same direction
+func (op *ReservedOp) IsSameOp(other *ReservedOp) bool {
+	return op.TxHash == other.TxHash && op.Index == other.Index && op.IsIncoming == other.IsIncoming
+}
+
+// field returns name of the field of reserved tx hash storing the op
+func (op *ReservedOp) field() string {
+	direction := "o"
+	if op.IsIncoming {
+		direction = "i"
+	}
+	return strings.Join([]string{
+		strconv.Itoa(op.Index),
+		direction,
+		op.Account,
+		op.AssetCode,
+		strconv.Itoa(int(op.CounterpartyType)),
+		strconv.FormatInt(op.ReservedAt.Unix(), 10),
+	}, ":")
+}
+
+func readReservedOp(txHash, field string, amount int64) (*ReservedOp, error) {
+	parts := strings.Split(field, ":")
+	if len(parts) != 6 {
+		return nil, errors.New("Invalid reserved op field: " + field)
+	}
+
+	index, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	counterpartyType, err := strconv.ParseInt(parts[4], 10, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	reservedAt, err := strconv.ParseInt(parts[5], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReservedOp{
+		TxHash:           txHash,
+		Index:            index,
+		Account:          parts[2],
+		AssetCode:        parts[3],
+		CounterpartyType: xdr.AccountType(counterpartyType),
+		Amount:           amount,
+		IsIncoming:       parts[1] == "i",
+		ReservedAt:       time.Unix(reservedAt, 0),
+	}, nil
+}
+
+func GetReservedTxKey(txHash string) string {
+	return getKey(namespace_reserved_tx, txHash)
+}
+
+// GetAccountReservationsKey returns key of hash, which lists transactions reserved statistics of account
+func GetAccountReservationsKey(account string) string {
+	return getKey(namespace_account_reservations, account)
+}
@@ -0,0 +1,110 @@
+package redis
+
+import (
+	"sort"
+	"time"
+
+	"github.com/garyburd/redigo/redis"
+)
+
+type ReservedOpProviderInterface interface {
+	Get(txHash string) ([]ReservedOp, error)
+	GetTxHashes(account string) ([]string, error)
+	Replace(txHash string, ops []ReservedOp, timeout time.Duration) error
+}
+
+type ReservedOpProvider struct {
+	conn ConnectionInterface
+}
+
+func NewReservedOpProvider(conn ConnectionInterface) *ReservedOpProvider {
+	return &ReservedOpProvider{
+		conn: conn,
+	}
+}
+
+// Get returns ops of transaction, which reserved statistics, ordered by index
+func (c *ReservedOpProvider) Get(txHash string) ([]ReservedOp, error) {
+	data, err := redis.Int64Map(c.conn.HGetAll(GetReservedTxKey(txHash)))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]ReservedOp, 0, len(data))
+	for field, amount := range data {
+		op, err := readReservedOp(txHash, field, amount)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, *op)
+	}
+
+	sort.Sort(reservedOpsByIndex(result))
+	return result, nil
+}
+
+// GetTxHashes returns hashes of transactions, which reserved statistics of account. Reservations of some
+// of the transactions may be already committed or released.
+func (c *ReservedOpProvider) GetTxHashes(account string) ([]string, error) {
+	data, err := redis.Int64Map(c.conn.HGetAll(GetAccountReservationsKey(account)))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]string, 0, len(data))
+	for txHash := range data {
+		result = append(result, txHash)
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+// Replace stores ops as reservations of transaction and sets expiration time. Reservations of transaction
+// are removed, if ops are empty.
+func (c *ReservedOpProvider) Replace(txHash string, ops []ReservedOp, timeout time.Duration) error {
+	key := GetReservedTxKey(txHash)
+	err := c.conn.Delete(key)
+	if err != nil || len(ops) == 0 {
+		return err
+	}
+
+	args := []interface{}{key}
+	for i := range ops {
+		args = append(args, ops[i].field(), ops[i].Amount)
+	}
+
+	err = c.conn.HMSet(args...)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.conn.Expire(key, timeout)
+	if err != nil {
+		return err
+	}
+
+	for i := range ops {
+		accountKey := GetAccountReservationsKey(ops[i].Account)
+		err = c.conn.HMSet(accountKey, txHash, ops[i].ReservedAt.Unix())
+		if err != nil {
+			return err
+		}
+
+		_, err = c.conn.Expire(accountKey, timeout)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type reservedOpsByIndex []ReservedOp
+
+func (s reservedOpsByIndex) Len() int      { return len(s) }
+func (s reservedOpsByIndex) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s reservedOpsByIndex) Less(i, j int) bool {
+	if s[i].Index != s[j].Index {
+		return s[i].Index < s[j].Index
+	}
+	return !s[i].IsIncoming && s[j].IsIncoming
+}
@@ -0,0 +1,205 @@
+package redis
+
+import (
+	"errors"
+	"time"
+
+	"github.com/spf13/cast"
+)
+
+var (
+	errNotSupported  = errors.New("Command is not supported by statistics storage")
+	errInTransaction = errors.New("Command can not be used in transaction")
+)
+
+type storageCommandType int
+
+const (
+	storageCommandHMSet storageCommandType = iota
+	storageCommandExpire
+	storageCommandDelete
+)
+
+// storageCommand is a write command queued in transaction
+type storageCommand struct {
+	Type    storageCommandType
+	Key     string
+	Fields  map[string]int64
+	Timeout time.Duration
+}
+
+// hashStorage stores hashes of int64 values with versions. Each write to a hash changes its version,
+// absent or expired hashes have version 0.
+type hashStorage interface {
+	// Returns fields and version of the hash stored at key
+	get(key string, now time.Time) (fields map[string]int64, version int64, err error)
+	// Returns time to live of hash stored at key. Returns -2s, if key does not exist, -1s, if it has no expire
+	ttl(key string, now time.Time) (time.Duration, error)
+	// Atomically checks that versions of watched keys are not changed and applies commands.
+	// Returns false, if any of watched keys were changed.
+	exec(watched map[string]int64, commands []storageCommand, now time.Time) (bool, error)
+}
+
+// storageConnection implements ConnectionInterface on top of hashStorage, emulating
+// redis optimistic transactions (WATCH/MULTI/EXEC) with versions of hashes.
+type storageConnection struct {
+	storage  hashStorage
+	watched  map[string]int64
+	commands []storageCommand
+	isMulti  bool
+}
+
+func newStorageConnection(storage hashStorage) *storageConnection {
+	return &storageConnection{
+		storage: storage,
+		watched: make(map[string]int64),
+	}
+}
+
+// Sets the specified fields to their respective values in the hash stored at key.
+func (c *storageConnection) HMSet(args ...interface{}) error {
+	if len(args) < 3 || len(args)%2 != 1 {
+		return errors.New("wrong number of arguments for HMSET")
+	}
+
+	fields := make(map[string]int64, len(args)/2)
+	for i := 1; i < len(args); i += 2 {
+		value, err := cast.ToInt64E(args[i+1])
+		if err != nil {
+			return err
+		}
+		fields[cast.ToString(args[i])] = value
+	}
+
+	return c.do(storageCommand{
+		Type:   storageCommandHMSet,
+		Key:    cast.ToString(args[0]),
+		Fields: fields,
+	})
+}
+
+// Returns all fields and values of the hash stored at key in the same format as redis does.
+func (c *storageConnection) HGetAll(key string) (interface{}, error) {
+	if c.isMulti {
+		return nil, errInTransaction
+	}
+
+	fields, _, err := c.storage.get(key, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]interface{}, 0, len(fields)*2)
+	for field, value := range fields {
+		result = append(result, []byte(field), value)
+	}
+	return result, nil
+}
+
+// Set a timeout on key.
+func (c *storageConnection) Expire(key string, timeout time.Duration) (bool, error) {
+	err := c.do(storageCommand{
+		Type:    storageCommandExpire,
+		Key:     key,
+		Timeout: timeout,
+	})
+	return err == nil, err
+}
+
+func (c *storageConnection) GetSet(key string, data interface{}) (interface{}, error) {
+	return nil, errNotSupported
+}
+
+func (c *storageConnection) Get(key string) (interface{}, error) {
+	return nil, errNotSupported
+}
+
+func (c *storageConnection) Set(key string, data interface{}) error {
+	return errNotSupported
+}
+
+// Marks the given keys to be watched for conditional execution of a transaction.
+func (c *storageConnection) Watch(key string) error {
+	if c.isMulti {
+		return errInTransaction
+	}
+
+	_, version, err := c.storage.get(key, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if _, ok := c.watched[key]; !ok {
+		c.watched[key] = version
+	}
+	return nil
+}
+
+// Flushes all the previously watched keys for a transaction.
+func (c *storageConnection) UnWatch() error {
+	c.watched = make(map[string]int64)
+	return nil
+}
+
+// Marks the start of a transaction block.
+func (c *storageConnection) Multi() error {
+	if c.isMulti {
+		return errors.New("MULTI calls can not be nested")
+	}
+	c.isMulti = true
+	return nil
+}
+
+// Executes all previously queued commands in a transaction. Returns false, if any of watched keys were changed.
+func (c *storageConnection) Exec() (bool, error) {
+	if !c.isMulti {
+		return false, errors.New("EXEC without MULTI")
+	}
+
+	isOk, err := c.storage.exec(c.watched, c.commands, time.Now())
+	c.reset()
+	return isOk, err
+}
+
+// Close discards not executed transaction
+func (c *storageConnection) Close() error {
+	c.reset()
+	return nil
+}
+
+// Removes the specified keys. A key is ignored if it does not exist.
+func (c *storageConnection) Delete(key string) error {
+	return c.do(storageCommand{
+		Type: storageCommandDelete,
+		Key:  key,
+	})
+}
+
+// Returns the remaining time to live of a key that has a timeout.
+func (c *storageConnection) TTL(key string) (time.Duration, error) {
+	if c.isMulti {
+		return 0, errInTransaction
+	}
+	return c.storage.ttl(key, time.Now())
+}
+
+func (c *storageConnection) Ping() error {
+	return nil
+}
+
+// do queues command, if transaction is started, or applies it immediately
+func (c *storageConnection) do(command storageCommand) error {
+	if c.isMulti {
+		c.commands = append(c.commands, command)
+		return nil
+	}
+
+	_, err := c.storage.exec(nil, []storageCommand{command}, time.Now())
+	return err
+}
+
+func (c *storageConnection) reset() {
+	c.isMulti = false
+	c.commands = nil
+	c.watched = make(map[string]int64)
+}
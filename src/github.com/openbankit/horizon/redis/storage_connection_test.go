@@ -0,0 +1,107 @@
+package redis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openbankit/go-base/keypair"
+	"github.com/openbankit/go-base/xdr"
+	"github.com/openbankit/horizon/db2/history"
+	"github.com/openbankit/horizon/test"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStorageConnection(t *testing.T) {
+	tt := test.Start(t).Scenario("base")
+	defer tt.Finish()
+
+	storages := map[string]ConnectionProviderInterface{
+		StorageMemory:   NewMemoryStorage(),
+		StoragePostgres: NewPostgresStorage(tt.HorizonRepo()),
+	}
+
+	for name, storage := range storages {
+		Convey(name+" storage", t, func() {
+			account, err := keypair.Random()
+			So(err, ShouldBeNil)
+			bankStats := history.NewAccountStatistics(account.Address(), "UAH", xdr.AccountTypeAccountBank)
+			bankStats.DailyIncome = 100
+			bankStats.AnnualOutcome = 200
+			bankStats.UpdatedAt = time.Unix(time.Now().Unix(), 0)
+			stats := NewAccountStatistics(account.Address(), "UAH", 1000, map[xdr.AccountType]history.AccountStatistics{
+				xdr.AccountTypeAccountBank: bankStats,
+			})
+			counterparties := []xdr.AccountType{xdr.AccountTypeAccountBank}
+
+			conn := storage.GetConnection()
+			defer conn.Close()
+			provider := NewAccountStatisticsProvider(conn)
+
+			Convey("Does not exist", func() {
+				stored, err := provider.Get(stats.Account, stats.AssetCode, counterparties)
+				So(err, ShouldBeNil)
+				So(stored, ShouldBeNil)
+				ttl, err := conn.TTL(stats.GetKey())
+				So(err, ShouldBeNil)
+				So(ttl, ShouldEqual, -2*time.Second)
+			})
+			Convey("Insert and delete", func() {
+				err := provider.Insert(stats, time.Minute)
+				So(err, ShouldBeNil)
+				stored, err := provider.Get(stats.Account, stats.AssetCode, counterparties)
+				So(err, ShouldBeNil)
+				assert.Equal(t, stats, stored)
+				ttl, err := conn.TTL(stats.GetKey())
+				So(err, ShouldBeNil)
+				So(ttl, ShouldBeGreaterThan, 50*time.Second)
+				err = conn.Delete(stats.GetKey())
+				So(err, ShouldBeNil)
+				stored, err = provider.Get(stats.Account, stats.AssetCode, counterparties)
+				So(err, ShouldBeNil)
+				So(stored, ShouldBeNil)
+			})
+			Convey("Expired", func() {
+				err := provider.Insert(stats, -time.Second)
+				So(err, ShouldBeNil)
+				stored, err := provider.Get(stats.Account, stats.AssetCode, counterparties)
+				So(err, ShouldBeNil)
+				So(stored, ShouldBeNil)
+			})
+			Convey("Transaction", func() {
+				other := storage.GetConnection()
+				defer other.Close()
+
+				err := conn.Watch(stats.GetKey())
+				So(err, ShouldBeNil)
+				err = conn.Multi()
+				So(err, ShouldBeNil)
+				err = provider.Insert(stats, time.Minute)
+				So(err, ShouldBeNil)
+				Convey("Commands are queued until exec", func() {
+					stored, err := NewAccountStatisticsProvider(other).Get(stats.Account, stats.AssetCode, counterparties)
+					So(err, ShouldBeNil)
+					So(stored, ShouldBeNil)
+					isOk, err := conn.Exec()
+					So(err, ShouldBeNil)
+					So(isOk, ShouldBeTrue)
+					stored, err = NewAccountStatisticsProvider(other).Get(stats.Account, stats.AssetCode, counterparties)
+					So(err, ShouldBeNil)
+					assert.Equal(t, stats, stored)
+				})
+				Convey("Watched key changed", func() {
+					changed := *stats
+					changed.Balance = 10
+					err := NewAccountStatisticsProvider(other).Insert(&changed, time.Minute)
+					So(err, ShouldBeNil)
+					isOk, err := conn.Exec()
+					So(err, ShouldBeNil)
+					So(isOk, ShouldBeFalse)
+					stored, err := provider.Get(stats.Account, stats.AssetCode, counterparties)
+					So(err, ShouldBeNil)
+					So(stored.Balance, ShouldEqual, 10)
+				})
+			})
+		})
+	}
+}
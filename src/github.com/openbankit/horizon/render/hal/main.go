@@ -16,6 +16,11 @@ func RenderToString(data interface{}, pretty bool) ([]byte, error) {
 
 // Render write data to w, after marshalling to json
 func Render(w http.ResponseWriter, data interface{}) {
+	RenderWithStatus(w, data, http.StatusOK)
+}
+
+// RenderWithStatus write data to w with the provided http status, after marshalling to json
+func RenderWithStatus(w http.ResponseWriter, data interface{}, status int) {
 	js, err := RenderToString(data, true)
 
 	if err != nil {
@@ -24,5 +29,6 @@ func Render(w http.ResponseWriter, data interface{}) {
 	}
 
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
 	w.Write(js)
 }
@@ -11,7 +11,7 @@ import (
 // Negotiate inspects the Accept header of the provided request and determines
 // what the most appropriate response type should be.  Defaults to HAL.
 func Negotiate(ctx context.Context, r *http.Request) string {
-	alternatives := []string{MimeHal, MimeJSON, MimeEventStream, MimeRaw}
+	alternatives := []string{MimeHal, MimeJSON, MimeEventStream, MimeRaw, MimeCSV}
 	accept := r.Header.Get("Accept")
 
 	if accept == "" {
@@ -28,6 +28,11 @@ func TestRenderPackage(t *testing.T) {
 			So(Negotiate(ctx, r), ShouldEqual, MimeHal)
 		})
 
+		Convey("Negotiates CSV", func() {
+			r.Header.Set("Accept", "text/csv")
+			So(Negotiate(ctx, r), ShouldEqual, MimeCSV)
+		})
+
 		Convey("Defaults to HAL", func() {
 			r.Header.Set("Accept", "")
 			So(Negotiate(ctx, r), ShouldEqual, MimeHal)
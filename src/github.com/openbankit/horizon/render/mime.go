@@ -12,4 +12,6 @@ const (
 	MimeProblem = "application/problem+json"
 	//MimeRaw is the mime type for "application/octet-stream"
 	MimeRaw = "application/octet-stream"
+	//MimeCSV is the mime type for "text/csv"
+	MimeCSV = "text/csv"
 )
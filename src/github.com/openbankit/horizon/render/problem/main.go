@@ -154,6 +154,26 @@ var (
 			"headers.",
 	}
 
+	// NotAuthorized is a well-known problem type.  Use it as a shortcut
+	// in your actions.
+	NotAuthorized = P{
+		Type:   "not_authorized",
+		Title:  "Not Authorized",
+		Status: http.StatusUnauthorized,
+		Detail: "The request must be signed by an admin of the bank.  Signature, " +
+			"signer's public key and signing time are expected in 'X-AuthSignature', " +
+			"'X-AuthPublicKey' and 'X-AuthTimestamp' headers.",
+	}
+
+	// Forbidden is a well-known problem type.  Use it as a shortcut
+	// in your actions.
+	Forbidden = P{
+		Type:   "forbidden",
+		Title:  "Forbidden",
+		Status: http.StatusForbidden,
+		Detail: "The request is signed by an account, which is not an admin of the bank.",
+	}
+
 	// NotImplemented is a well-known problem type.  Use it as a shortcut
 	// in your actions.
 	NotImplemented = P{
@@ -193,6 +213,17 @@ var (
 			"several minutes before trying your request again.",
 	}
 
+	// AccountQueueFull is a well-known problem type.  Use it as a shortcut
+	// in your actions.
+	AccountQueueFull = P{
+		Type:   "account_queue_full",
+		Title:  "Account Queue Full",
+		Status: 429,
+		Detail: "Too many transactions of the source account are waiting to be " +
+			"submitted.  Please wait for the pending transactions to be applied " +
+			"before submitting new ones.",
+	}
+
 	// Timeout is a well-known problem type.  Use it as a shortcut
 	// in your actions.
 	Timeout = P{
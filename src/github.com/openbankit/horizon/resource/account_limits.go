@@ -38,15 +38,27 @@ func (al *AccountLimits) Populate(
 // Populate fills out the resource's fields
 func (ale *AccountLimitsEntry) Populate(entry history.AccountLimits) {
 	ale.AssetCode = entry.AssetCode
+	if entry.CounterpartyType != history.AnyCounterpartyType {
+		ale.CounterpartyTypeI, ale.CounterpartyType = PopulateAccountTypeP(xdr.AccountType(entry.CounterpartyType))
+	}
 	ale.MaxOperationOut = ale.formatLimit(entry.MaxOperationOut)
 	ale.DailyMaxOut = ale.formatLimit(entry.DailyMaxOut)
+	ale.WeeklyMaxOut = ale.formatLimit(entry.WeeklyMaxOut)
 	ale.MonthlyMaxOut = ale.formatLimit(entry.MonthlyMaxOut)
+	ale.AnnualMaxOut = ale.formatLimit(entry.AnnualMaxOut)
 	ale.MaxOperationIn = ale.formatLimit(entry.MaxOperationIn)
 	ale.DailyMaxIn = ale.formatLimit(entry.DailyMaxIn)
+	ale.WeeklyMaxIn = ale.formatLimit(entry.WeeklyMaxIn)
 	ale.MonthlyMaxIn = ale.formatLimit(entry.MonthlyMaxIn)
+	ale.AnnualMaxIn = ale.formatLimit(entry.AnnualMaxIn)
 }
 
 func (ale *AccountLimitsEntry) formatLimit(limit int64) string {
+	return formatLimit(limit)
+}
+
+// formatLimit formats limit amount. Absence of limit (-1) is formatted as -1.0000000
+func formatLimit(limit int64) string {
 	if limit == -1 {
 		return amount.String(xdr.Int64(limit) * amount.One)
 	}
@@ -0,0 +1,69 @@
+package resource
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/openbankit/go-base/amount"
+	"github.com/openbankit/go-base/xdr"
+	"github.com/openbankit/horizon/db2/history"
+	"github.com/openbankit/horizon/httpx"
+	"github.com/openbankit/horizon/render/hal"
+	"github.com/openbankit/horizon/txsub/transactions/validators"
+
+	"golang.org/x/net/context"
+)
+
+// Populate fills out the resource's fields
+func (u *AccountLimitsUsage) Populate(ctx context.Context, address string, usage []validators.LimitsUsage) {
+	u.Account = address
+	u.Assets = make([]AccountLimitsUsageAsset, len(usage))
+	for i := range usage {
+		u.Assets[i].Populate(usage[i])
+	}
+
+	lb := hal.LinkBuilder{httpx.BaseURL(ctx)}
+	accountLink := fmt.Sprintf("/accounts/%s", address)
+	u.Links.Self = lb.Link(accountLink + "/limits/usage")
+	u.Links.Account = lb.Link(accountLink)
+}
+
+// Populate fills out the resource's fields
+func (a *AccountLimitsUsageAsset) Populate(usage validators.LimitsUsage) {
+	a.AssetCode = usage.AssetCode
+	a.IsAnonymous = usage.IsAnonymous
+	a.Outgoing.Populate(usage.Outgoing)
+	a.Incoming.Populate(usage.Incoming)
+	a.Rules = make([]AccountLimitsUsageRule, len(usage.Rules))
+	for i := range usage.Rules {
+		a.Rules[i].Populate(usage.Rules[i])
+	}
+}
+
+// Populate fills out the resource's fields
+func (h *LimitsHeadroom) Populate(headroom validators.Headroom) {
+	h.MaxPayment = formatLimit(headroom.MaxPayment)
+	h.BindingSource = headroom.BindingSource
+	h.BindingPeriod = strings.ToLower(headroom.BindingPeriod)
+}
+
+// Populate fills out the resource's fields
+func (r *AccountLimitsUsageRule) Populate(rule validators.LimitsRuleUsage) {
+	r.Source = rule.Source
+	r.Direction = string(rule.Direction)
+	if rule.CounterpartyType != history.AnyCounterpartyType {
+		r.CounterpartyTypeI, r.CounterpartyType = PopulateAccountTypeP(xdr.AccountType(rule.CounterpartyType))
+	}
+	r.MaxOperation = formatLimit(rule.MaxOperation)
+	r.MaxPayment = formatLimit(rule.MaxPayment)
+	r.BindingPeriod = strings.ToLower(rule.BindingPeriod)
+	r.Periods = make([]LimitsPeriodUsage, len(rule.Periods))
+	for i, period := range rule.Periods {
+		r.Periods[i] = LimitsPeriodUsage{
+			Period:    strings.ToLower(period.Period),
+			Limit:     formatLimit(period.Limit),
+			Used:      amount.String(xdr.Int64(period.Used)),
+			Remaining: formatLimit(period.Remaining),
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/openbankit/horizon/render/hal"
 	"fmt"
 	"golang.org/x/net/context"
+	"time"
 )
 
 // AccountTraits shows if account's incoming, outgoing payments are blocked
@@ -18,13 +19,43 @@ type AccountTraits struct {
 	AccountID string `json:"account_id"`
 	BlockIn   bool   `json:"block_incoming_payments"`
 	BlockOut  bool   `json:"block_outcoming_payments"`
+
+	IncomingBlock  *AccountBlock `json:"incoming_block,omitempty"`
+	OutcomingBlock *AccountBlock `json:"outcoming_block,omitempty"`
+
+	// CommissionPayer is an account sponsoring commissions of payments to this account
+	CommissionPayer string `json:"commission_payer,omitempty"`
+}
+
+// AccountBlock describes why and till when account's payments are blocked
+type AccountBlock struct {
+	Reason string     `json:"reason"`
+	Until  *time.Time `json:"until"`
+	Note   string     `json:"note"`
 }
 
 func (at *AccountTraits) Populate(ctx context.Context, hat history.Account) (err error) {
 	at.AccountID = hat.Address
 	at.PT = hat.PagingToken()
-	at.BlockIn = hat.BlockIncomingPayments
-	at.BlockOut = hat.BlockOutcomingPayments
+	// expired blocks are shown as not blocked
+	now := time.Now()
+	at.BlockIn = hat.IsIncomingBlocked(now)
+	at.BlockOut = hat.IsOutcomingBlocked(now)
+	at.CommissionPayer = hat.CommissionPayer
+	if at.BlockIn {
+		at.IncomingBlock = &AccountBlock{
+			Reason: hat.BlockIncomingReason,
+			Until:  hat.BlockIncomingUntil,
+			Note:   hat.BlockIncomingNote,
+		}
+	}
+	if at.BlockOut {
+		at.OutcomingBlock = &AccountBlock{
+			Reason: hat.BlockOutcomingReason,
+			Until:  hat.BlockOutcomingUntil,
+			Note:   hat.BlockOutcomingNote,
+		}
+	}
 	lb := hal.LinkBuilder{httpx.BaseURL(ctx)}
 	at.Links.Account = lb.Link(fmt.Sprintf("/accounts/%s", hat.Address))
 	at.Links.Self = lb.Link(fmt.Sprintf("/accounts/%s/traits", hat.Address))
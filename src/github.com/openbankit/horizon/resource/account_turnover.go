@@ -0,0 +1,52 @@
+package resource
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/openbankit/go-base/amount"
+	"github.com/openbankit/go-base/xdr"
+	"github.com/openbankit/horizon/httpx"
+	"github.com/openbankit/horizon/ingest/statistics"
+	"github.com/openbankit/horizon/render/hal"
+
+	"golang.org/x/net/context"
+)
+
+// Populate fills out the resource's fields
+func (t *AccountTurnover) Populate(
+	ctx context.Context,
+	address string,
+	from, to time.Time,
+	groupBy string,
+	entries []statistics.TurnoverEntry,
+) {
+	t.Account = address
+	t.From = from
+	t.To = to
+	t.GroupBy = groupBy
+	t.Entries = make([]AccountTurnoverEntry, len(entries))
+	for i := range entries {
+		t.Entries[i].Populate(entries[i])
+	}
+
+	lb := hal.LinkBuilder{httpx.BaseURL(ctx)}
+	accountLink := fmt.Sprintf("/accounts/%s", address)
+	query := url.Values{}
+	query.Set("from", from.UTC().Format(time.RFC3339))
+	query.Set("to", to.UTC().Format(time.RFC3339))
+	query.Set("group_by", groupBy)
+	t.Links.Self = lb.Link(accountLink + "/turnover?" + query.Encode())
+	t.Links.Account = lb.Link(accountLink)
+}
+
+// Populate fills out the resource's fields
+func (entry *AccountTurnoverEntry) Populate(turnover statistics.TurnoverEntry) {
+	entry.PeriodStart = turnover.PeriodStart
+	entry.AssetCode = turnover.AssetCode
+	entry.CounterpartyType = int16(turnover.CounterpartyType)
+	entry.CounterpartyTypeName = turnover.CounterpartyType.String()
+	entry.Income = amount.String(xdr.Int64(turnover.Income))
+	entry.Outcome = amount.String(xdr.Int64(turnover.Outcome))
+}
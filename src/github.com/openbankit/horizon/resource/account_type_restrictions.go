@@ -0,0 +1,61 @@
+package resource
+
+import (
+	"sort"
+
+	"github.com/openbankit/go-base/xdr"
+	"github.com/openbankit/horizon/httpx"
+	"github.com/openbankit/horizon/render/hal"
+	"golang.org/x/net/context"
+)
+
+// AccountTypeRestrictions shows which account types are allowed to send payments to each other
+type AccountTypeRestrictions struct {
+	Links struct {
+		Self hal.Link `json:"self"`
+	} `json:"_links"`
+	Restrictions []AccountTypeRestrictionsEntry `json:"restrictions"`
+}
+
+// AccountTypeRestrictionsEntry lists account types, payments to which are allowed for account type
+type AccountTypeRestrictionsEntry struct {
+	AccountType         string               `json:"account_type"`
+	AccountTypeI        int32                `json:"account_type_i"`
+	AllowedDestinations []AllowedAccountType `json:"allowed_destinations"`
+}
+
+type AllowedAccountType struct {
+	AccountType  string `json:"account_type"`
+	AccountTypeI int32  `json:"account_type_i"`
+}
+
+// Populate fills out the resource's fields
+func (r *AccountTypeRestrictions) Populate(ctx context.Context, restrictions map[xdr.AccountType][]xdr.AccountType) {
+	r.Restrictions = make([]AccountTypeRestrictionsEntry, 0, len(restrictions))
+	for _, from := range sortedAccountTypes(restrictions) {
+		var entry AccountTypeRestrictionsEntry
+		entry.AccountTypeI, entry.AccountType = PopulateAccountType(from)
+		entry.AllowedDestinations = make([]AllowedAccountType, len(restrictions[from]))
+		for i, to := range restrictions[from] {
+			entry.AllowedDestinations[i].AccountTypeI, entry.AllowedDestinations[i].AccountType = PopulateAccountType(to)
+		}
+		r.Restrictions = append(r.Restrictions, entry)
+	}
+
+	lb := hal.LinkBuilder{httpx.BaseURL(ctx)}
+	r.Links.Self = lb.Link("/account_type_restrictions")
+}
+
+func sortedAccountTypes(restrictions map[xdr.AccountType][]xdr.AccountType) []xdr.AccountType {
+	rawTypes := make([]int, 0, len(restrictions))
+	for accountType := range restrictions {
+		rawTypes = append(rawTypes, int(accountType))
+	}
+	sort.Ints(rawTypes)
+
+	result := make([]xdr.AccountType, len(rawTypes))
+	for i, rawType := range rawTypes {
+		result[i] = xdr.AccountType(rawType)
+	}
+	return result
+}
@@ -0,0 +1,33 @@
+package resource
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/openbankit/horizon/db2/history"
+)
+
+// Populate fills out the resource's fields
+func (this *AuditLogEntry) Populate(row history.AuditLog) error {
+	this.ID = row.Id
+	this.PT = this.PagingToken()
+	this.Actor = row.Actor
+	this.Subject = row.Subject
+	this.Action = row.Action
+	this.TxHash = row.TxHash
+	this.CreatedAt = row.CreatedAt
+	err := json.Unmarshal([]byte(row.Meta), &this.Meta)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal([]byte(row.Before), &this.Before)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal([]byte(row.After), &this.After)
+}
+
+func (this AuditLogEntry) PagingToken() string {
+	return fmt.Sprintf("%d", this.ID)
+}
@@ -33,6 +33,24 @@ func (res *Commission) Populate(row history.Commission) (err error) {
 	}
 	res.FlatFee = amount.String(xdr.Int64(row.FlatFee))
 	res.PercentFee = amount.String(xdr.Int64(row.PercentFee))
+	res.ValidFrom = row.ValidFrom
+	res.ValidUntil = row.ValidUntil
+	res.MinFee = amount.String(xdr.Int64(row.MinFee))
+	if row.MaxFee > 0 {
+		maxFee := amount.String(xdr.Int64(row.MaxFee))
+		res.MaxFee = &maxFee
+	}
+
+	tiers, err := row.GetTiers()
+	if err != nil {
+		return
+	}
+	for _, tier := range tiers {
+		res.Tiers = append(res.Tiers, CommissionTier{
+			FromAmount: amount.String(xdr.Int64(tier.FromAmount)),
+			PercentFee: amount.String(xdr.Int64(tier.PercentFee)),
+		})
+	}
 	return
 }
 
@@ -0,0 +1,90 @@
+package resource
+
+import (
+	"net/url"
+
+	"github.com/openbankit/go-base/amount"
+	"github.com/openbankit/go-base/xdr"
+	"github.com/openbankit/horizon/config"
+)
+
+// RedactedValue replaces secrets in rendered config
+const RedactedValue = "xxxxx"
+
+// Populate fills out the resource's fields, redacting passwords, tokens and secret seeds
+func (res *Config) Populate(c config.Config) {
+	res.DatabaseURL = redactURL(c.DatabaseURL)
+	res.StellarCoreDatabaseURL = redactURL(c.StellarCoreDatabaseURL)
+	res.StellarCoreURL = redactURL(c.StellarCoreURL)
+	res.Port = c.Port
+	res.Autopump = c.Autopump
+	res.RateLimit = nil
+	if c.RateLimit != nil {
+		res.RateLimit = &ConfigRateLimit{MaxBurst: c.RateLimit.MaxBurst}
+	}
+	res.RedisURL = redactURL(c.RedisURL)
+	res.LogLevel = c.LogLevel.String()
+	res.SentryDSN = redactURL(c.SentryDSN)
+	res.LogglyHost = c.LogglyHost
+	res.LogglyToken = redact(c.LogglyToken)
+	res.FriendbotSecret = redact(c.FriendbotSecret)
+	res.TLSCert = c.TLSCert
+	res.TLSKey = c.TLSKey
+	res.Ingest = c.Ingest
+	res.BankMasterKey = c.BankMasterKey
+	res.BankCommissionKey = c.BankCommissionKey
+	res.AnonymousUserRestrictions.Populate(c.AnonymousUserRestrictions)
+	res.AdminSignatureValid = int64(c.AdminSignatureValid.Seconds())
+	res.StatisticsTimeout = int64(c.StatisticsTimeout.Seconds())
+	res.ProcessedOpTimeout = int64(c.ProcessedOpTimeout.Seconds())
+	res.StatisticsStorage = c.StatisticsStorage
+	res.StatisticsReconcileInterval = int64(c.StatisticsReconcileInterval.Seconds())
+	res.StatisticsReconcileHeal = c.StatisticsReconcileHeal
+	res.SubmissionStorage = c.SubmissionStorage
+	res.SubmissionQueueSize = c.SubmissionQueueSize
+	res.SubmissionQueueAccountSize = c.SubmissionQueueAccountSize
+	res.SubmissionQueuePrioritySize = c.SubmissionQueuePrioritySize
+	res.WebhookAllowPrivate = c.WebhookAllowPrivate
+}
+
+// Populate fills out the resource's fields
+func (res *ConfigAnonymousUserRestrictions) Populate(r config.AnonymousUserRestrictions) {
+	res.MaxDailyOutcome = amount.String(xdr.Int64(r.MaxDailyOutcome))
+	res.MaxMonthlyOutcome = amount.String(xdr.Int64(r.MaxMonthlyOutcome))
+	res.MaxAnnualOutcome = amount.String(xdr.Int64(r.MaxAnnualOutcome))
+	res.MaxBalance = amount.String(xdr.Int64(r.MaxBalance))
+}
+
+func redact(secret string) string {
+	if secret == "" {
+		return ""
+	}
+	return RedactedValue
+}
+
+// redactURL hides password of the url's user info and password query param.
+// Values, which can not be parsed as url, are redacted completely.
+func redactURL(rawURL string) string {
+	if rawURL == "" {
+		return ""
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Scheme == "" || u.Opaque != "" {
+		return RedactedValue
+	}
+
+	if u.User != nil {
+		if _, hasPassword := u.User.Password(); hasPassword {
+			u.User = url.UserPassword(u.User.Username(), RedactedValue)
+		}
+	}
+
+	query := u.Query()
+	if query.Get("password") != "" {
+		query.Set("password", RedactedValue)
+		u.RawQuery = query.Encode()
+	}
+
+	return u.String()
+}
@@ -0,0 +1,40 @@
+package resource
+
+import (
+	"github.com/openbankit/horizon/db2/history"
+	"github.com/openbankit/horizon/httpx"
+	"github.com/openbankit/horizon/render/hal"
+	"golang.org/x/net/context"
+)
+
+// DefaultLimits is the list of limits applied to accounts of specified type, if account does not have own limits
+type DefaultLimits struct {
+	Links struct {
+		Self hal.Link `json:"self"`
+	} `json:"_links"`
+	Limits []DefaultLimitsEntry `json:"limits"`
+}
+
+// DefaultLimitsEntry represents default limits for account type on a specific currency
+type DefaultLimitsEntry struct {
+	AccountType  string `json:"account_type"`
+	AccountTypeI int32  `json:"account_type_i"`
+	AccountLimitsEntry
+}
+
+// Populate fills out the resource's fields
+func (dl *DefaultLimits) Populate(ctx context.Context, limits []history.AccountTypeLimits) {
+	dl.Limits = make([]DefaultLimitsEntry, len(limits))
+	for i, limit := range limits {
+		dl.Limits[i].Populate(limit)
+	}
+
+	lb := hal.LinkBuilder{httpx.BaseURL(ctx)}
+	dl.Links.Self = lb.Link("/limits/defaults")
+}
+
+// Populate fills out the resource's fields
+func (dle *DefaultLimitsEntry) Populate(entry history.AccountTypeLimits) {
+	dle.AccountTypeI, dle.AccountType = PopulateAccountType(entry.AccountType)
+	dle.AccountLimitsEntry.Populate(entry.ToAccountLimits(""))
+}
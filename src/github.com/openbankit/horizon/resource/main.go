@@ -3,6 +3,7 @@
 package resource
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/openbankit/go-base/xdr"
@@ -113,6 +114,145 @@ type AccountStatisticsEntry struct {
 	} `json:"outcome"`
 }
 
+// AccountTurnover is income/outcome of an account for arbitrary period grouped by day or month,
+// asset and counterparty type
+type AccountTurnover struct {
+	Links struct {
+		Self    hal.Link `json:"self"`
+		Account hal.Link `json:"account"`
+	} `json:"_links"`
+	Account string                 `json:"account_id"`
+	From    time.Time              `json:"from"`
+	To      time.Time              `json:"to"`
+	GroupBy string                 `json:"group_by"`
+	Entries []AccountTurnoverEntry `json:"entries"`
+}
+
+// AccountTurnoverEntry represents turnover of an account in single period
+type AccountTurnoverEntry struct {
+	PeriodStart          time.Time `json:"period_start"`
+	AssetCode            string    `json:"asset_code"`
+	CounterpartyType     int16     `json:"counterparty_type"`
+	CounterpartyTypeName string    `json:"counterparty_type_name"`
+	Income               string    `json:"income"`
+	Outcome              string    `json:"outcome"`
+}
+
+// StatisticsSummary is bank-wide statistics built from history
+type StatisticsSummary struct {
+	From        time.Time                 `json:"from"`
+	To          time.Time                 `json:"to"`
+	Assets      []StatisticsSummaryAsset  `json:"assets"`
+	Volume      []StatisticsSummaryVolume `json:"volume"`
+	ChargedFees []StatisticsSummaryTotal  `json:"charged_fees"`
+	Reversals   []StatisticsSummaryTotal  `json:"reversals"`
+}
+
+// StatisticsSummaryAsset represents issued and circulating amount of an asset
+type StatisticsSummaryAsset struct {
+	AssetCode   string `json:"asset_code"`
+	Issued      string `json:"issued"`
+	Circulating string `json:"circulating"`
+}
+
+// StatisticsSummaryVolume represents amount of payments between accounts of two types
+type StatisticsSummaryVolume struct {
+	AssetCode    string `json:"asset_code"`
+	FromType     int32  `json:"from_type"`
+	FromTypeName string `json:"from_type_name"`
+	ToType       int32  `json:"to_type"`
+	ToTypeName   string `json:"to_type_name"`
+	Amount       string `json:"amount"`
+	Count        int64  `json:"count"`
+}
+
+// StatisticsSummaryTotal represents total amount in asset
+type StatisticsSummaryTotal struct {
+	AssetCode string `json:"asset_code"`
+	Amount    string `json:"amount"`
+	Count     int64  `json:"count"`
+}
+
+// StatisticsReconciliation is the result of comparison of account statistics cached in redis with history db
+type StatisticsReconciliation struct {
+	Checked    int                  `json:"checked"`
+	Skipped    int                  `json:"skipped"`
+	Healed     int                  `json:"healed"`
+	Mismatches []StatisticsMismatch `json:"mismatches"`
+}
+
+// StatisticsMismatch represents account statistics, which differ in redis and history db
+type StatisticsMismatch struct {
+	Account string                 `json:"account_id"`
+	Healed  bool                   `json:"healed"`
+	Stored  AccountStatisticsEntry `json:"stored"`
+	Cached  AccountStatisticsEntry `json:"cached"`
+}
+
+// StatisticsReservations is a list of account statistics reserved by transactions waiting for result
+type StatisticsReservations struct {
+	Reservations []StatisticsReservation `json:"reservations"`
+}
+
+// StatisticsReservation is an amount added to account statistics by operation of not applied transaction
+type StatisticsReservation struct {
+	TxHash            string    `json:"tx_hash"`
+	OpIndex           int       `json:"op_index"`
+	Account           string    `json:"account_id"`
+	AssetCode         string    `json:"asset_code"`
+	CounterpartyTypeI int32     `json:"counterparty_type_i"`
+	CounterpartyType  string    `json:"counterparty_type"`
+	Direction         string    `json:"direction"`
+	Amount            string    `json:"amount"`
+	ReservedAt        time.Time `json:"reserved_at"`
+}
+
+// Config is the effective configuration of horizon with secrets redacted
+type Config struct {
+	DatabaseURL                 string                          `json:"db_url"`
+	StellarCoreDatabaseURL      string                          `json:"stellar_core_db_url"`
+	StellarCoreURL              string                          `json:"stellar_core_url"`
+	Port                        int                             `json:"port"`
+	Autopump                    bool                            `json:"autopump"`
+	RateLimit                   *ConfigRateLimit                `json:"rate_limit"`
+	RedisURL                    string                          `json:"redis_url"`
+	LogLevel                    string                          `json:"log_level"`
+	SentryDSN                   string                          `json:"sentry_dsn"`
+	LogglyHost                  string                          `json:"loggly_host"`
+	LogglyToken                 string                          `json:"loggly_token"`
+	FriendbotSecret             string                          `json:"friendbot_secret"`
+	TLSCert                     string                          `json:"tls_cert"`
+	TLSKey                      string                          `json:"tls_key"`
+	Ingest                      bool                            `json:"ingest"`
+	BankMasterKey               string                          `json:"bank_master_key"`
+	BankCommissionKey           string                          `json:"bank_commission_key"`
+	AnonymousUserRestrictions   ConfigAnonymousUserRestrictions `json:"anonymous_user_restrictions"`
+	AdminSignatureValid         int64                           `json:"admin_sig_valid"`
+	StatisticsTimeout           int64                           `json:"stats_timeout"`
+	ProcessedOpTimeout          int64                           `json:"processed_op_timeout"`
+	StatisticsStorage           string                          `json:"stats_storage"`
+	StatisticsReconcileInterval int64                           `json:"stats_reconcile_interval"`
+	StatisticsReconcileHeal     bool                            `json:"stats_reconcile_heal"`
+	SubmissionStorage           string                          `json:"txsub_storage"`
+	SubmissionQueueSize         int                             `json:"txsub_queue_size"`
+	SubmissionQueueAccountSize  int                             `json:"txsub_queue_account_size"`
+	SubmissionQueuePrioritySize int                             `json:"txsub_queue_priority_size"`
+	WebhookAllowPrivate         bool                            `json:"webhook_allow_private"`
+}
+
+// ConfigRateLimit represents rate limit quota of horizon
+type ConfigRateLimit struct {
+	MaxBurst int `json:"max_burst"`
+}
+
+// ConfigAnonymousUserRestrictions represents limits applied to anonymous users
+type ConfigAnonymousUserRestrictions struct {
+	MaxDailyOutcome   string `json:"max_daily_outcome"`
+	MaxMonthlyOutcome string `json:"max_monthly_outcome"`
+	MaxAnnualOutcome  string `json:"max_annual_outcome"`
+	MaxBalance        string `json:"max_balance"`
+}
+
 // AccountFlags represents the state of an account's flags
 type AccountFlags struct {
 	AuthRequired  bool `json:"auth_required"`
@@ -308,6 +448,24 @@ type TransactionResultCodes struct {
 	OperationCodes  []string `json:"operations,omitempty"`
 }
 
+// TransactionValidation represents the result of pre-flight validation of transaction
+type TransactionValidation struct {
+	Valid       bool                             `json:"valid"`
+	Hash        string                           `json:"hash"`
+	Env         string                           `json:"envelope_xdr"`
+	TxErrorInfo map[string]string                `json:"tx_error_info,omitempty"`
+	Operations  []TransactionValidationOperation `json:"operations"`
+}
+
+// TransactionValidationOperation represents the result of validation and commission of single operation
+type TransactionValidationOperation struct {
+	Type           string            `json:"type"`
+	TypeI          int32             `json:"type_i"`
+	ResultCode     string            `json:"result_code,omitempty"`
+	AdditionalInfo map[string]string `json:"additional_info,omitempty"`
+	Fee            details.Fee       `json:"fee"`
+}
+
 // TransactionSuccess represents the result of a successful transaction
 // submission.
 type TransactionSuccess struct {
@@ -321,6 +479,19 @@ type TransactionSuccess struct {
 	Meta   string `json:"result_meta_xdr"`
 }
 
+// TransactionStatus represents state of the submitted transaction
+type TransactionStatus struct {
+	Links struct {
+		Self        hal.Link `json:"self"`
+		Transaction hal.Link `json:"transaction"`
+	} `json:"_links"`
+	Hash        string                  `json:"hash"`
+	Status      string                  `json:"status"`
+	Ledger      int32                   `json:"ledger,omitempty"`
+	Result      string                  `json:"result_xdr,omitempty"`
+	ResultCodes *TransactionResultCodes `json:"result_codes,omitempty"`
+}
+
 // AccountLimits is the limits set on an account
 type AccountLimits struct {
 	Links struct {
@@ -333,27 +504,167 @@ type AccountLimits struct {
 
 // AccountLimitsEntry represents limits on a specific currency
 type AccountLimitsEntry struct {
-	AssetCode       string `json:"asset_code"`
-	MaxOperationOut string `json:"max_operation_out"`
-	DailyMaxOut     string `json:"daily_max_out"`
-	MonthlyMaxOut   string `json:"monthly_max_out"`
-	MaxOperationIn  string `json:"max_operation_in"`
-	DailyMaxIn      string `json:"daily_max_in"`
-	MonthlyMaxIn    string `json:"monthly_max_in"`
+	AssetCode         string  `json:"asset_code"`
+	CounterpartyType  *string `json:"counterparty_type,omitempty"`
+	CounterpartyTypeI *int32  `json:"counterparty_type_i,omitempty"`
+	MaxOperationOut   string  `json:"max_operation_out"`
+	DailyMaxOut       string  `json:"daily_max_out"`
+	WeeklyMaxOut      string  `json:"weekly_max_out"`
+	MonthlyMaxOut     string  `json:"monthly_max_out"`
+	AnnualMaxOut      string  `json:"annual_max_out"`
+	MaxOperationIn    string  `json:"max_operation_in"`
+	DailyMaxIn        string  `json:"daily_max_in"`
+	WeeklyMaxIn       string  `json:"weekly_max_in"`
+	MonthlyMaxIn      string  `json:"monthly_max_in"`
+	AnnualMaxIn       string  `json:"annual_max_in"`
+}
+
+// AccountLimitsUsage is usage of limits applied to account's payments and remaining headroom
+type AccountLimitsUsage struct {
+	Links struct {
+		Self    hal.Link `json:"self"`
+		Account hal.Link `json:"account"`
+	} `json:"_links"`
+	Account string                    `json:"account"`
+	Assets  []AccountLimitsUsageAsset `json:"assets"`
+}
+
+// AccountLimitsUsageAsset represents usage of limits for payments in a specific currency
+type AccountLimitsUsageAsset struct {
+	AssetCode   string                   `json:"asset_code"`
+	IsAnonymous bool                     `json:"is_anonymous"`
+	Outgoing    LimitsHeadroom           `json:"outgoing"`
+	Incoming    LimitsHeadroom           `json:"incoming"`
+	Rules       []AccountLimitsUsageRule `json:"rules"`
+}
+
+// LimitsHeadroom represents max amount of single payment with any counterparty and the rule it is limited by
+type LimitsHeadroom struct {
+	MaxPayment    string `json:"max_payment"`
+	BindingSource string `json:"binding_source,omitempty"`
+	BindingPeriod string `json:"binding_period,omitempty"`
+}
+
+// AccountLimitsUsageRule represents usage of a single set of limits in one direction
+type AccountLimitsUsageRule struct {
+	Source            string              `json:"source"`
+	Direction         string              `json:"direction"`
+	CounterpartyType  *string             `json:"counterparty_type,omitempty"`
+	CounterpartyTypeI *int32              `json:"counterparty_type_i,omitempty"`
+	MaxOperation      string              `json:"max_operation"`
+	MaxPayment        string              `json:"max_payment"`
+	BindingPeriod     string              `json:"binding_period,omitempty"`
+	Periods           []LimitsPeriodUsage `json:"periods"`
+}
+
+// LimitsPeriodUsage represents limit for the period, used and remaining amount
+type LimitsPeriodUsage struct {
+	Period    string `json:"period"`
+	Limit     string `json:"limit"`
+	Used      string `json:"used"`
+	Remaining string `json:"remaining"`
 }
 
 type Commission struct {
-	Id               int64          `json:"id"`
-	From             *string        `json:"from,omitempty"`
-	To               *string        `json:"to,omitempty"`
-	FromAccountType  *string        `json:"from_account_type,omitempty"`
-	FromAccountTypeI *int32         `json:"from_account_type_i,omitempty"`
-	ToAccountType    *string        `json:"to_account_type,omitempty"`
-	ToAccountTypeI   *int32         `json:"to_account_type_i,omitempty"`
-	Asset            *details.Asset `json:"asset,omitempty"`
-	FlatFee          string         `json:"flat_fee"`
-	PercentFee       string         `json:"percent_fee"`
-	Weight           int            `json:"weight"`
+	Id               int64            `json:"id"`
+	From             *string          `json:"from,omitempty"`
+	To               *string          `json:"to,omitempty"`
+	FromAccountType  *string          `json:"from_account_type,omitempty"`
+	FromAccountTypeI *int32           `json:"from_account_type_i,omitempty"`
+	ToAccountType    *string          `json:"to_account_type,omitempty"`
+	ToAccountTypeI   *int32           `json:"to_account_type_i,omitempty"`
+	Asset            *details.Asset   `json:"asset,omitempty"`
+	FlatFee          string           `json:"flat_fee"`
+	PercentFee       string           `json:"percent_fee"`
+	MinFee           string           `json:"min_fee"`
+	MaxFee           *string          `json:"max_fee,omitempty"`
+	Tiers            []CommissionTier `json:"tiers,omitempty"`
+	ValidFrom        *time.Time       `json:"valid_from,omitempty"`
+	ValidUntil       *time.Time       `json:"valid_until,omitempty"`
+	Weight           int              `json:"weight"`
+}
+
+// AuditLogEntry represents admin action performed on horizon's state
+type AuditLogEntry struct {
+	ID        int64       `json:"id"`
+	PT        string      `json:"paging_token"`
+	Actor     string      `json:"actor"`
+	Subject   string      `json:"subject"`
+	Action    string      `json:"action"`
+	Meta      interface{} `json:"meta"`
+	TxHash    string      `json:"tx_hash"`
+	Before    interface{} `json:"before"`
+	After     interface{} `json:"after"`
+	CreatedAt time.Time   `json:"created_at"`
+}
+
+// AdminActionDryRun represents result of administrative operation validation without applying it.
+// For batch Errors contains errors of all invalid items.
+type AdminActionDryRun struct {
+	Valid   bool                `json:"valid"`
+	Error   *AdminActionError   `json:"error,omitempty"`
+	Errors  []AdminActionError  `json:"errors,omitempty"`
+	Changes []AdminActionChange `json:"changes"`
+}
+
+// AdminActionError describes why administrative operation is malformed
+type AdminActionError struct {
+	Index  *int   `json:"index,omitempty"`
+	Field  string `json:"field,omitempty"`
+	Reason string `json:"reason"`
+}
+
+// AdminActionChange represents row, which will be changed by administrative operation
+type AdminActionChange struct {
+	Subject string      `json:"subject"`
+	Action  string      `json:"action"`
+	Before  interface{} `json:"before"`
+	After   interface{} `json:"after"`
+}
+
+// CommissionTier represents percent fee charged for payments starting from amount
+type CommissionTier struct {
+	FromAmount string `json:"from_amount"`
+	PercentFee string `json:"percent_fee"`
+}
+
+// WebhookSubscription represents HTTP endpoint notified about horizon events. Secret used to sign
+// deliveries is never rendered.
+type WebhookSubscription struct {
+	Links struct {
+		Self        hal.Link `json:"self"`
+		DeadLetters hal.Link `json:"dead_letters"`
+	} `json:"_links"`
+	ID         string    `json:"id"`
+	PT         string    `json:"paging_token"`
+	URL        string    `json:"url"`
+	Account    string    `json:"account_id,omitempty"`
+	EventTypes []string  `json:"event_types"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// WebhookDeadLetter represents delivery, which failed max number of attempts
+type WebhookDeadLetter struct {
+	ID             string          `json:"id"`
+	PT             string          `json:"paging_token"`
+	SubscriptionID string          `json:"subscription_id"`
+	DeliveryID     string          `json:"delivery_id"`
+	URL            string          `json:"url"`
+	EventID        string          `json:"event_id"`
+	EventType      string          `json:"event_type"`
+	Payload        json.RawMessage `json:"payload"`
+	Attempts       int             `json:"attempts"`
+	LastError      string          `json:"last_error"`
+	FailedAt       time.Time       `json:"failed_at"`
+}
+
+// WebhookPing represents result of test delivery sent to webhook subscription
+type WebhookPing struct {
+	SubscriptionID string `json:"subscription_id"`
+	Delivered      bool   `json:"delivered"`
+	StatusCode     int    `json:"status_code,omitempty"`
+	DurationMs     int64  `json:"duration_ms"`
+	Error          string `json:"error,omitempty"`
 }
 
 // NewEffect returns a resource of the appropriate sub-type for the provided
@@ -0,0 +1,19 @@
+package resource
+
+import (
+	"github.com/openbankit/horizon/txsub/transactions/statistics"
+)
+
+// Populate fills out the resource's fields
+func (res *StatisticsReconciliation) Populate(result *statistics.ReconcileResult) {
+	res.Checked = result.Checked
+	res.Skipped = result.Skipped
+	res.Healed = result.Healed
+	res.Mismatches = make([]StatisticsMismatch, len(result.Mismatches))
+	for i, mismatch := range result.Mismatches {
+		res.Mismatches[i].Account = mismatch.Account
+		res.Mismatches[i].Healed = mismatch.Healed
+		res.Mismatches[i].Stored.Populate(mismatch.Stored)
+		res.Mismatches[i].Cached.Populate(mismatch.Cached)
+	}
+}
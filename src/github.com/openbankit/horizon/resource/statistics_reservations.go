@@ -0,0 +1,27 @@
+package resource
+
+import (
+	"github.com/openbankit/go-base/amount"
+	"github.com/openbankit/go-base/xdr"
+	"github.com/openbankit/horizon/txsub/transactions/statistics"
+)
+
+// Populate fills out the resource's fields
+func (res *StatisticsReservations) Populate(reservations []statistics.Reservation) {
+	res.Reservations = make([]StatisticsReservation, len(reservations))
+	for i := range reservations {
+		res.Reservations[i].Populate(reservations[i])
+	}
+}
+
+// Populate fills out the resource's fields
+func (res *StatisticsReservation) Populate(reservation statistics.Reservation) {
+	res.TxHash = reservation.TxHash
+	res.OpIndex = reservation.OpIndex
+	res.Account = reservation.Account
+	res.AssetCode = reservation.AssetCode
+	res.CounterpartyTypeI, res.CounterpartyType = PopulateAccountType(reservation.CounterpartyType)
+	res.Direction = string(reservation.Direction)
+	res.Amount = amount.String(xdr.Int64(reservation.Amount))
+	res.ReservedAt = reservation.ReservedAt
+}
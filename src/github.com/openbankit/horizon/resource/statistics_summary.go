@@ -0,0 +1,46 @@
+package resource
+
+import (
+	"github.com/openbankit/go-base/amount"
+	"github.com/openbankit/go-base/xdr"
+	"github.com/openbankit/horizon/ingest/statistics"
+)
+
+// Populate fills out the resource's fields
+func (s *StatisticsSummary) Populate(summary *statistics.Summary) {
+	s.From = summary.From
+	s.To = summary.To
+
+	s.Assets = make([]StatisticsSummaryAsset, len(summary.Issuance))
+	for i, issuance := range summary.Issuance {
+		s.Assets[i] = StatisticsSummaryAsset{
+			AssetCode:   issuance.AssetCode,
+			Issued:      amount.String(xdr.Int64(issuance.Issued)),
+			Circulating: amount.String(xdr.Int64(issuance.Circulating)),
+		}
+	}
+
+	s.Volume = make([]StatisticsSummaryVolume, len(summary.Volume))
+	for i, volume := range summary.Volume {
+		s.Volume[i].AssetCode = volume.AssetCode
+		s.Volume[i].FromType, s.Volume[i].FromTypeName = PopulateAccountType(volume.FromType)
+		s.Volume[i].ToType, s.Volume[i].ToTypeName = PopulateAccountType(volume.ToType)
+		s.Volume[i].Amount = amount.String(xdr.Int64(volume.Amount))
+		s.Volume[i].Count = volume.Count
+	}
+
+	s.ChargedFees = populateSummaryTotals(summary.ChargedFees)
+	s.Reversals = populateSummaryTotals(summary.Reversals)
+}
+
+func populateSummaryTotals(totals []statistics.AssetTotal) []StatisticsSummaryTotal {
+	result := make([]StatisticsSummaryTotal, len(totals))
+	for i, total := range totals {
+		result[i] = StatisticsSummaryTotal{
+			AssetCode: total.AssetCode,
+			Amount:    amount.String(xdr.Int64(total.Amount)),
+			Count:     total.Count,
+		}
+	}
+	return result
+}
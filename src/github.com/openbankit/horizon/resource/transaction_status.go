@@ -0,0 +1,39 @@
+package resource
+
+import (
+	"github.com/openbankit/horizon/httpx"
+	"github.com/openbankit/horizon/render/hal"
+	"github.com/openbankit/horizon/txsub"
+	"github.com/openbankit/horizon/txsub/results"
+	"golang.org/x/net/context"
+)
+
+// Populate fills out the details
+func (res *TransactionStatus) Populate(ctx context.Context, hash string, status txsub.TransactionStatus, result txsub.Result) (err error) {
+	res.Hash = hash
+	res.Status = string(status)
+	res.Ledger = 0
+	res.Result = ""
+	res.ResultCodes = nil
+
+	switch status {
+	case txsub.TransactionApplied:
+		res.Ledger = result.LedgerSequence
+		res.Result = result.ResultXDR
+	case txsub.TransactionFailed:
+		res.Ledger = result.LedgerSequence
+		res.Result = result.ResultXDR
+		if fail, ok := result.Err.(*results.FailedTransactionError); ok {
+			res.ResultCodes = &TransactionResultCodes{}
+			err = res.ResultCodes.Populate(ctx, fail)
+			if err != nil {
+				return
+			}
+		}
+	}
+
+	lb := hal.LinkBuilder{httpx.BaseURL(ctx)}
+	res.Links.Self = lb.Linkf("/transactions/%s/status", hash)
+	res.Links.Transaction = lb.Link("/transactions", hash)
+	return
+}
@@ -0,0 +1,60 @@
+package resource
+
+import (
+	"github.com/openbankit/go-base/xdr"
+	"github.com/openbankit/horizon/codes"
+	"github.com/openbankit/horizon/resource/operations"
+	"github.com/openbankit/horizon/txsub"
+	"golang.org/x/net/context"
+)
+
+// Populate fills out the details
+func (res *TransactionValidation) Populate(ctx context.Context, result *txsub.ValidationResult) (err error) {
+	res.Valid = result.IsValid
+	res.Hash = result.Hash
+	res.Env = result.EnvelopeXDR
+	if result.TransactionErrorInfo != nil {
+		res.TxErrorInfo = result.TransactionErrorInfo.GetData()
+	}
+
+	ops := result.Envelope.Tx.Operations
+	res.Operations = make([]TransactionValidationOperation, len(ops))
+	for i := range ops {
+		op := &res.Operations[i]
+		op.Type = operations.TypeNames[ops[i].Body.Type]
+		op.TypeI = int32(ops[i].Body.Type)
+
+		if i < len(result.Fees) {
+			op.Fee.Populate(result.Fees[i])
+
+			// sponsored operations are not charged
+			isSponsored := result.Fees[i].Type == xdr.OperationFeeTypeOpFeeNone &&
+				i < len(result.Sponsorships) && result.Sponsorships[i] != nil
+			if isSponsored {
+				op.Fee.Sponsor = result.Sponsorships[i].FeeSponsor()
+			}
+		}
+
+		// operations are not checked, if transaction itself is invalid
+		if i >= len(result.Operations) {
+			continue
+		}
+
+		opResult := result.Operations[i]
+		if opResult.IsValid {
+			// inner result is not populated for valid operations
+			op.ResultCode = codes.OpSuccess
+			continue
+		}
+
+		op.ResultCode, err = codes.ForOperationResult(opResult.Result.Result)
+		if err != nil {
+			return
+		}
+
+		if len(opResult.Result.Info) > 0 {
+			op.AdditionalInfo = opResult.Result.Info.GetData()
+		}
+	}
+	return
+}
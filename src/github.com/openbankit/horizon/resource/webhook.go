@@ -0,0 +1,66 @@
+package resource
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/openbankit/horizon/httpx"
+	"github.com/openbankit/horizon/render/hal"
+	"github.com/openbankit/horizon/webhook"
+	"golang.org/x/net/context"
+)
+
+// Populate fills out the resource's fields
+func (res *WebhookSubscription) Populate(ctx context.Context, row webhook.Subscription) {
+	res.ID = strconv.FormatInt(row.ID, 10)
+	res.PT = row.PagingToken()
+	res.URL = row.URL
+	res.Account = row.Account.String
+	res.CreatedAt = row.CreatedAt
+
+	res.EventTypes = make([]string, 0)
+	for _, eventType := range row.Types() {
+		res.EventTypes = append(res.EventTypes, string(eventType))
+	}
+
+	lb := hal.LinkBuilder{httpx.BaseURL(ctx)}
+	res.Links.Self = lb.Link("/admin/webhooks", res.ID)
+	res.Links.DeadLetters = lb.Linkf("/admin/webhooks/dead_letters?subscription_id=%s", res.ID)
+}
+
+// PagingToken implementation for hal.Pageable
+func (res WebhookSubscription) PagingToken() string {
+	return res.PT
+}
+
+// Populate fills out the resource's fields
+func (res *WebhookDeadLetter) Populate(row webhook.DeadLetter) {
+	res.ID = strconv.FormatInt(row.ID, 10)
+	res.PT = row.PagingToken()
+	res.SubscriptionID = strconv.FormatInt(row.SubscriptionID, 10)
+	res.DeliveryID = strconv.FormatInt(row.DeliveryID, 10)
+	res.URL = row.URL
+	res.EventID = row.EventID
+	res.EventType = row.EventType
+	res.Payload = []byte(row.Payload)
+	res.Attempts = row.Attempts
+	res.LastError = row.LastError
+	res.FailedAt = row.FailedAt
+}
+
+// PagingToken implementation for hal.Pageable
+func (res WebhookDeadLetter) PagingToken() string {
+	return res.PT
+}
+
+// Populate fills out the resource's fields
+func (res *WebhookPing) Populate(subscriptionID int64, result webhook.Result) {
+	res.SubscriptionID = strconv.FormatInt(subscriptionID, 10)
+	res.Delivered = result.Err == nil
+	res.StatusCode = result.StatusCode
+	res.DurationMs = int64(result.Duration / time.Millisecond)
+	res.Error = ""
+	if result.Err != nil {
+		res.Error = result.Err.Error()
+	}
+}
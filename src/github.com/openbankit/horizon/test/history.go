@@ -0,0 +1,31 @@
+package test
+
+import (
+	"fmt"
+)
+
+// InsertPayment stores payment as if it was ingested in the ledger as application order-th transaction.
+// Both accounts must exist in history. Returns id of the operation.
+func (t *T) InsertPayment(ledger int32, order int32, from, to, amount, assetCode string) int64 {
+	repo := t.HorizonRepo()
+	txID := int64(ledger)<<32 | int64(order)<<12
+	opID := txID + 1
+
+	_, err := repo.ExecRaw(`INSERT INTO history_transactions (transaction_hash, ledger_sequence, application_order,
+		account, account_sequence, fee_paid, operation_count, id, tx_envelope, tx_result, tx_meta, tx_fee_meta)
+		VALUES (?, ?, ?, ?, ?, 0, 1, ?, '', '', '', '')`,
+		fmt.Sprintf("%064x", txID), ledger, order, from, txID, txID)
+	t.Require.NoError(err)
+
+	details := fmt.Sprintf(`{"from": "%s", "to": "%s", "amount": "%s", "asset_code": "%s", "asset_type": "credit_alphanum4"}`,
+		from, to, amount, assetCode)
+	_, err = repo.ExecRaw(`INSERT INTO history_operations (id, transaction_id, application_order, type, details, source_account)
+		VALUES (?, ?, 1, 1, ?, ?)`, opID, txID, details, from)
+	t.Require.NoError(err)
+
+	_, err = repo.ExecRaw(`INSERT INTO history_operation_participants (history_operation_id, history_account_id)
+		SELECT ?, id FROM history_accounts WHERE address IN (?, ?)`, opID, from, to)
+	t.Require.NoError(err)
+
+	return opID
+}
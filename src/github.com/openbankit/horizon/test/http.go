@@ -12,7 +12,11 @@ import (
 type RequestHelper interface {
 	Get(string, func(*http.Request)) *httptest.ResponseRecorder
 	Post(string, url.Values, func(*http.Request)) *httptest.ResponseRecorder
+	Delete(string, func(*http.Request)) *httptest.ResponseRecorder
 	SignedPost(keypair.KP, string, url.Values, func(*http.Request)) *httptest.ResponseRecorder
+	SignedGet(keypair.KP, string, func(*http.Request)) *httptest.ResponseRecorder
+	SignedDelete(keypair.KP, string, func(*http.Request)) *httptest.ResponseRecorder
+	Execute(*http.Request, func(*http.Request)) *httptest.ResponseRecorder
 }
 
 type requestHelper struct {
@@ -64,6 +68,15 @@ func (r *requestHelper) Post(
 	return r.Execute(req, requestModFn)
 }
 
+func (r *requestHelper) Delete(
+	path string,
+	requestModFn func(*http.Request),
+) *httptest.ResponseRecorder {
+
+	req, _ := http.NewRequest("DELETE", path, nil)
+	return r.Execute(req, requestModFn)
+}
+
 func (r *requestHelper) SignedPost(signer keypair.KP, path string, form url.Values, requestModFn func(*http.Request)) *httptest.ResponseRecorder {
 	requestData := NewRequestData(signer, form)
 	requestData.Path = path
@@ -71,6 +84,16 @@ func (r *requestHelper) SignedPost(signer keypair.KP, path string, form url.Valu
 	return r.Execute(req, requestModFn)
 }
 
+func (r *requestHelper) SignedGet(signer keypair.KP, path string, requestModFn func(*http.Request)) *httptest.ResponseRecorder {
+	requestData := NewSignedRequestData(signer, "GET", path)
+	return r.Execute(requestData.CreateRequest(), requestModFn)
+}
+
+func (r *requestHelper) SignedDelete(signer keypair.KP, path string, requestModFn func(*http.Request)) *httptest.ResponseRecorder {
+	requestData := NewSignedRequestData(signer, "DELETE", path)
+	return r.Execute(requestData.CreateRequest(), requestModFn)
+}
+
 func (r *requestHelper) Execute(
 	req *http.Request,
 	requestModFn func(*http.Request),
@@ -102,6 +102,15 @@ func BankMasterSeed() *keypair.Full {
 	return bankFull
 }
 
+// AdminSeed returns seed of the bank's master key set in test config, used to sign requests to admin endpoints
+func AdminSeed() *keypair.Full {
+	kp, err := keypair.Parse(adminSeed)
+	if err != nil {
+		hlog.WithStack(err).Panic("Failed to parse admin seed")
+	}
+	return kp.(*keypair.Full)
+}
+
 func RedisURL() string {
 	return os.Getenv("REDIS_URL")
 }
@@ -164,27 +173,48 @@ func StellarCoreDatabaseURL() string {
 
 }
 
+// adminSeed is the seed of the bank's master key set in test config
+const adminSeed = "SAWVTL2JG2HTPPABJZKN3GJEDTHT7YD3TW5XWAWPKAE2NNZPWNNBOIXE"
+
 // Used to create http.Request with signature
 type RequestData struct {
 	Path        string
+	Method      string
 	Signature   string
 	PublicKey   string
 	EncodedForm string
 	Timestamp   int64
 }
 
-func GetAdminActionSignatureBase(bodyString string, timeCreated string) string {
-	return "{method: 'post', body: '" + bodyString + "', timestamp: '" + timeCreated + "'}"
+// GetAdminActionSignatureBase returns content signed by admin. Body of POST request is signed, request URI - otherwise
+func GetAdminActionSignatureBase(method string, bodyString string, timeCreated string) string {
+	return "{method: '" + strings.ToLower(method) + "', body: '" + bodyString + "', timestamp: '" + timeCreated + "'}"
 }
 
 // Used to create valid RequestData
 func NewRequestData(signer keypair.KP, form url.Values) RequestData {
 	r := RequestData{
-		PublicKey: signer.Address(),
-		Timestamp: time.Now().Unix(),
+		Method:      "POST",
+		EncodedForm: form.Encode(),
 	}
-	r.EncodedForm = form.Encode()
-	signatureBase := GetAdminActionSignatureBase(r.EncodedForm, strconv.FormatInt(r.Timestamp, 10))
+	r.sign(signer, r.EncodedForm)
+	return r
+}
+
+// NewSignedRequestData creates valid RequestData for request without body
+func NewSignedRequestData(signer keypair.KP, method string, path string) RequestData {
+	r := RequestData{
+		Path:   path,
+		Method: method,
+	}
+	r.sign(signer, path)
+	return r
+}
+
+func (r *RequestData) sign(signer keypair.KP, body string) {
+	r.PublicKey = signer.Address()
+	r.Timestamp = time.Now().Unix()
+	signatureBase := GetAdminActionSignatureBase(r.Method, body, strconv.FormatInt(r.Timestamp, 10))
 	hashBase := hash.Hash([]byte(signatureBase))
 	xdrSig, err := signer.SignDecorated(hashBase[:])
 	if err != nil {
@@ -194,14 +224,18 @@ func NewRequestData(signer keypair.KP, form url.Values) RequestData {
 	if err != nil {
 		hlog.Panic("Failed to marshal sign")
 	}
-	return r
 }
 
 // Creates http request from RequestData
 func (r *RequestData) CreateRequest() *http.Request {
-	body := strings.NewReader(r.EncodedForm)
-	req, _ := http.NewRequest("POST", r.Path, body)
-	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	var req *http.Request
+	if r.Method == "POST" {
+		body := strings.NewReader(r.EncodedForm)
+		req, _ = http.NewRequest(r.Method, r.Path, body)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	} else {
+		req, _ = http.NewRequest(r.Method, r.Path, nil)
+	}
 	req.Header.Set("X-AuthPublicKey", r.PublicKey)
 	req.Header.Set("X-AuthSignature", r.Signature)
 	req.Header.Set("X-AuthTimestamp", strconv.FormatInt(r.Timestamp, 10))
@@ -221,6 +255,8 @@ func NewTestConfig() config.Config {
 		AdminSignatureValid:    time.Duration(60) * time.Second,
 		StatisticsTimeout:      time.Duration(60) * time.Second,
 		ProcessedOpTimeout:     time.Duration(30) * time.Second,
-		BankMasterKey:          "GAWIB7ETYGSWULO4VB7D6S42YLPGIC7TY7Y2SSJKVOTMQXV5TILYWBUA", //SAWVTL2JG2HTPPABJZKN3GJEDTHT7YD3TW5XWAWPKAE2NNZPWNNBOIXE
+		BankMasterKey:          "GAWIB7ETYGSWULO4VB7D6S42YLPGIC7TY7Y2SSJKVOTMQXV5TILYWBUA", // adminSeed
+		// webhook endpoints of tests are served on loopback
+		WebhookAllowPrivate:    true,
 	}
 }
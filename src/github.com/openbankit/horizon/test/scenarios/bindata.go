@@ -236,7 +236,7 @@ func baseCoreSql() (*asset, error) {
 	return a, nil
 }
 
-var _baseHorizonSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xc4\x5c\xe9\x72\xdb\xb8\x93\xff\xee\xa7\x40\xfd\xbf\xc8\xa9\xb5\xbd\xbc\x0f\xbb\x66\xaa\x64\x5b\x49\x34\x91\xa5\x8c\x25\x27\xf1\x6e\x6d\xb1\x78\x80\x32\x37\x12\xa9\x21\xa9\x24\x9e\xad\x7d\xf7\x05\x78\x48\x3c\x40\x02\x24\xe5\xd9\x19\x57\x39\x26\x1a\xbf\x3e\xd0\x68\x34\x1b\x04\x2e\x2f\xcf\x2e\x2f\xc1\xe7\x20\x8a\xd7\x21\x5c\xfe\x39\x03\x8e\x19\x9b\x96\x19\x41\xe0\xec\xb7\x3b\xd4\x76\x86\xdb\xef\xd1\xbf\xa1\x03\xdc\x30\xd8\x1e\x09\x7e\xc0\x30\xf2\x02\x1f\xe8\x57\xf2\x15\x57\xa0\xb2\x5e\xc1\x6e\x6d\xe0\xee\x15\x92\xb3\xe5\x64\x05\xa2\xd8\x8c\xe1\x16\xfa\xb1\x11\x7b\x5b\x18\xec\x63\xf0\x1b\xe0\x6e\x92\xa6\x4d\x60\x7f\xaf\x3f\xb5\x37\x1e\xa6\x86\xbe\x1d\x38\x9e\xbf\x46\x0d\xa3\xa7\xd5\x7b\x6d\x74\x93\xc3\xf9\x8e\x19\x3a\x86\x1d\xf8\x6e\x10\x6e\x11\x85\x11\xc5\x21\xfa\x15\x21\xca\xc0\xcf\x30\x5e\x20\x82\x76\xf7\xbe\x1d\x23\x71\x0c\x0b\x21\x41\xdc\xee\x9a\x9b\x08\x96\xd8\x20\x00\x63\x0b\xa3\xc8\x5c\x27\x04\x3f\xcd\xd0\x47\x58\x37\x99\xec\xd0\x0c\xed\x17\x63\x67\xc6\x2f\xa8\x6d\xb7\xb7\x36\x9e\x7d\x81\x95\xb5\x91\x4d\x36\x01\x26\xbb\x7f\x5c\x7c\x06\xd3\xf9\xfd\xe4\x1b\x98\xbe\x07\x93\x6f\xd3\xe5\x6a\x99\x51\x5e\xc5\xa1\xe9\x40\x03\xba\x2e\xb4\xe3\xc8\xb0\x5e\x8d\x20\x74\x60\x88\xa4\x09\xbe\xdf\xb4\x76\xf4\x7c\x07\xfe\x32\x5e\xbc\x28\x0e\xc2\x57\x03\xc1\xf8\x91\x99\x68\x12\x19\x48\x1b\xcf\xe9\xd2\x3b\xd8\xc1\xd0\x3c\xf4\x8d\x5f\x77\x70\x40\xef\xa3\x24\x83\xa4\xe8\xd6\x77\x03\x9d\x35\xf2\x2b\xdc\x31\x82\x7f\xed\x91\x63\x74\x52\xa1\xd0\x7d\x17\xc2\x1f\x5e\xb0\x8f\xb2\x67\xc6\x8b\x19\xbd\xf4\x84\x1a\x8e\xe0\x6d\x77\x41\x18\x23\x8c\x6c\xd2\xf4\x85\xe9\x6b\x4b\x7b\x13\x44\xd0\x31\xcc\xb8\x4b\xff\xdc\x99\x7b\xb8\x92\x69\xdb\xc1\xde\x47\x7d\x7f\x7a\xf1\x0b\x76\x25\x2f\x8e\x7a\xf5\xef\xac\x74\xb1\xa7\xe9\x38\x21\x9a\xee\xed\xdd\x5f\xe2\x1d\x9e\xae\x2f\x31\x8d\xcf\x4b\x54\x9a\x13\xa8\x0f\x43\x8f\xcc\x75\x58\x88\x83\x54\x8e\x80\x4a\x88\x34\x35\xe2\x5f\xc6\x8e\x0e\x89\x29\x11\x2c\x23\x25\x64\x25\xcb\xa3\x5b\x3b\xb1\x1d\x6c\xb7\x5e\x14\x65\xb6\xa2\x4f\x9e\x32\xbd\x19\x45\x90\xe2\xad\x95\x0e\xe9\xc0\x33\xb8\x2a\xb1\x5f\x7b\x17\x2b\x9f\x4d\x54\x32\xba\x9e\xac\x3c\x13\x0b\x44\x68\xed\x43\xeb\x0a\x12\x77\x8f\xdc\x88\xae\x5b\x6e\x05\xbc\x12\xa3\xc1\xf2\xec\x28\x9f\x05\x68\x70\x7f\xdd\x9c\x8d\x67\xab\xc9\x23\x58\x8d\x6f\x67\x93\x42\xe7\xc5\x7c\xf6\x5c\x1c\xe3\xca\x4a\x84\x16\xc5\x10\x41\x79\x3b\x13\x4d\x2c\x90\xb0\xbf\x5b\xcc\x97\xab\xc7\xf1\x74\xbe\x2a\xc0\xd0\xba\x1a\xbb\xef\xf0\xb5\x8b\x0c\x87\x95\xa4\xab\x04\xe4\x8e\xcc\xfc\xd7\x41\xb8\x43\xd9\xc2\x3a\x5b\xc6\x5a\x18\x56\x28\x99\x39\x1c\x7d\xb0\x05\xbc\xe0\xa8\xac\xb8\x89\xd3\xb4\x40\x26\xed\xec\x68\x35\x6f\x6a\x83\xae\xbb\x5e\x57\x3e\x1b\x6f\xeb\xb5\x8e\x6f\x99\xb0\x15\x9f\xd5\x9d\xd3\xde\x77\x8b\xd9\xd3\xc3\x1c\x78\x4e\xca\xfc\x7e\xf2\x7e\xfc\x34\x5b\x31\x62\x37\xb8\xe9\x00\xe4\x82\x7b\x0c\x40\x49\x9d\xa1\x1d\x20\xf9\x8b\xdd\x76\xf9\x62\xba\x9c\xfc\xf9\x34\x99\xdf\xf5\x30\x38\x8a\x43\x38\xb5\xeb\xcc\xb9\x04\xc2\xd6\xfb\x98\x88\x32\x4b\xdd\x10\x38\xba\xc8\x4c\x86\x60\xeb\x9b\xa5\x6c\x6c\xc4\x59\x7e\xc6\x46\x9c\xe7\x45\xed\xd4\x95\x70\x46\x35\x5b\x21\x42\xb1\x98\xe8\x48\xde\x4e\x17\xec\xd2\xb8\x7b\x37\x5e\xde\x8d\xef\x27\x54\x31\xd2\xa8\xc6\x22\x41\x31\xad\x68\x22\xa9\xc5\x31\x36\xfa\x34\x26\x65\xb4\x93\x6f\xab\xc9\x7c\x39\x5d\xcc\x8b\x8b\x13\x1e\x09\xd8\x42\xb0\xdb\xec\xd6\xd1\x5f\x9b\x5c\xdd\xbb\x8f\x93\x87\x71\x8d\xdf\xcd\x59\xfa\x5a\x3f\x37\xb7\xf0\x3a\x7f\x06\x56\x28\x33\xb8\xce\xba\xdc\x80\x25\x7a\x27\xde\x9a\xd7\xe0\xf2\x06\x2c\x7e\xfa\x30\x44\xff\x4a\xde\xf6\xef\x1e\x27\xe3\xd5\x24\x47\xce\xf1\xce\xca\x88\x99\x10\x19\xe4\x41\x4e\x2a\x6a\x49\xa3\xf9\x62\x55\xd1\x0a\x7c\x9d\xae\x3e\x1e\x58\x17\x5f\xab\x4b\xec\x8f\x28\x15\x41\xee\x16\x0f\x0f\x93\xf9\xaa\x45\x8c\x94\x00\x2d\x2c\x75\x10\x30\x5d\x82\xd1\xe7\xd9\xbf\xef\xd6\xb8\x0c\xb2\x0b\x03\x1b\x3a\xfb\xd0\xdc\x80\x8d\xe9\xaf\xf7\xe6\x1a\x8e\xaa\x72\x64\x83\x75\x32\x2b\xa4\x78\x65\x23\x10\xed\x7f\x04\x28\x8b\xd0\x4f\xff\x8c\x2d\x56\x1f\xd7\x76\x00\xce\x20\x81\x1b\x84\x00\x3f\xc7\x15\x17\x9c\x63\x82\xc0\x05\xe7\x68\x29\xbd\x00\x3f\xcc\xcd\x1e\xbe\x03\x3b\xd3\x0b\xa3\xc4\x24\x8c\x95\x11\x4c\xe6\x40\xd7\xdc\x6f\x50\x02\x6e\x5a\x1b\x18\xed\x4c\x1b\xe2\x72\xce\xa8\xd2\x9a\xbc\x10\xa2\x77\x9c\x42\x85\xa6\xa4\x7e\x65\x36\x65\xca\x27\x53\xef\xa8\x7a\xee\xf5\xa4\x01\x48\x67\x69\x25\xa3\x38\x3f\x03\xe8\xbf\x2c\x13\x06\xf6\x8b\x19\xa2\x45\x05\x86\x48\xdf\xf0\x15\x59\xe1\x5c\x91\xde\x25\x83\x35\x7f\x9a\xcd\x2e\x52\xda\x24\xa4\xe0\xe4\x9b\x40\xce\x0b\x55\xf2\xad\xf9\xab\x10\xf8\x71\x8d\xcb\xf2\xd6\x9e\x1f\xe7\x0b\x2d\xe0\x2a\x1d\x1c\xd3\xdb\xbc\x1a\x49\x37\x3a\xf1\x36\xf0\xe3\x97\x0e\xe4\x25\x61\x3c\xbf\x4a\x3f\xba\xe4\x47\xd7\xd7\xe8\x09\x44\x8b\x4d\xa3\x5c\xdd\xfa\x15\x45\x64\xed\x79\xf6\xae\xea\xfc\x84\xd8\x3b\xd4\x03\x0a\xb9\xeb\x9b\x7b\x41\xc2\x11\x86\x78\xdd\x7f\x4d\x5e\xd6\x40\xb4\x35\x37\x1b\xba\x1f\x78\x3e\x5a\x1a\x21\x9b\xcf\x20\x07\x60\x21\xfe\x09\xe1\x77\x66\xe4\x8c\x98\x11\x3a\x1f\x6b\x36\xec\x9c\x9a\x11\xdc\xf4\xfd\xbd\xb9\x61\xc4\xce\x88\x19\xa1\xf7\x3b\x14\x03\x93\x32\x18\xc0\x95\x68\xe4\x19\xdb\x1d\xc0\x01\x29\xf9\x13\xfc\x1d\xf8\xb0\xcd\x37\x93\xd4\xa1\xb7\x3b\x26\xb9\x78\xea\x81\x28\x09\xcf\x24\x2d\xcb\x97\x78\x0c\x79\x7a\x31\xbb\x60\x5a\x29\x60\x72\x6e\x2f\x32\x4c\x3f\xf0\x5f\xb7\xc1\x3e\x02\x56\x10\x6c\xa0\xe9\xd3\xf4\xcf\x93\xac\x3c\xe1\xc8\x52\x32\x36\x4b\x1c\x12\xb8\x22\x54\x22\xca\x72\x35\x7e\x5c\xa5\x8b\x23\x9f\x3c\x98\xce\x51\x9f\x64\x39\xbb\x7d\xce\x1e\xcd\x17\xe0\x61\x3a\xff\x32\x9e\x3d\x4d\x0e\x7f\x8f\xbf\x1d\xff\xbe\x1b\xa3\x65\x15\xf0\x5d\xc4\x06\x8b\xaf\xf3\xc9\x3d\x62\x41\x91\x3f\x7d\x85\x22\x8a\x7f\x80\x48\x9f\x5e\xe1\x12\x5a\x59\x80\x42\xd2\xdb\xd7\x79\x0a\xaf\x83\xed\x1e\x84\x16\xf1\xa4\x02\x75\x1c\x7f\xc2\xb8\x63\xa2\x64\xa1\x07\xff\x1d\x05\xbe\x55\x69\x75\x37\x66\x6c\xb8\x90\x3a\x99\xd0\xfa\x62\xe3\x4d\x95\x56\xd2\xba\x17\xd5\xdf\x18\x86\xb9\x52\x0d\xef\xad\xfd\x89\xaa\x40\x4f\xa7\xaa\xe1\x1e\x3d\xeb\xd8\x44\x70\xaf\xea\x2b\x5b\x5f\x1f\xab\xd6\xbc\x0e\x8e\x16\xc3\x5f\x55\x37\x33\x77\xbb\x8d\xd7\x1e\x48\xeb\x23\x5f\x7b\x13\xed\x2b\x69\x15\x88\x32\x27\x5a\xd7\xfb\x8c\xa4\x50\x3b\x6e\x08\xc0\x56\xb2\x81\x99\xac\x4a\x78\x1b\x72\x67\xbe\xe2\x7d\xce\x63\xdc\xcc\x7d\x3f\xc9\x69\x89\x7d\xd3\x45\xaa\x73\xe7\x24\x83\xc5\xb6\x4e\xca\xc1\xe9\x94\x6d\x36\x6e\x5e\x13\x18\x6a\xdb\x0c\x27\x33\x6d\xc5\xe2\x46\x93\xa9\xeb\x25\x90\x26\xca\x7f\x25\x1b\x08\xff\x6a\x30\x76\xcb\x38\x38\x30\x46\x59\x10\xd5\x0e\x79\x21\x65\xa8\x1d\x32\x9c\xcc\x0e\xf9\x96\x64\x83\x6c\x85\x7d\x42\xa6\x05\x98\xb4\x45\xd9\xe6\xa6\xc5\x6a\x58\x32\x10\x07\x39\x9a\x82\xf4\x71\x20\xd8\xe8\x0f\xfb\x84\x95\x79\x8d\x5f\x3a\xea\x39\x52\xd6\x27\x84\xe4\xac\xaa\xd4\x89\x92\x81\x11\x68\x0f\xae\x93\xfd\x59\xd9\x42\xad\xe9\xc2\x57\x9d\x28\x40\x6f\xa7\x48\x6f\x0f\x05\x33\xa2\x0f\xa2\x95\xcb\xd8\xa1\x19\x48\x6e\xc5\x9f\x41\x24\x8b\x5b\x43\x3c\xc0\xcd\x28\xae\xc0\xf0\x47\x13\x09\x7e\x15\x8a\x7f\x19\x38\x57\x88\xbc\xbf\xeb\x54\xcd\xde\xdb\x50\x42\x1c\xea\xcc\x0d\x75\xea\x43\xf8\x24\xab\xc1\x3e\xa9\xe9\x61\xa2\xab\xca\xa7\xc9\x11\x98\x78\xbc\x75\xde\xd0\x4b\xd1\x9e\xb9\x04\x13\xaf\x63\x7e\xd1\x4e\x4e\xc8\x39\x08\x05\xf6\x93\xf9\x26\x6d\x39\x2f\x7f\x97\xd2\xb0\xe4\xe3\xfc\xc4\xce\x6a\x31\x78\xa1\x19\xb8\xce\xa4\x8f\xa2\x60\x8f\x52\xde\xdc\xbb\x1b\x22\xfc\xa1\xe2\x31\xba\xbe\xae\x51\x94\xe7\x41\xc9\x0e\x59\xc9\xfb\x0c\x2b\xef\x23\x23\xe3\x2e\xb8\xff\xb9\x58\x79\xc5\x4b\xcb\x78\x28\x27\xc3\x7f\x7c\x7e\x9c\x3e\x8c\x1f\x9f\xc1\xa7\xc9\xf3\x39\xee\xf5\xae\x79\x82\x35\x6e\xa5\x0c\x1d\xb9\xc6\x9d\x35\xc6\xb8\xc2\x32\xa0\x43\x22\x0b\x6d\x23\xea\x34\xb1\x85\xc2\xe5\x9f\x8a\x2e\x1d\x95\x1d\x18\x5f\x28\xdc\xea\x11\xa6\xa9\x43\x4b\x8c\x29\x6d\x3e\x9e\xd0\x57\x73\xff\x2c\x8a\xc4\x9c\xb9\x65\x09\x1b\x25\x1f\x64\x0d\x43\xed\x11\x85\x48\x7b\x64\xdd\x9c\xda\x98\x8d\x53\xaf\x29\x2d\xfc\x7f\x49\xec\x50\x8a\x04\xfd\x1f\x70\x83\x84\x22\xbd\x6b\xa2\x66\x94\x66\xed\x37\x71\x43\xe3\x16\x66\xf1\xb0\xde\x84\xad\xd0\xd4\x1c\x79\x6b\xdf\x8c\xf7\x08\x9a\x60\x76\x5d\x79\xf7\x9f\xff\x75\x0c\xe5\xff\xf3\xbf\xa4\x60\x8e\x28\x2a\xf9\x1e\xdc\x06\xe9\x2b\x64\x3d\xf0\x1f\xb0\x7c\x64\x86\xd6\xa5\xe1\x88\x55\x87\xc9\x34\x43\xe6\x34\x2c\x34\x70\x4e\x84\x47\x4e\x43\x0e\xbc\x26\xbc\x6f\xa3\x29\x95\x4d\x97\x7c\xb3\x9f\x65\x8e\xa7\xf3\x25\xf9\x38\x83\xfc\xf9\x00\xde\xcc\xc9\xb5\xf1\x91\x5d\x7f\x98\x9b\xf3\x51\xb1\x22\x86\xb4\x0b\xe1\xda\xde\xa0\x67\xa7\x97\xa9\xe5\xc3\x08\xa2\x60\xb5\xaa\xca\x9b\x4a\xd7\xf1\x83\x10\xa2\xc4\x4c\xb9\xdb\x3f\xa2\x05\xf3\x27\x33\xad\x7a\x50\xd6\x08\xb2\x26\xf7\x38\xc9\xc1\xdb\x94\xd4\x4d\x41\x70\x3f\x5e\x8d\x29\x1a\x52\x50\x1b\x36\x9b\x86\x20\xd7\xb6\x0a\x58\xc0\xa6\xf3\xe5\x04\xe5\x07\xd3\xf9\x6a\x91\xcd\xbd\x64\xd9\x5f\x82\x73\xfe\x02\xa0\x9f\xd1\xd3\xf8\xe3\x08\xfd\xfa\x30\xfe\x3a\xbd\x55\x27\xab\xe7\x0f\xcb\xaf\x4f\xb3\x85\xf4\xe5\x56\xbd\x57\x96\x92\xf0\x3c\xfb\xfc\x61\x7a\xa7\xae\x9e\xd5\x67\x61\xb9\xfc\xe3\xd3\x97\xc5\xea\xe1\xcf\x6f\x5f\xe4\xd5\x74\xf6\xfc\xf5\xf6\x69\x8c\xfa\x26\x05\x26\x64\xe7\x66\x56\x42\xca\x6a\x3c\x9c\x57\x1c\xee\x61\xa7\x4d\x04\xec\x47\x14\x13\x2d\x27\xb3\xc9\xdd\xaa\xb0\xf7\x7c\x85\xe0\xea\x11\xe8\x02\xc8\x35\xfe\x95\x21\x6a\xa8\xca\x77\x19\x74\xd6\x7a\xf0\x10\xb5\xea\xf1\x2b\x19\x9f\x7c\x1c\x1b\x94\x6b\xab\x09\x77\xf5\xc4\x6a\x5d\x38\x77\x94\x11\x6f\x78\xbe\x17\x7b\xe6\xc6\x88\x12\xac\xab\xe8\xaf\x0d\x76\x19\x81\xe3\x95\x4b\x4e\xbb\x14\x74\xc0\xeb\xd7\xb2\x7a\xcd\xcb\x57\xbc\x22\x4b\x82\xf2\x6f\x9c\x38\xaa\x38\x5f\x23\xba\x60\xa4\xdf\x75\x97\x42\x86\x85\xc2\x49\xe0\x39\x6d\x9c\x44\x4e\x93\x05\xad\x0b\x27\xd1\x30\xd7\x6b\x14\x83\x50\xfe\x62\xc0\x5f\x3b\xe8\x47\x30\x32\x90\x2d\x0f\xf5\xe5\x56\x76\x9a\xa2\x48\x7c\x17\x76\xaa\x51\x8e\x66\x6d\xe8\x12\xaf\xea\x5c\x27\x65\xb4\x0a\xba\x11\xff\x0c\x8c\x9f\xe6\x6b\x1b\x17\x59\x50\xd1\xff\x5d\xb8\xe8\x06\x9f\xd5\xa3\xdb\x70\x15\x81\x17\x04\xb5\x1b\x6e\x61\xab\xa3\x05\x59\xe3\x55\x49\xcd\xad\xde\x30\x07\x5a\xb7\x1b\xba\x4e\x82\xda\x96\x43\x21\x32\x0f\x89\x91\x4a\x36\x95\x0f\xbf\x70\x06\x58\xb1\x56\x23\x6f\x01\xf3\xbe\x5b\xc8\xb7\xff\xb1\x92\xbf\x88\x73\x71\xf9\x49\xb8\xbb\x97\x9f\x3e\xdd\xa3\xc8\xf3\xc7\xed\xf3\xfb\xe5\xf4\xe1\xf9\xfe\x8b\x70\xab\xca\xcb\xd9\xa7\xaf\x93\x6f\xb3\xc7\xe7\xf7\xf2\x87\xf9\xe2\xf1\xf9\xee\x43\x0b\x6f\x8a\x3d\x49\x3b\x0c\x03\x96\xca\xb6\x82\x7d\xdf\x51\xca\x8b\xf6\xc5\x41\xe2\x38\x4e\x57\x78\xd5\x52\x1d\x4b\x56\x4c\x87\x73\x39\xd7\xd2\x55\xd5\x56\x74\x91\x83\xba\xab\x98\xa2\x65\xda\x8e\xa4\xe9\x0e\xaf\x49\x92\xac\x42\xcd\x75\x54\xd3\xe6\x64\xd4\x24\xe8\xbc\x3c\x4a\xed\x73\x01\xb8\xe4\x67\xc4\xeb\x2a\x77\xc9\xf1\xe8\x07\x70\xdc\x75\xf2\x53\xf5\x56\x05\x7b\xab\xc0\x5d\x71\x9a\xca\x2b\x1a\xb5\x55\x12\x74\x49\x57\x54\x41\x47\x03\xa3\xe5\x7c\xd2\x1f\x9e\xe3\x1a\x9c\xa2\xaa\x2a\xf6\x09\xcd\xd5\x04\x68\xf2\x82\x0e\x55\x55\xb6\xa1\xac\x59\xd0\x31\xa1\xa6\x39\x96\x6d\x73\xa2\xab\x70\xba\xab\x99\xaa\x6c\x72\x92\x25\x08\xba\xae\x58\x82\x26\xd8\xba\x28\x09\x9a\xc9\x3b\x92\xe0\x8e\x4e\x63\xae\xcc\x50\xa9\xce\xea\x25\xcf\x03\x5e\xbc\x96\xb5\x6b\xa1\xd1\x14\xbc\xc6\xe9\xa2\x4e\x6d\xd5\x64\x4d\x47\xe2\xca\xba\x50\x33\x94\xcc\x6a\x27\x11\x31\x41\x1a\x5b\x22\x52\xc9\xb2\x45\x17\xba\x9c\x2a\x71\x8a\x2c\xcb\x9a\xed\x9a\x26\x7a\xae\x2a\x9a\xa0\x70\x12\xa7\xeb\x28\x8c\x21\xeb\x49\xae\xcb\x5b\x22\x27\xab\xb2\xae\xc8\x50\x74\x52\x35\x4e\x60\xeb\x26\x3b\x89\x62\x93\x25\x04\x9d\x13\xb9\x46\x3b\x1d\x5a\x79\x01\x49\xad\x73\xbc\xa6\x69\xfd\x0d\x25\x21\x2e\xba\xa3\xa8\xaa\xe6\x0a\x8e\x2e\x22\x7b\xe1\x61\x40\x66\x70\x55\xc7\xd5\x44\x87\x17\x1d\x59\x70\x38\x64\x35\xc8\x59\xa6\x28\x42\x9e\x57\x90\x0b\xbb\x9c\xe4\x28\x50\x17\x5d\x1e\x75\x1e\x9d\xc6\xd8\x8d\x86\x6a\x74\x28\x51\xd1\x24\x86\x56\x5e\x45\xeb\xac\xa6\xe8\xc8\x95\xfb\x1b\x0a\x65\x9c\x23\x4b\xe1\x35\x5b\xd2\x6d\xcb\x56\x5c\x51\x80\x96\xc8\x0b\xaa\xe5\x58\xbc\x2b\xb8\x50\x14\x4c\x59\xe2\x24\x57\x17\x55\xc1\x76\x2d\xa8\xe8\xaa\x2c\x29\x9c\x60\x5b\x50\x50\x24\xa8\xcb\xb6\x24\x8c\x4e\x63\xec\x26\x43\x49\x8d\x1e\x25\x21\x96\xbc\x44\x6d\x15\x78\x49\x95\x34\x51\x91\x34\x8e\x6c\x28\x4a\x90\x67\xd8\xd7\xea\x9e\x80\xf7\xdb\x58\x19\x92\x94\xb3\xbd\xa2\xb3\x24\xea\x94\x8d\x94\x13\xac\xab\x4c\x65\xff\xfe\x46\xef\x5a\x6f\x3e\x85\xd9\x69\x15\x85\x2e\x86\x6f\xac\x2e\x77\x37\x09\xe9\x6c\xd2\xe1\xdb\xed\xfc\x2c\x53\xe7\x1a\x5c\x09\x34\x29\xff\x8d\xef\xef\x8b\x87\xa3\x08\x6c\x8b\xdb\x42\xe0\x3c\xfb\xfe\xe5\xa2\xf0\x31\x2b\xc3\x77\xb7\x27\x96\xff\x08\xdc\xa6\x43\x85\x3d\x55\x8f\x8b\xfa\x17\xb7\x0d\x15\x87\x13\x69\x83\xb1\x88\x0a\x1c\x98\x94\x65\xf6\x9c\xb6\x0f\xe0\x4e\x23\xd4\x11\x90\x24\x59\x85\x1d\x55\x3c\xe2\x81\xc6\xc1\x32\x56\x50\x49\x82\x92\x18\x53\xa5\x65\x39\xef\x39\x58\xf8\x76\x26\x24\x5d\x18\xc4\x62\x56\xad\xfd\x30\xed\xc9\x94\x6b\x62\xd3\xa6\x5e\xab\x68\x54\x05\x29\x47\x95\x33\xcd\x92\x73\xce\x6c\x5b\x7d\xe9\x91\xe8\x76\x58\x7c\x3a\x86\x70\x32\xe0\x69\x39\x9d\x7f\x00\x56\x1c\x42\x78\x08\x34\xe4\x48\x42\x38\x90\xdd\x5d\xd2\xa7\xf9\x14\xad\x87\xb9\xc0\x64\xd8\x44\xd2\xa4\x34\x5b\x12\x2e\x0d\x7b\x29\xdd\x05\x20\x46\xbc\xc2\x01\xf3\xbe\x46\x3c\x42\x60\x31\x88\xbb\xa7\x65\x93\xa5\xc4\x17\xb5\xed\x49\x92\x70\xc9\x11\xf9\x01\x92\x25\xbb\xb4\x4c\x62\x55\xf7\x76\x49\xd2\x64\xe7\xfa\x07\xc8\x93\x22\xb0\x49\x54\xd9\x38\xbe\xa8\xef\x11\xb7\x2d\x18\x27\x18\x59\x22\x1a\x96\xbd\xb0\xb3\x56\x92\xf8\xfc\xfc\xf8\xbd\xf8\xe5\xef\xbf\x83\x11\xbe\x28\x68\x74\x7d\x8d\xf7\x54\xdf\xbd\xbb\x00\xb5\xf6\x38\x38\xb4\xb2\xe9\xd2\x77\x16\xb5\x28\x74\x98\x41\xcd\x5a\x91\xd4\x4a\xba\x1d\xa4\x3f\x1c\x58\x4a\xb4\xac\xab\xd9\x44\x4d\xd3\xba\xb8\x39\x34\x54\xdd\x24\x40\x74\x19\xbd\x34\x53\x29\x49\x4e\x18\xc3\x63\x8a\x45\xa7\x4a\x63\x11\xeb\x98\xf7\x9c\xfc\xa5\x88\x59\x47\x6c\x33\x41\x7e\x26\x82\xb8\xc2\x16\x6f\x33\x19\x28\x55\x05\xae\x18\x0f\xf2\xaf\xa9\x4b\x72\x91\xbe\xab\xbc\xc8\x3f\x8c\x6e\x12\xf6\xb8\xb9\x3b\x50\x4c\xcf\x61\x16\xf0\xf8\xd1\xd5\x05\xf1\x63\x50\x8a\xd0\xf9\x05\x34\xa7\x90\x3b\xc3\x2a\x8a\xde\xb0\xd7\xde\x4b\x13\xb2\x02\xf9\x5d\x3b\xa7\x50\x20\xc3\x6a\x58\x2c\x7a\xaa\x50\xfe\x82\xae\xae\x44\xe1\x66\xa1\xbe\x61\xa7\x80\xd1\xd7\xf8\xed\x86\xae\x5c\x95\x34\xd4\xd6\x65\xb8\xa2\xc8\x79\xdd\xae\x24\x23\x59\xa2\xfa\x75\x4f\xc3\xc5\xaa\x61\xb2\xe5\x0d\x24\x01\x0b\x17\x57\xf5\x1e\xd6\x23\x46\x7f\x97\xa4\xb8\x1f\xfd\x7e\xae\x81\x56\xa5\x32\x28\xaa\x76\xd8\x45\x63\x4a\xf9\x5b\x6f\x25\x7b\x33\xb1\xcb\x83\x41\x96\x98\xdd\xd0\xc5\x2b\xd8\xfa\xfa\x09\x1d\x9a\x49\x62\xf0\xf5\xe3\xe4\x71\x82\x12\x89\xa6\xd3\x54\xbf\xa5\x5f\x6d\x80\xc5\x23\x38\x6f\x3c\x35\x95\x11\x51\xf4\xaf\xde\x5e\x77\x1a\xd5\x2b\xa8\xd4\x35\x94\xf8\x82\xc6\x70\x4d\xdf\x69\xa4\x25\x41\x53\x63\xe1\x81\x92\x5d\xee\x53\x4f\x86\x12\x74\x9f\xe0\xcd\x7e\x11\xe3\xc9\x0d\x5d\x3b\xa7\x44\x15\xbf\xd2\x81\x5d\x99\xe2\xbd\x94\x6f\x65\xff\xe2\xd1\x34\x9a\x26\x05\x5a\x76\x25\x88\xf7\x74\xbe\x95\x36\xc4\x13\x77\x34\xb5\x48\x9d\xd8\xf5\x3b\x5c\x63\xfa\x56\x3a\x1d\xbe\x04\xa7\xe9\xd1\x58\x93\xa1\x5c\xdf\x7a\x52\xc1\xab\xe8\xc4\x6c\xb2\xeb\x04\x6f\xbd\xb9\xf6\x34\x33\xbc\x8d\x05\x8b\x0e\x9d\x92\x24\xc2\x3d\xbe\x6f\xa2\x45\x65\x05\x6b\x94\x9d\xbe\x88\x11\xee\x2d\x3e\xa9\xdb\xd4\xf1\x7b\xe7\xcd\x6d\x37\x35\xf7\xb5\x72\x0b\x26\x35\x45\x38\x3f\xcf\xcf\x9a\x25\x45\x95\x28\xd8\x64\x87\xbd\xeb\x55\x9a\x26\xc2\x5a\xa1\xa6\x89\xb0\x52\xab\xa9\x91\x5a\xc1\x7e\xfd\x12\x33\xb1\x2f\x91\xb6\x0b\x50\x22\xad\x96\x8b\xf2\x9c\x30\x71\xc6\xdf\x80\x28\x16\x06\xac\xe9\xea\x72\x5c\xeb\xd9\x6d\x60\x0c\x93\x91\xf8\xbf\x00\x00\x00\xff\xff\x5a\x7c\xe7\x6f\xe7\x5c\x00\x00")
+var _baseHorizonSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xd4\x7d\x6d\x6f\x9b\xca\xb6\xff\xfb\x7c\x8a\xd1\x79\xe3\x44\x7f\xa7\x7f\xc0\x36\x0f\x89\x7a\xa4\x34\x71\xbb\x73\x9a\x3a\xdd\xb1\xd3\x36\x77\x6b\x0b\x0d\x30\x38\x9c\x62\x60\xc3\xb8\x8d\xcf\xd5\xfd\xee\x57\x83\x07\x18\x60\x80\x01\x3b\xdd\xba\x52\xb5\xb7\xe2\x59\x0f\xbf\xb5\x66\xad\x35\x0f\xcc\xc0\xf9\xf9\xc9\xf9\x39\xf8\x1c\x26\x78\x1d\xa3\xe5\xef\x77\xc0\x81\x18\x5a\x30\x41\xc0\xd9\x6e\xa2\x93\xf3\xf3\x13\xd2\x7e\xb3\xdd\x44\xc8\x01\x6e\x1c\x6e\x0a\x82\x1f\x28\x4e\xbc\x30\x00\xc6\x9b\xd9\x1b\x89\xa1\xb2\x76\x20\x5a\x9b\x84\xbd\x42\x72\xb2\x9c\xaf\x40\x82\x21\x46\x1b\x14\x60\x13\x7b\x1b\x14\x6e\x31\x78\x0b\xa4\xcb\xb4\xc9\x0f\xed\xef\xf5\x5f\x6d\xdf\x23\xd4\x28\xb0\x43\xc7\x0b\xd6\xe0\x2d\x18\x3d\xae\xde\xeb\xa3\xcb\x4c\x5c\xe0\xc0\xd8\x31\xed\x30\x70\xc3\x78\xe3\x05\x6b\x33\xc1\xb1\x17\xac\x13\xf0\x16\x84\x01\x95\xf1\x8c\xec\xef\xa6\xbb\x0d\x6c\xec\x85\x81\x69\x85\x8e\x87\x48\xbb\x0b\xfd\x04\x95\xd4\x6c\xbc\xc0\xdc\xa0\x24\x81\xeb\x94\xe0\x27\x8c\x03\x2f\x58\x5f\x9e\xa4\x34\x09\x82\xb1\xfd\x6c\x46\x10\x3f\x83\xb7\x20\xda\x5a\xbe\x67\x8f\x89\xb1\x36\xc4\xd0\x0f\x09\xd9\xcd\xc3\xfd\x67\x70\xbb\xb8\x99\x7f\x03\xb7\xef\xc1\xfc\xdb\xed\x72\xb5\xa4\x94\x6f\x70\x0c\x1d\x64\x22\xd7\x45\x36\x4e\x4c\x6b\x67\x86\xb1\x83\x62\xd3\x0a\xc3\xef\x97\xad\x8c\x5e\xe0\xa0\x17\xf3\xd9\x4b\x70\x18\xef\x4c\x1c\xc3\x20\x81\xa9\x25\x89\x19\x06\xa6\xe7\xf4\xe1\x0e\x23\x14\xc3\x9c\x17\xef\x22\x74\x00\x77\x81\xe4\x20\x14\xfd\x78\x7d\xe4\xac\x51\x9c\x32\x26\xe8\xaf\x2d\x0a\x6c\x34\x90\x3d\x8a\xd1\x0f\x2f\xdc\x26\xf4\x37\xf3\x19\x26\xcf\x03\x45\x1d\x2e\xc1\xdb\x44\x61\x8c\x51\x6c\xd2\xa4\x19\x2a\x66\xa8\x2f\x6d\x3f\x4c\x90\x63\x42\xdc\x87\x3f\x0b\xe6\x01\xa1\x04\x6d\x3b\xdc\x06\x38\x31\x7f\x7a\xf8\x99\x04\xb5\x87\x93\x41\xfc\xbd\x8d\x66\x39\xa1\xe3\xc4\x28\xe9\x50\xfc\x8c\x23\x92\xae\xcf\xb8\x4b\xcf\x73\x52\xca\x09\x6b\xd7\x89\xec\x39\x0f\x3e\x11\xe2\x70\x8f\x23\xec\x24\xf4\x12\x6c\xe2\x17\x33\xea\x16\x49\x28\xc3\x48\x94\x12\x89\x92\x65\xd5\xad\x9d\xd8\x0e\x37\x1b\x2f\x49\xa8\xaf\xba\x93\xa7\x4c\x0f\x93\x04\xe1\x5e\x0c\xfb\x8e\x17\x08\x55\x2e\x5f\x3b\x8b\x95\x65\x53\x27\x59\xb7\x9d\xa2\x3a\x53\x0f\x24\xa6\x1d\x3a\xc8\xf4\x92\x64\x8b\x62\x01\xdb\xa8\x64\x93\x8c\xc4\x5e\x82\x3d\x3b\xc9\xb2\xc0\xf4\x9c\x97\xcb\x93\xab\xbb\xd5\xfc\x01\xac\xae\xde\xdd\xcd\x19\xe6\xfb\xc5\xdd\x53\x26\x81\x33\x12\x99\x11\x8c\xb1\x67\x7b\x11\x0c\x70\x02\x52\xcc\xd7\xf7\x8b\xe5\xea\xe1\xea\x76\xb1\x62\xc4\x74\xb1\x9a\xd1\x77\xb4\xeb\x83\x21\x1f\x49\xfa\x22\xe0\x33\x0a\xeb\x5f\x87\x71\x64\x6e\xbc\x35\x1d\xc6\x5a\x14\x56\x28\x85\x35\x14\x31\xd8\x22\x9c\x09\x54\x51\xb9\x69\xd0\xb4\x88\x4c\xdb\x85\x51\xd6\xa3\xa9\x4d\x74\x3d\xf4\xfa\xea\xf1\xbd\x8d\x87\x45\x74\xec\x09\x5b\xe5\x57\x42\xa9\x31\x9c\xf7\xe8\xae\xef\xef\x1e\x3f\x2d\x80\xe7\xec\x95\xdf\xcc\xdf\x5f\x3d\xde\xad\x04\x65\x37\x84\xe9\x01\x92\x99\xf0\x38\x40\x4a\xda\xd9\x1d\x02\xd2\xbf\x3a\xec\x63\x7c\x97\x0d\xa6\xcb\xf9\xef\x8f\xf3\xc5\xf5\x00\x87\x9b\x9e\x63\x26\xe8\xaf\xde\x9a\x4b\x42\xc4\xb8\xf3\x7e\x11\x47\xcd\xef\xca\x5e\x98\xf9\x22\xc4\x78\xe9\x94\x4d\x8c\x98\xce\xcf\xc4\x88\x69\xe6\x74\xe0\xa8\x94\xb3\x76\x62\xb8\x75\x3c\x6c\xa6\x4b\xa3\x36\x32\xa6\x1e\x10\x28\x70\x8d\x3a\x7b\xa3\xce\x92\x4d\x9c\xbb\xbb\x01\xbf\x24\x5b\x8b\xac\x41\x02\x33\xd9\x5a\x34\x8b\x92\x4e\x95\x3f\x91\xf5\x1c\x86\xdf\x4d\x07\x41\xc7\xf4\x11\xc6\x28\x16\xeb\x76\x1e\x63\x0f\x6d\xbe\xf7\x03\xc5\x1e\xea\xab\x2b\x63\x13\xd6\x94\x6c\xad\xc4\x8e\xbd\x28\xed\xd7\x5e\xca\x4a\x9c\x9d\xfa\x98\x31\x4b\x44\x49\x41\xde\x4e\x17\xee\x81\x83\xeb\xab\xe5\xf5\xd5\xcd\xbc\x13\x46\x5a\xfa\x84\xcc\x64\x27\x9a\x4d\x24\x74\xd4\x29\xc2\x52\x8c\x9e\x4c\xd7\xe8\x98\x26\xc6\x50\xa2\x9d\x7f\x5b\xcd\x17\xcb\xdb\xfb\x05\x43\xff\x4c\x32\x08\xb5\x10\x44\x7e\xb4\x4e\xfe\xf2\x29\xc5\xf2\xfa\xb7\xf9\xa7\x2b\xb6\x39\xd5\x77\x49\xb6\x7e\xce\xcf\xc1\x02\x6e\xd0\x45\xf6\x1b\x58\xed\x22\x74\x41\x59\x2e\xc1\xd2\x7e\x46\x1b\x78\x01\xce\x2f\xc1\xfd\xcf\x00\xc5\x17\x80\xb0\x9c\x9c\x5c\x3f\xcc\xaf\x56\x73\x4a\x96\xcb\x3b\x29\x4b\xa4\x20\xa8\xc8\x1c\x67\xa7\xd4\x92\x45\x8b\xfb\x55\xc5\x2a\xf0\xf5\x76\xf5\x5b\xae\x9a\xdd\x99\x29\xa9\x2f\xa4\x54\x80\x5c\xdf\x7f\xfa\x34\x5f\xac\x5a\x60\xec\x09\xc0\xfd\xa2\x2e\x04\xdc\x2e\xc1\xe8\xf3\xdd\xff\x8f\xd6\x64\x27\x2d\x8a\x43\x1b\x39\xdb\x18\xfa\xc0\x87\xc1\x7a\x0b\xd7\x68\x54\xc5\x41\x3b\xeb\x68\x5e\xd8\xcb\x2b\x3b\x81\xeb\xff\x42\x40\x19\xc2\x30\xfb\xa9\x5a\x62\x3e\xd9\x1e\x04\x24\xaa\x81\x1b\xc6\x80\xfc\x4e\x36\xed\xc8\x32\x05\x84\x2e\x38\xfd\x8e\x76\x63\xf0\x03\xfa\x5b\x74\x06\x22\xe8\xc5\x49\xea\x12\xc1\xcd\x35\x42\xe6\x20\x17\x6e\x7d\x6c\x62\x68\xf9\x28\x89\xa0\x8d\xc8\x8e\xe0\xa8\xd2\x9a\xee\x29\x84\x9e\xc3\x6c\xf2\x95\xcc\xaf\x64\x13\x35\x3e\xcd\xed\xc2\x74\xea\x39\x6e\x07\xa4\xa4\x95\xb9\x26\x38\x3d\x01\x00\x00\xba\x98\x02\xf6\x33\x8c\xa1\x8d\x51\x0c\x7e\xc0\x78\xe7\x05\xeb\x53\x75\x7a\x96\x76\xd6\xe2\xf1\xee\x6e\xbc\xa7\x25\x95\x25\x5d\xbf\x71\xc8\x65\xa5\x4a\xbe\x81\x2f\xcc\xf4\x83\x6c\x9e\x5a\xde\xda\x0b\x70\x36\x57\x03\x52\x85\xc1\x81\x9e\xbf\x33\x53\xb6\x6e\xe2\x4d\x18\xe0\xe7\x1e\xe4\x25\x30\x5e\x50\xa5\x1f\x9d\xcb\xa3\x8b\x0b\x2f\xc0\x68\x8d\xe2\x46\x5c\xfd\xf8\x58\x88\xfd\x38\xd3\xfe\x46\x31\x99\x6f\xed\xd2\x45\x32\x48\x36\xd0\xf7\x45\xd9\x7f\x22\xf4\xbd\xd9\x35\x6d\x9c\x30\x08\xb6\xd0\x1f\xc2\xc9\xe8\xf4\x82\x81\x2a\x45\x19\x4f\xce\x2e\x4f\xf8\x29\xc2\x8c\x68\x87\xa6\x49\x21\xea\xf5\x53\x45\xa0\xbf\xab\x01\xbd\x4f\x16\x2f\xb0\xc3\x0d\xea\x0a\xfe\x3d\x6d\xb8\xc5\x22\xc4\xb4\x23\xbd\xa0\x07\xb1\xa0\xe8\x2c\x21\xbc\xa0\x0f\xb5\xa0\x70\x1a\x47\x5e\xd0\x83\x58\x50\xf4\x36\x72\x20\x4e\xb7\x9b\x01\x79\xe2\x93\x60\xb8\x89\x00\xa9\xda\xe9\x9f\xe0\x3f\x61\x80\x04\x62\x93\x9d\x3d\x1d\x1a\x9c\x8c\xac\x2c\x3a\x99\x16\xd0\x90\x69\x47\xae\xdf\x62\x35\xb3\x27\x63\xd6\xeb\x43\x58\xff\x4f\xd5\x78\x9a\x3a\x03\xec\xa4\xc1\x3b\x80\x93\xd1\xe9\x05\x03\x55\xfe\xd2\x91\xec\xf3\xc3\xed\xa7\xab\x87\x27\xf0\x71\xfe\x74\xca\x46\xf8\x98\x09\xe6\x71\x5d\xc9\x19\x27\x0b\x09\xfd\xf0\xbc\x23\xdc\x34\xd3\x3c\x27\x73\x40\x19\x6b\x4b\xe6\x09\xe7\xdc\x7e\x5f\x5c\x68\x88\xf1\x12\x13\x06\x61\xb0\xdb\x84\xdb\x04\x58\x61\xe8\x23\x18\xb4\x55\x21\x76\x01\x49\xdd\x90\x2d\x37\xc5\x3c\x91\x2f\x4e\x59\x51\x29\x94\xe5\xea\xea\x61\xb5\x9f\xc7\xcb\xe9\x0f\xb7\x8b\xeb\x87\x79\x3a\xf3\x7e\xf7\x44\x7f\x5a\xdc\x83\x4f\xb7\x8b\x2f\x57\x77\x8f\xf3\xfc\xef\xab\x6f\xc5\xdf\xd7\x57\xd7\xbf\xcd\x81\xdc\x07\x36\xb8\xff\xba\x98\xdf\x80\x77\x4f\x1d\xf8\xf7\x1b\x86\x5c\xf8\xb9\x88\xfd\xaf\x6f\x3c\xa7\x06\x20\xdf\x14\x1a\x1c\x3b\x99\x84\x8e\xf8\x81\x36\x0e\xc5\xba\x3e\xd9\x5a\xff\x46\x36\x16\xa2\xdd\xef\x58\x0a\x91\x6e\x10\x86\xe0\xdf\x49\x18\x58\x45\x6e\xfe\xf7\xff\x8c\x2e\x2e\xf6\xbf\x95\x89\xed\x18\x75\x8e\x89\x99\x94\x20\xfc\x79\x5a\x55\x86\x5f\xd2\xe7\xcf\x0d\xc0\x72\xfd\xa3\x8b\x8b\x1a\x45\x45\x92\x85\xdc\x30\x46\xe9\x36\x3d\xaa\xc2\x0f\xb6\xbe\xdf\x60\x00\x74\xc9\xf3\x6b\x71\xae\x93\xb3\xc6\xd0\x68\x88\x4f\xb1\x20\x29\xe2\xb2\x22\xee\xd5\x53\xab\x03\xfe\xd0\xf4\xaa\x88\x65\x52\x2c\x6b\xe1\xa4\x99\xe7\x64\xea\x69\x2f\x08\x29\xdd\x27\x58\xfa\xe4\x25\x57\x5b\x7b\x3e\x40\x96\xda\x59\xd7\x06\xe8\x05\xff\x80\xfe\xe9\xa8\x8a\x72\x74\x71\x11\xa3\xb5\xed\xc3\x24\x69\xe9\xe7\xf4\xf9\x0c\xc5\x59\x3c\xcf\x19\x0c\x35\xed\x9d\xab\x9b\x1b\xf6\xd9\x50\x59\x19\x3b\x00\x82\x53\xcf\x69\xc1\x96\x3e\x86\xc5\x61\x9c\xf9\x31\x7d\xa0\x2a\x16\x82\x29\x29\x47\x14\xd9\x37\xc9\x7f\x05\x8f\xcb\xdb\xc5\x07\x60\xe1\x18\x21\x70\x9a\xb6\xb7\xa3\xa1\x55\xea\x38\x78\xb2\x92\xd7\x8c\x88\x52\x8c\x69\xc5\x6b\xc7\x56\x54\xaf\xe3\xc0\x63\xaa\x61\x33\xc2\x82\xa8\x1d\x1c\x2d\x8d\xc7\x41\x96\xd5\xd9\x66\x58\x94\xe2\xac\xb2\xeb\xd4\xf1\xcc\xa2\x52\x2c\xc4\xf0\xe5\x45\xa2\x5d\xf8\x6b\x57\xbe\xba\xf6\xc1\x83\x7b\x5d\x14\x1d\xe5\x03\xb8\xe1\x4d\xf9\x94\xd9\xac\x3a\x12\xba\x1e\xf2\x9d\x44\x78\xe0\xa5\x7e\xe2\xcf\x21\xd0\x4b\xe4\xc5\x28\x69\x1b\x95\x4f\xce\xba\xdd\x71\xa4\x42\x57\x17\xcc\xab\x78\x0d\xea\xcb\xa5\x8f\xb8\x53\x04\xb8\xb5\x33\x0b\x1f\x0c\xce\xa0\x2e\xc1\xa4\x30\xd6\x69\xca\x69\x55\x50\xd7\x70\x37\x3c\xd2\x1b\x1a\x83\x7c\x71\x34\x0e\xcb\x93\x2c\xde\x04\xb1\xc7\xb6\x56\x76\x84\x91\x1f\x7c\xe9\xe3\x49\xdc\x35\x29\x6c\x9e\x4f\xf1\xed\x38\x52\x2c\xf2\x85\xf3\xe2\xb1\x05\x46\x39\x26\xb3\xaa\x29\x62\x84\xb5\xcb\x4f\xef\x0d\x0d\xca\x4e\xc9\x24\x2a\xf9\x44\xe5\xc8\xa4\xe4\x3d\xa0\xb3\x3d\x3b\x38\xa9\xc4\xc4\x8b\x1a\xc1\xf2\x54\x87\x2e\xfe\x93\xde\xa1\xe9\xc5\x95\xd6\xb1\x96\xdb\xc6\x3e\x27\xa1\x64\x49\xe1\xa4\x94\x1d\x23\x2c\x36\x52\xd0\x1d\x10\x0e\xb1\x3a\x3d\xa3\x03\xc0\x0f\x44\xb7\x48\x12\x31\x99\xcc\xcc\xa5\x31\x65\xf9\xeb\xb8\x7a\x02\x73\x3d\x45\x27\xd8\x07\x4e\x18\xda\x44\xbf\xf6\x74\xa1\x8f\x59\x03\x17\x4d\x6d\x2a\x8a\x05\x14\x97\xea\x35\x16\x53\x5c\x45\x62\x0b\xab\x36\x4b\xda\x16\x59\x7c\xbe\xe3\xd4\x7e\xae\x6c\x5e\xe9\x6f\x06\xd1\xb9\x10\xe3\x1c\x65\x39\xb4\xe0\x14\xa2\x3a\xaa\x0d\x0b\xd7\x6c\x22\xda\x57\x06\xcf\xe1\x94\x05\x59\xd1\xab\x65\xa1\xa8\x23\x1c\xfa\x49\x6d\xdf\x32\x82\x3b\x3f\x84\x0e\xc0\xe8\xa5\x06\x0e\x43\xbc\xe5\x15\x23\x59\x65\xf6\x79\x22\x14\x90\x0b\x33\x02\xdb\x3d\x10\x63\xb4\x89\x70\x92\x6f\xb5\x36\x3d\xb5\x21\x6b\x7d\x93\x52\x0b\xcd\x48\xf6\x6c\x3e\x4c\xb0\x89\xe2\x38\x8c\xf7\xd6\xe4\x10\x47\x17\x17\x1c\xf3\x86\xd7\x4f\xfa\xe0\x61\xdf\xc9\xed\x12\x4e\x04\xc2\x8d\xe6\x58\xb5\x20\x89\x05\x5e\xad\x10\xd5\xe4\xfe\xaa\x22\xdb\x69\xd0\x81\x15\xb6\x26\xbf\x5e\x5e\x0b\x92\xd7\xac\xad\x85\x96\x7e\x85\xb5\x66\x80\x48\x55\x65\x98\x8e\x5b\x52\x0b\xc1\x6d\xf5\xb4\xa2\x7e\x40\x31\x2d\x95\x64\x62\xb8\xcb\x98\xf1\xfe\xe3\xdf\x61\x09\x0f\x11\x78\x7f\xff\x30\xbf\xfd\xb0\xd8\x5b\x56\xa1\x38\x03\x0f\xf3\xf7\xf3\x07\x12\x8e\xcb\x5c\x20\x4b\x93\x90\x1d\x3e\x32\x01\xbe\x99\xdf\xcd\x57\xf3\xe2\x70\x60\xa7\x77\xc8\xb2\x97\x54\xec\x01\x93\xf3\xc7\xc5\xed\xef\x8f\xd9\x1c\xbd\x45\x34\xc1\x55\x6f\xae\x4d\xca\x59\x83\xc7\xf9\xa0\x23\xd2\xc3\xd6\xce\xac\x94\xec\x01\xd6\xb4\x99\x51\x1d\x10\x44\x0c\x4a\x07\xaf\x71\x75\x2c\x69\x31\x87\x39\x34\x7b\xf8\xf8\x5f\x08\xeb\x98\x01\x50\xfc\xbb\xc6\xd1\xbf\xd2\x33\x87\xae\x5a\xfe\xae\xc9\x44\x6d\xf0\x6f\x1f\xbb\xcb\xad\x2e\xf4\xfc\x23\xaf\x74\xd8\x2e\xa2\xe5\xb8\x3a\x5e\x89\xf5\x3c\x67\x9c\xaa\x49\xfe\x75\x43\x70\xa7\x51\x07\x0f\xc2\x35\x0d\xbc\x61\xb8\x20\x7a\xdd\x81\xb8\xd0\xd3\x77\x28\xae\x99\x21\x36\x18\x33\x6c\xc7\x1e\x8e\x0b\xd1\xed\xc3\x58\x05\x42\x8f\x21\x99\xe1\xdc\x6f\x0d\xe5\x85\xe5\x08\xf5\xba\x59\x78\xb9\x5c\x17\x74\xad\x23\x50\xcd\x0c\xe6\x7c\xfe\xd0\xf2\x5c\x88\xe8\x28\xca\xdf\xd1\xce\xec\xdc\x7e\x25\x44\xe9\x11\x63\xfa\x00\xa0\xdc\xea\xfa\x10\x9b\x2e\xea\x3c\xa1\x16\xa1\xd8\x46\x81\x10\x29\x79\x69\x80\x08\x19\x7c\x11\x21\xc3\x1e\x8a\x6b\xcf\x2e\xfe\xf8\xb3\xe9\xd9\x05\xf4\xc9\xd4\x8d\xbc\x99\xa1\xa9\x04\xb3\x84\xdb\x00\x7b\x7e\x8f\x45\x51\xd1\x33\x34\x1b\xab\x65\x4b\xac\x9b\xf3\x72\x55\x93\xf7\xda\x15\xb8\xd3\x80\x81\x75\xb7\x26\xb7\xa8\xb6\x45\x13\xa7\xc6\x56\xaf\x4f\x0d\x4d\x99\x8a\x9c\x22\x6f\x78\x43\x7c\x14\xf9\x5e\xdf\xe5\x70\xed\x56\xd8\x50\xa4\x55\x41\x1d\x29\x9e\xed\xbc\xb7\xec\xc8\x0a\x1c\xcc\xb4\xd2\x97\x89\xa4\x27\x57\xc9\x2b\x41\x22\xb8\x23\xef\x1c\x29\x4e\x75\x65\x89\x95\x5e\x0e\xe0\xf2\xee\x0f\xb2\xf6\x66\x4e\x6f\x14\x10\x5f\x93\xc3\x4f\x34\x8d\xb9\x90\x62\x04\x93\xc6\x53\x44\x99\x06\xa1\xc3\x3a\x65\xb9\xed\x19\xce\x65\x09\x42\x8c\x84\x76\x66\xaa\x9e\x39\xb2\x0d\x85\x60\x71\x23\x0a\x1e\x61\x2b\x98\xd4\x8d\xe0\x0e\xc5\x07\xe1\x6f\x4e\x9c\xec\xee\xe5\xa1\x79\x43\xe5\xd0\xb4\xa9\x64\x53\xe3\xc2\x23\xa3\x63\x4e\xef\x36\x50\xfe\x23\x7d\x0d\xcd\x3f\x1a\x12\xa9\x25\xc7\x1c\x84\xa1\xe7\xd3\x18\x6f\xf6\x43\x76\x61\xf5\x50\x3f\x50\x39\xe0\xb4\xfc\xdc\x94\x8f\x8d\x79\x1f\x4b\x43\xf7\x96\xe9\x79\xaf\x82\xe1\x33\x52\xb7\x30\xb7\x8e\xd3\x8e\xc8\x71\x34\x0d\xeb\x45\x47\x88\xd1\xe7\xef\x63\xa9\xa4\x01\x39\x9f\x9c\x67\x82\xe8\xce\x69\x89\x89\xae\x4c\x23\x47\x98\x36\x0f\x32\xfa\x67\xe5\x55\x35\x35\x5b\xe4\x0a\x2e\x1c\x62\xe8\x9b\x76\xe8\x05\x09\x3f\x06\x5d\x84\xcc\x28\x0c\x7d\x7e\x2b\x79\x1f\x55\x3a\x73\xca\xf4\x70\x9a\x63\x94\xa0\xf8\x47\x13\x09\x39\xd8\x8d\x5f\x4c\x72\xf0\x35\xf1\xfe\x53\xa7\x6a\x8e\xde\x86\xab\xda\x87\x06\x33\x5f\x2c\x8d\x6d\xcf\x69\x30\xa3\xce\xdd\x95\xfe\xcd\x65\xa2\xaf\xc9\x0d\xd3\x27\x31\xe3\xf3\x69\x93\x90\x8e\xd7\x9e\x13\x0e\x32\x74\xe0\x3c\x51\x48\x57\x31\x77\x6c\x27\xe7\xcc\x27\x6b\x0c\x47\x8c\xcd\xae\xa9\x1a\xfb\xea\x85\x26\x9a\x74\xee\x69\xd3\x0b\x2f\x64\xa0\x39\x70\x9c\xa1\x7b\x6f\xe1\x36\xb6\x51\x16\xdd\x0d\x15\xbe\xc7\x00\x5e\xf2\x03\xbd\x48\x7e\x42\x8c\x27\x87\xa4\x88\x50\xc2\x5f\xdb\x57\xdb\xdf\x75\x45\x2f\x98\x80\x62\x56\xfb\xa7\x84\x8b\x73\x61\xa2\xf3\x95\x15\x87\xf6\x5c\x93\x60\xd1\xba\x22\xd2\xa1\x87\x54\x96\x26\x7c\x0d\x29\x27\xe6\x82\x5a\xaa\x75\x68\xf9\x55\xd5\xa5\xa7\xb1\x07\xd6\x97\x0e\x6d\xf5\x0a\xd3\xc4\xd0\x52\x63\x18\x96\xa3\xc6\x6a\x16\x9f\xcc\x4f\xe2\x33\x37\x3a\x61\xeb\x98\x0f\x8a\x96\xa1\xf6\x8a\xc2\xa5\x2d\x54\x73\xf3\x25\x9d\xda\xc0\xc6\xd4\x6b\x9a\x16\xfe\x2d\x13\x3b\xfc\x62\xa2\xe0\x07\xf2\xc3\x88\xae\xa4\xca\x28\xf0\x8b\x19\xa3\x64\xeb\xe3\x86\xc6\xf4\xb6\x0b\xbf\x89\x78\xa1\xa9\x39\xf1\xd6\x01\xc4\xdb\x98\x7b\xbe\xca\x50\xcf\xfe\xf8\x33\x9f\x58\xee\xcf\xf0\xd6\xa8\xfe\xf8\xb3\x22\x72\x83\x36\x61\xc3\xd3\x91\x42\x56\x10\x06\xa8\x75\x68\x28\x64\xd5\xc5\x50\xcb\xbc\x0d\x32\xad\x70\x1b\x38\xe9\xe1\x09\x3d\x86\xc1\x9a\xb3\x97\x72\xf0\xc6\x3a\xff\x35\x4d\xdc\x9d\x74\xf6\x2e\x56\xdb\xd6\xf9\xc1\x98\x8a\x65\xb4\x18\x30\x66\xd9\xfd\x0b\xd0\xd5\x26\x33\xa5\x22\x27\x86\xb8\x5d\xc6\xaf\xb4\x82\xad\x8c\xc3\xed\x68\x92\xd2\x6a\xc9\x0d\x99\xe4\x90\x77\x79\xd0\x6b\x15\xcd\x6f\xce\x00\x37\x57\xab\xab\x0e\x0b\x3b\xa4\x16\x27\xc0\x8f\x26\xb9\x76\x49\x55\x44\xd8\xed\x62\x39\x7f\x58\x81\xdb\xc5\xea\x9e\x5e\x54\x4d\xaf\x55\x2e\xc1\xa9\x3c\x06\xf2\x18\x8c\x1e\xaf\x7e\x1b\x8d\xc1\xe8\xc3\xd5\xd7\xdb\x77\xda\x7c\xf5\xf4\x61\xf9\xf5\xf1\xee\x7e\xfa\xe5\x9d\x76\xa3\x2e\xa7\xca\xd3\xdd\xe7\x0f\xb7\xd7\xda\xea\x49\x7b\x52\x96\xcb\x7f\x7d\xfc\x72\xbf\xfa\xf4\xfb\xb7\x2f\xb3\xd5\xed\xdd\xd3\xd7\x77\x8f\x57\xa3\xf1\x7e\xf3\xf0\xec\xb2\x45\x95\xb2\x57\x75\x75\xb8\x2e\x1c\x6f\xeb\x47\xfd\xd9\x42\x91\x39\x28\x9f\x56\x2c\xe7\x5d\xb1\xba\x9c\xdf\xcd\xaf\x57\xcc\x0b\x5a\xde\x24\x88\x53\x81\xc6\x60\x56\xd3\x5f\xe9\xa2\xa2\x30\xf4\xee\xa7\xb2\x45\xb5\x0a\x73\x54\xb3\x6a\xd2\x47\x69\xff\x64\xfd\xd8\x60\x5c\xdb\x7e\x7f\xdf\x48\xac\xee\xf9\x67\x31\x39\x92\x4d\x2f\xf0\xb0\x07\x7d\x33\x49\x65\xbd\x49\xfe\xf2\x49\x78\x2a\x92\xac\x9e\x4b\xfa\xb9\x62\x00\xd9\xb8\x98\x69\x17\xf2\xec\x8d\xac\xce\xa6\x8a\xfa\xff\xa4\xc9\xe8\xec\x52\x4c\xba\x62\xee\xdf\xbc\x5b\x2a\x19\xd6\xce\xc4\xa1\xe7\xb4\x69\x9a\x48\xfa\x4c\xd1\xfb\x68\x9a\x98\x70\xbd\x8e\xd1\x1a\x62\x44\xee\x9c\xa0\x20\x41\x89\xe9\x86\x71\xb6\xd8\x48\x5a\xd5\xe9\xaa\x3a\x95\xfb\xa8\xd3\xf2\x45\x4c\xba\x6b\xdf\x2a\x7d\x2a\x6b\x86\xd4\xcb\x18\xbd\x22\xdd\xc4\x3f\x43\xf3\x27\xdc\xb5\x69\x99\x29\x9a\xa2\x29\x7d\xb4\x18\xa6\x4c\x9f\x35\xb4\xc9\x55\x15\x59\x51\xb4\x7e\x72\x8b\x78\x6f\x93\xac\xcb\xda\x54\xcb\xbc\xde\x90\x03\xd9\xf8\x43\xfd\x71\x58\x12\x54\x85\xe5\x90\xe5\xc3\x6a\xa4\x4a\x53\x39\xff\x1f\x99\xa0\x9e\x5d\x8a\xe9\x56\x88\xee\xeb\xfb\xd9\xbb\xff\x5a\xcd\xbe\x4c\x16\x93\xe5\x47\xe5\xfa\x66\xf6\xf8\xf1\x66\x39\xff\xfd\x5f\xef\x9e\xde\x2f\x6f\x3f\x3d\xdd\x7c\x51\xde\x69\xb3\xe5\xdd\xc7\xaf\xf3\x6f\x77\x0f\x4f\xef\x67\x1f\x16\xf7\x0f\x4f\xd7\x1f\x5a\x74\x77\xf8\x93\xf7\x84\x41\xc4\x9d\x1d\x62\x79\x1b\xf6\x43\x7b\x29\xdb\xb4\x67\x3b\x49\x92\x24\x43\x95\x35\x4b\x73\xac\x99\x0a\x1d\xc9\x95\x5c\xcb\xd0\x34\x5b\x35\x26\x12\x32\x5c\x15\x4e\x2c\x68\x3b\x53\xdd\x70\x64\x7d\x3a\x9d\x69\x48\x77\x1d\x0d\xda\xd2\xcc\x55\xa1\x62\xc8\xb3\xd1\xde\x3f\x63\x20\xa5\xff\x46\xb2\xa1\x49\xe7\x92\x7c\x2e\xc9\x40\x92\x2e\xd2\x7f\xd5\x68\x55\x49\x16\x2b\xd2\x1b\x49\xd7\x64\x55\xef\x6c\x9d\x2a\xc6\xd4\x50\x35\xc5\x50\xc7\x40\xcf\xf4\xec\xff\x2b\x4b\xd2\xd9\xa5\x90\xa9\x24\x26\x74\x57\x57\x10\x94\x15\x03\x69\xda\xcc\x46\x33\xdd\x42\x0e\x44\xba\xee\x58\xb6\x2d\x4d\x5c\x55\x32\x5c\x1d\x6a\x33\x28\x4d\x2d\x45\x31\x0c\xd5\x52\x74\xc5\x36\x26\x53\x45\x87\xb2\x33\x55\xdc\xd1\x71\xdc\x45\x1d\xb5\xb7\x59\x3b\x97\x65\x20\x4f\x2e\x66\xfa\x85\xd2\xe8\x0a\x59\x97\x8c\x89\xd1\xd9\xaa\xcf\x74\xc3\x98\x4c\x67\x86\x52\x73\xd4\x4c\xd4\x4f\x93\x31\x18\x4d\xa6\x8a\x35\xd1\x6c\xd5\xb2\x27\x2e\x72\x25\x6d\x2a\xa9\xb3\xd9\x4c\xb7\x5d\x08\xad\x89\xa6\xa9\xba\xa2\x4a\x53\xc9\x30\x14\x59\x41\xba\x3e\x75\x5d\xd9\x9a\x48\x33\x6d\x66\xa8\x33\x34\x71\xf6\x66\x1c\xc1\xd7\x4d\x7e\x9a\x4c\x9a\x3c\xa1\x18\xd2\x44\x32\x3a\x5b\x65\x45\xd7\xa7\x86\x24\xeb\xba\x3e\xdc\x51\xd3\x31\x18\x19\x8e\xaa\x69\xba\xab\x38\xc6\x64\xa2\xd9\xa4\x93\xa4\x99\xe6\x6a\x8e\xab\x4f\x1c\x79\xe2\xcc\x14\x47\xd2\x6d\x17\x49\x16\x9c\x4c\x90\x2c\xab\x8a\xa1\xba\xd2\xd4\x51\x91\x31\x71\x65\xc3\x51\x47\xc7\x71\x76\xa3\xa3\x1a\x03\x6a\xa2\xea\x53\x81\x56\x59\x93\x35\x43\x57\x0d\x59\x9f\x0e\x77\xd4\x6c\x0c\x46\x96\x2a\xeb\xf6\xd4\xb0\x2d\x5b\x75\x27\x0a\xb2\x26\xb2\xa2\x59\x8e\x25\xbb\x8a\x8b\x26\x0a\x9c\x4d\xa5\xa9\x6b\x4c\x34\xc5\x76\x2d\xa4\x1a\xda\x6c\xaa\x4a\x8a\x6d\x21\x45\x9d\x22\x63\x66\x4f\x95\xd1\x71\x9c\xdd\xe4\xa8\x69\x63\x44\x4d\x35\x4d\x97\xa7\x9d\xad\x8a\x3c\xd5\xa6\xfa\x44\x9d\xea\x12\xdf\x51\x1d\x45\x9e\xbf\xa6\x1d\x3c\x94\x88\x08\x7f\x8d\x49\xb9\x90\x46\xa1\x89\x7a\x4d\xd2\x70\x67\x34\x48\x6e\x5a\x7e\x0f\xd6\x23\x26\xfe\x35\xdd\xde\xa1\xb3\x97\xe3\x19\x59\x87\xba\xa4\x32\xf1\x3e\xce\x81\xd0\xb2\x50\xde\x51\x50\x8e\xda\xf2\x21\x50\x7a\xb6\xa9\xeb\x55\x5b\x97\x0d\xe6\x14\xbb\x23\x47\xba\xc5\x57\x17\xdc\x66\x56\x45\xfd\x71\x4c\x2b\x3e\x36\x70\xb8\x35\x44\x16\xd7\x80\x5c\x49\xe7\x99\x5c\x66\x91\x7f\x1c\x50\x85\x40\x1e\xb2\x8a\xba\x4e\x78\x95\xe5\xda\x91\x1c\x57\x91\xca\x03\xca\x53\xdc\x89\xb6\x56\x52\xcb\x35\xe2\x38\xe0\xdb\x95\xf0\x6c\x11\x80\x25\x6c\x5a\x63\x01\x3c\xae\x71\x4d\x6a\xda\xcc\x6b\x85\xd6\x69\x20\x27\xe9\x69\x8a\xa7\x5f\x89\x19\x7a\x2c\xbd\x5d\x2c\x39\x90\x5e\xa7\x28\x1f\x47\xa7\xe4\x75\xc0\x0d\xdf\xc2\xe9\x8f\xb4\x74\x7d\xab\x41\x6c\x8a\x94\x34\x95\xc1\x11\xed\x63\xb0\xa7\x1b\x03\x6e\xc5\x63\xbe\xed\x33\xd4\x89\x85\x08\x02\x83\xd3\xdf\x55\x97\xed\x89\xc7\xb5\x27\x96\x3c\x70\x07\xbd\x27\x89\xf2\x8b\xc1\x62\x5a\xf2\xd7\x25\x55\xd1\xd0\x4f\x2a\x1d\x80\x67\x2f\x41\x0c\x51\xe5\x59\xf2\xb8\x7e\x7a\xa5\x86\x91\xa9\xe0\x47\xe8\x59\xae\x34\x82\xbd\x68\x28\x23\x3e\x3d\x2d\x6e\x3b\x9c\xff\xf3\x9f\x60\xe4\xc6\xe1\x86\x1e\x7b\x3d\x3b\x1b\x83\x5a\x3b\x0e\xf3\x56\x31\x5b\x86\x66\x51\x8b\x41\x79\x06\x35\x5b\xc5\x33\x2b\x65\xcb\xd1\xe7\xef\x37\x4d\xad\xac\x9b\xd9\x44\xdd\x65\x35\xfb\xbc\xe8\x50\x73\x89\xac\x5e\xbd\x97\x56\x9b\x32\x72\x4e\x1f\x16\x53\xac\x6e\xaa\x7d\x2d\x12\xed\xf3\x81\xc9\x5f\xaa\x98\x75\x89\x6d\x2e\xc8\x6e\xf4\x8c\xc1\xf5\xfd\xd5\xdd\x7c\x79\x3d\x3f\x2d\xae\xb4\x8c\xc1\xe8\xdc\x0b\x5c\x2f\xf0\xf0\x8e\x98\x50\x3a\xce\x50\x9c\x65\x38\xab\x31\xa7\xe7\xc7\xc7\x60\x24\xc2\x5c\x73\x49\xf5\x13\x76\x07\xfa\xa3\x22\x8e\xad\x44\xd9\xd1\xee\x92\x47\xea\xd3\x13\x72\xed\x97\x9e\xd2\x6e\x02\xeb\x39\x47\x82\xe9\x39\xc2\x00\xb3\x6c\x26\xf0\x06\x80\xce\xbe\x3a\x78\x0c\xdc\x54\x16\x0b\xbd\x40\xc2\x4e\x7e\x86\x59\xc2\x37\x00\xbf\x1c\xcf\x00\xfc\x52\x33\xa0\x69\xfe\x26\x6e\x02\x2b\x81\x67\x04\xf3\x39\xc9\xfe\x36\x50\xf0\x85\x8c\xa1\xce\x6f\x77\x74\xe5\xfb\x98\x87\xfa\xba\x2c\x8e\x85\x9c\x6d\x22\x96\x30\xf2\x11\xb1\x7e\x3d\x16\xac\x9a\x4c\x16\x1b\xd3\x28\x00\x90\xf9\x5a\x69\x7f\x5c\x14\x50\x21\x63\x78\x48\xb2\xd4\x1c\x9c\xdd\x1f\x65\x3d\xd0\xab\x9d\x0a\x58\xd3\xb2\xe6\xb2\x29\x94\xb0\x07\x76\xcf\x79\x3d\xd8\xe5\xce\xe0\x23\x16\x77\x34\xfb\xdd\xdd\xfe\x90\x5b\xb1\x32\xa2\x85\x10\x83\xaf\xbf\xcd\x1f\xe6\xe0\xf4\xb4\x72\x31\x2d\xbf\x79\xf7\x76\x7f\x84\x04\xdc\x3f\x80\xd3\xea\xbd\xaf\x2a\x51\x87\xfd\xd5\x4f\x16\x1f\xc7\xf4\x8a\x54\xd6\x6a\xda\x54\x36\x9a\xbb\x34\x2c\x8b\xe4\x7e\x9b\xf9\x38\x68\x79\xa2\x59\xc8\xb4\xbd\x0c\x39\xa7\x14\xc7\x7d\xec\x64\x28\x89\xee\x04\xdc\x99\x0a\x6d\x5f\xdf\x3e\xba\xa3\xab\x1a\xba\xe1\x57\x18\xc4\x8d\xa1\x43\xdc\x31\x26\xf3\x22\x3a\x3a\x2d\x61\x68\xc5\x8d\xe0\x7e\x9c\xfd\xb5\xac\xe1\x5e\xff\xeb\x32\x8b\xc7\x24\x6e\x5f\xb6\xc9\xf0\x6a\x3d\x94\x29\xe8\xec\x9e\x8c\xb0\x03\x7b\x3e\x4f\x7b\x95\xd4\xae\x4a\x67\x51\x17\x6d\x3d\x13\xbc\x2c\xb4\x3c\x1f\x19\x00\xbf\x1b\x77\x59\x85\x88\x0d\x65\x8e\x7e\xf6\x1c\x6f\xf8\xaa\x0b\x16\xc2\xde\x3d\x88\x31\xe6\xbd\x4a\xd8\xd4\xe5\xb3\xc0\xd9\xd6\xce\xd0\xc1\x31\x74\x50\x3e\x90\x67\xcb\x75\xd3\x0a\xc3\xef\x83\xbd\xdc\x22\x93\xc5\x49\x09\xca\x10\x4f\x4f\xb3\x8b\x6f\xe9\xa6\x4f\x12\xfa\x8e\xd9\xb0\x3f\xd4\x44\x58\xdb\x22\x6a\x22\xac\xec\x12\xd5\x48\xad\x70\xbb\x7e\xc6\x42\xea\x4b\xa4\xed\x00\x4a\xa4\xd5\x8d\xaa\x6c\x4e\x48\x8c\x05\x6f\xc1\x64\xc2\x74\xd8\xe7\x30\xc1\xeb\x18\x91\xaf\x6c\x92\x3b\x78\xe4\x02\x30\x70\xb6\x9b\x88\xec\xbf\x45\x3e\xc2\xe8\xe4\xfc\xfc\xe4\xe4\x7f\x07\x00\xed\xd1\x85\x9c\xdc\x86\x00\x00")
 
 func baseHorizonSqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -251,12 +251,12 @@ func baseHorizonSql() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "base-horizon.sql", size: 23783, mode: os.FileMode(420), modTime: time.Unix(1484155113, 0)}
+	info := bindataFileInfo{name: "base-horizon.sql", size: 34524, mode: os.FileMode(420), modTime: time.Unix(1484155113, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _blankHorizonSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xc4\x5c\xeb\x6f\xe3\xb8\x11\xff\x9e\xbf\x82\xb8\x2f\x4e\x50\x27\x8d\x37\xd9\x3c\x71\x07\xf8\x12\x5f\xd7\x68\x62\xef\xc5\x4e\x77\x17\x45\x21\xc8\x16\x6d\xab\x2b\x4b\x5a\x49\x4e\xe2\x2b\xfa\xbf\x77\x48\xbd\x28\x89\x2f\x3d\x72\xbd\x2f\xb7\xb1\x86\x3f\xfe\x66\x38\x1c\x0e\x87\x94\x8e\x8f\x0f\x8e\x8f\xd1\x67\x2f\x8c\xd6\x01\x9e\xfd\xfe\x80\x2c\x33\x32\x17\x66\x88\x91\xb5\xdb\xfa\xf0\xec\x80\x3c\xbf\x87\x7f\x63\x0b\xad\x02\x6f\x9b\x0b\xbc\xe0\x20\xb4\x3d\x17\x5d\x9f\x7c\x3c\x39\x65\xa4\x16\x7b\xe4\xaf\x0d\xd2\xbc\x24\x72\x30\x1b\xcd\x51\x18\x99\x11\xde\x62\x37\x32\x22\x7b\x8b\xbd\x5d\x84\x7e\x46\xa7\xb7\xf4\x91\xe3\x2d\xbf\x57\x7f\x5d\x3a\x36\x91\xc6\xee\xd2\xb3\x6c\x77\x0d\x0f\x7a\xcf\xf3\xdf\xae\x7a\xb7\x29\x9c\x6b\x99\x81\x65\x2c\x3d\x77\xe5\x05\x5b\x90\x30\xc2\x28\x80\xff\x85\x20\xe9\xb9\x09\xc6\x06\x03\xf4\x6a\xe7\x2e\x23\xa0\x63\x2c\x00\x09\x93\xe7\x2b\xd3\x09\x71\xa1\x1b\x00\x30\xb6\x38\x0c\xcd\x35\x15\x78\x35\x03\x17\xb0\x62\x91\xc0\x7b\x35\x42\xbc\xdc\x05\x76\xb4\x27\xe0\xab\xd5\x6d\xa2\x13\x36\x83\xe5\xc6\xf0\xcd\x68\x03\xbf\xfb\xbb\x85\x63\x2f\xfb\xc4\x08\x4b\xb0\x95\xe3\x41\xf3\x83\xfb\xa7\xe9\x67\x34\x9e\xdc\x8f\xbe\xa2\xf1\x6f\x68\xf4\x75\x3c\x9b\xcf\x12\xc9\x93\x28\x30\x2d\x6c\xe0\xd5\x0a\x2f\xa3\xd0\x58\xec\x0d\x2f\xb0\x70\x00\x2c\xbd\xef\xb7\xd2\x86\xb6\x6b\xe1\x37\x63\x63\x87\x91\x17\xec\x0d\x80\x71\x43\x93\x6a\x18\x1a\xa0\xa5\x6d\xd5\x69\xed\xf9\x38\x30\xb3\xb6\xd1\xde\xc7\x2d\x5a\xe7\x4c\x5a\xb1\xa8\xd7\xd6\xc1\xd6\x1a\xfc\x8d\x34\x0c\xf1\x8f\x1d\x38\x4c\x2d\x15\x98\xe6\x7e\x80\x5f\x6c\x6f\x17\x26\xbf\x19\x1b\x33\xdc\x34\x84\x6a\x8f\x60\x6f\x7d\x2f\x88\x00\x23\x99\x4c\x4d\x61\x9a\xda\x72\xe9\x78\x21\xb6\x0c\x33\xaa\xd3\x3e\x75\xe6\x06\xae\x64\x2e\x97\xde\xce\x85\xb6\xaf\x76\xb4\x21\xae\x64\x47\x61\xa3\xf6\xb5\x95\x66\x5b\x9a\x96\x15\x40\x18\x90\x37\xdf\x44\x3e\x99\xae\x9b\x48\xd5\xcf\x26\x2c\xcc\x09\x68\xa3\xd1\x22\x71\x1d\x1d\x61\x2f\xe6\xe1\x29\x05\x41\x53\x23\x7a\x33\x7c\x35\x24\x91\x04\x58\x4d\x49\xac\x2b\x96\x46\x37\xb9\xf0\xd2\xdb\x6e\xed\x30\x4c\x6c\xa5\x9e\x3c\x45\x79\x33\x0c\xb1\xc2\x5b\x4b\x0d\xe2\x81\xd7\x70\x55\x6e\x3b\x79\x93\x45\x3a\x9b\x94\x62\x6a\x3d\x75\xfb\xa4\x16\x08\x61\x4d\x84\x75\x05\xe8\xee\xc0\x8d\xd4\xba\xa5\x56\x20\x2b\x34\x0c\x96\xbd\x0c\xd3\x59\x00\x83\xfb\x76\x7b\x30\x7c\x98\x8f\x9e\xd0\x7c\xf8\xeb\xc3\x88\x69\x3c\x9d\x3c\x7c\x63\xc7\xb8\xb4\x12\xc1\xa2\x18\x00\x94\xed\x9b\x30\xb1\x10\xed\xfe\x6e\x3a\x99\xcd\x9f\x86\xe3\xc9\x9c\x81\x51\x35\x35\xfc\xef\x78\x5f\x87\x43\xb6\x92\xd4\x65\xc0\x6f\xa8\xdd\xff\xda\x0b\x7c\xc8\x22\xd6\xc9\x32\x26\xe9\xb0\x24\xa9\xdd\x43\xee\x83\x12\x70\xc6\x51\x75\x71\xa9\xd3\x48\x20\xe9\x73\x7d\xb4\x8a\x37\xc9\xa0\xab\xae\x57\xb7\x1f\xc7\xde\xda\xd2\xf1\x2d\x0a\x4a\xf1\x75\xdd\x39\x6e\x7d\x37\x7d\x78\x7e\x9c\x20\xdb\x8a\x3b\xbf\x1f\xfd\x36\x7c\x7e\x98\x6b\x62\x0b\xdc\xb4\x05\x32\xe3\x1e\x2d\x50\x62\x67\x90\x03\xd0\xbf\xf4\x6d\x97\x2e\xa6\xb3\xd1\xef\xcf\xa3\xc9\x5d\x03\x83\x43\x1c\x22\xa9\x5d\xed\x9e\x0b\x20\x7a\xad\xf3\x44\x54\x9b\xb5\x20\x70\xd4\xe1\xcc\x87\xd0\x6b\x9b\xa4\x6c\x7a\xc2\x49\x7e\xa6\x27\x9c\xe6\x45\x72\xe9\x52\x38\x53\x9a\x8d\x89\x50\x3a\x26\xca\xc5\x95\xc8\x71\xa0\xd2\x01\x65\x33\x05\x91\x48\x25\x34\xe9\xc9\xc7\x61\x26\x91\x1d\x7d\x9d\x8f\x26\xb3\xf1\x74\xc2\xae\x37\xc4\xb8\x58\x22\xe0\x3b\xfe\x3a\xfc\xe1\xa4\xea\xde\x7d\x1a\x3d\x0e\x2b\xfd\xdd\x1e\xc4\x3b\xf8\x89\xb9\xc5\x37\xe9\x6f\x68\x0e\x8b\xfd\x4d\xd2\xe4\x16\xcd\x60\xfb\xbb\x35\x6f\xd0\xf1\x2d\x9a\xbe\xba\x38\x80\x7f\xd1\x8d\xfd\xdd\xd3\x68\x38\x1f\xa5\xc8\x29\xde\x41\x11\x31\x21\x91\x40\x66\x3c\x95\xa8\x05\x8d\x26\xd3\x79\x49\x2b\xf4\x65\x3c\xff\x94\x75\xcd\xee\x94\x0b\xdd\xe7\x28\x25\x22\x77\xd3\xc7\xc7\xd1\x64\x2e\xa1\x11\x0b\xc0\x5a\x51\x05\x41\xe3\x19\xea\x7d\x7e\xf8\xab\xbf\x26\x15\x0f\x3f\xf0\x96\xd8\xda\x05\xa6\x83\x1c\xd3\x5d\xef\x60\xeb\xdf\x2b\xf3\x48\x06\xab\x33\x2b\xc4\x78\x45\x23\x70\xed\x9f\x03\x14\x29\x34\xd3\x3f\xe9\x96\xa8\x4f\xca\x38\x88\x24\x85\x68\xe5\x05\x88\xfc\x4e\x8a\x2b\x24\x6d\x44\xde\x0a\x1d\xc2\xea\xd8\x47\x2f\xa6\xb3\xc3\x47\xc8\x37\xed\x20\xa4\x26\xd1\x2c\x76\x10\x31\x0b\xaf\xcc\x9d\x03\x39\xb5\xb9\x70\x70\xe8\x9b\x4b\x4c\x2a\x37\xbd\xd2\x53\xba\xc7\x83\x6d\x0b\x53\x8c\x29\xa8\x5f\x9a\x4d\x89\xf2\x74\xea\xe5\xaa\xa7\x5e\xcf\x1b\x80\x78\x96\x96\x92\x84\xc3\x03\x04\xff\x25\xc9\x2d\x5a\x6e\xcc\x00\xd6\x09\x1c\x80\xbe\xc1\x1e\xac\x70\x78\x71\x7e\x44\x07\x6b\xf2\xfc\xf0\xd0\x8f\x65\x69\x48\x21\xf9\x34\x47\x7c\xf0\xa1\x2c\xbe\x35\xdf\x98\x58\x4e\xca\x59\x0b\x7b\x6d\xbb\x51\xba\x76\xa2\xd3\x52\x03\xcb\xb4\x9d\xbd\x41\x9b\xa9\x85\xb7\x9e\x1b\x6d\x6a\x88\x17\xc8\xd8\x6e\x59\xbe\x77\x3c\xe8\xdd\xdc\xc0\x2f\x18\xd6\x0f\x21\xaf\x7a\xed\x58\x8a\xba\x2d\x0f\x8e\xca\xce\xcf\x89\xbd\x6d\x3d\x80\x49\x47\xdf\xdd\x0b\x68\x8f\x38\x20\x4b\xf9\x9e\xee\xbf\x50\xb8\x35\x1d\x47\xed\x07\xb6\x0b\xab\x1d\xd6\xf3\x19\x70\x00\x1d\xe1\x57\x8c\xbf\x6b\x23\x27\xc2\x9a\xd0\xe9\x58\xeb\x61\xa7\xd2\x9a\xe0\xa6\xeb\xee\x4c\x47\x13\x3b\x11\xd6\x84\xde\xf9\x10\x03\x69\x65\x0b\x91\xa2\x33\x78\xc6\xd6\x47\x24\x20\xd1\x3f\xd1\x1f\x9e\x8b\x65\xbe\x49\x53\x87\xc6\xee\x48\xd3\xeb\xd8\x03\x21\xaf\x4e\x98\x16\xf9\x51\x8f\xe1\x4f\x2f\x6d\x17\x8c\x37\xff\x5a\xce\x6d\xc3\x76\xdf\xf5\xdc\xfd\xd6\xdb\x85\x68\xe1\x79\x0e\x36\x5d\x95\xfe\x69\x92\x95\x26\x1c\x49\x4a\xa6\x67\x89\x2c\x81\x63\xa1\x28\x95\xd9\x7c\xf8\x34\x8f\x17\xc7\x01\xfd\x61\x3c\x81\x36\x74\x39\xfb\xf5\x5b\xf2\xd3\x64\x8a\x1e\xc7\x93\x7f\x0c\x1f\x9e\x47\xd9\xdf\xc3\xaf\xf9\xdf\x77\x43\x58\x56\xd1\xa0\x0e\x6d\x34\xfd\x32\x19\xdd\x43\x17\x0a\xfe\xf1\xae\x88\x4b\x3f\x83\x88\x7f\x3d\x21\x55\xb1\x22\x01\x26\x8f\x6d\xea\x3c\xcc\x0e\x4f\xee\x41\xb0\x88\xd3\xa2\x52\x3e\xfe\x9c\x71\x27\x42\x74\xa1\x47\xff\x0e\x3d\x77\x51\x7a\xba\x72\xcc\xc8\x58\x61\xe5\x64\x82\xf5\x65\x49\xce\x4f\xa4\xa2\x55\x2f\xaa\x6e\x02\xda\xb9\x52\x05\xef\xbd\xfd\x49\xa9\x40\x43\xa7\xaa\xe0\xe6\x9e\x95\x3f\xe2\xb8\x57\x79\x17\xd6\xd4\xc7\xca\x65\xac\xcc\xd1\x22\xfc\x56\x76\x33\xd3\xf7\x1d\x5b\x1e\x48\xab\x23\x5f\xd9\x5c\x36\x65\x5a\x06\x52\xcc\x09\xe9\x7a\x9f\x88\x30\xe5\x60\x41\x00\x5e\xd0\xb3\x4a\xba\x2a\x91\x13\x47\xdf\xdc\x93\x23\xcd\x3c\x6e\xa6\xbe\x4f\x73\x5a\x6e\xdb\x78\x91\xaa\xdd\x98\x66\xb0\xc4\xd6\xb4\xc2\x1b\x4f\x59\xb1\x71\xd3\x6d\x7e\x5b\xdb\x26\x38\x89\x69\x4b\x16\x37\x44\xa6\xae\x56\x35\x44\x92\x3f\xd1\x33\x81\x9f\x04\xc6\x96\x8c\x83\x85\x23\xc8\x82\x94\x76\x48\x6b\x23\x6d\xed\x90\xe0\x24\x76\x48\x4f\x19\x05\xdc\x98\xa3\x3f\xad\x05\x98\x77\xea\x28\x73\x53\xb6\xc0\x45\x07\x22\xe3\x21\x0a\xd2\xf9\x40\xe8\xc9\x67\x47\x7f\xa5\x79\x4d\x36\x1d\xd5\x1c\x29\x69\x13\x60\x7e\x56\x55\x68\xa4\xc8\xc0\x38\xb2\x99\xeb\x24\x7f\x96\x4e\x45\x2b\xba\x0c\xca\x4e\xe4\xc1\xee\x14\xf4\xb6\x21\x98\x71\x7d\x10\x56\x2e\xc3\x87\x19\xc8\x7f\x4a\x6e\x3c\xd0\xc5\x4d\x10\x0f\xc8\x63\x88\x2b\x38\x78\x11\x89\x90\xad\x50\xf4\x66\x90\x5c\x21\xb4\xff\xa8\x4a\x89\xbd\x57\x50\x15\x6c\xeb\xcc\x82\xd2\x73\x16\x3e\xf9\x6a\xe8\x4f\x6a\x75\x98\xa8\xab\x72\x37\x39\x82\x56\x1f\xef\x9d\x37\x34\x52\xb4\x61\x2e\xa1\xd5\x57\x9e\x5f\xc8\xc5\x39\x39\x07\xa7\x66\xde\x99\x6f\xaa\x96\xf3\xe2\x55\x13\xc1\x92\x4f\xf2\x93\x65\x52\x8b\x21\x0b\x4d\xcb\x75\x26\xfe\x29\xf4\x76\x90\xf2\xa6\xde\x2d\x88\xf0\x59\xc5\xa3\x77\x73\x53\x91\xd0\x98\x07\xc2\x43\x8c\xb6\x06\x16\x9e\x69\x69\x4e\x7f\x1d\xbb\xb7\x09\x00\xaa\x23\xa0\x6e\x42\x80\xa2\x97\x3f\x2b\x08\xd4\x54\xb6\x65\x18\x50\xf4\x56\x0d\x04\xa2\x06\x92\x50\x50\x38\xf6\xeb\xd0\x57\x53\xff\x64\x29\x69\x27\x58\x49\x5e\xa5\x48\xdb\x74\xa3\x85\x7c\xe2\x73\x65\xf3\xae\xc5\x19\x88\x29\x9c\x7a\xa2\xec\xed\xff\x92\x7f\x41\x26\x83\xdd\x17\xec\x00\x29\xde\x96\x10\x1e\x43\x36\xb4\x73\x22\xc1\xc3\x2d\x26\xa7\x0f\xdc\x47\xc4\x0a\xa2\xc7\xa1\xbd\x76\xcd\x68\x07\xd0\x1c\xb3\x5f\x5f\x1c\xfd\xf3\x5f\x79\xc4\xfd\xcf\x7f\x79\x31\x17\x24\x4a\x69\x19\xde\x7a\xf1\x4e\xaf\x1a\x9f\x33\x2c\x17\xcc\x20\x8d\xe0\x39\x56\x15\x26\xd1\x0c\xcc\x69\x2c\x60\xe0\xac\x90\x8c\xdc\x15\x38\xf0\x9a\xb3\x2d\x86\x29\x95\x4c\x97\xf4\x98\x5d\x67\x8e\xc7\xf3\x85\x5e\x8b\xe0\x1f\xdc\x93\x33\x97\x54\x1b\x17\xec\xfa\x62\x3a\x87\x3d\xb6\x70\x05\xda\x05\x78\xbd\x74\xe0\xb7\xee\x39\x49\xae\x24\x70\x89\x55\x8a\x1f\xef\xca\xae\xe6\x55\x0c\x2e\x63\xad\x14\xeb\x4f\xd1\x42\xfb\xb2\x8a\x54\x0f\xc5\x1a\xc1\xd7\xe4\x9e\x1c\x29\x92\xd3\x44\xe5\xd9\x1d\xba\x1f\xce\x87\x0a\x0d\x15\xa8\x82\x33\xa1\x36\xc8\x95\x8a\x7e\x1d\x30\x8d\xf2\x32\x58\x5c\x01\x36\x1b\x3d\x8c\xee\xe6\xcc\x61\xea\x09\xc0\x55\xe7\x6a\x1f\x0d\xfa\x71\x75\x48\x6c\x7d\x41\x9d\xb9\xbe\x4a\xea\x0a\x67\x1b\xbd\xaa\x53\x5d\x47\x39\x59\x95\x53\x47\xc3\xf1\x64\x36\x82\xa4\x6e\x3c\x99\x4f\x2b\x95\x4e\x9a\xb5\xcd\xd0\x61\x6f\x60\xd8\xae\x1d\xd9\xa6\x63\x84\x14\xeb\x24\xfc\xe1\x00\xbb\xde\x87\xd3\xc1\xc5\xf1\xe9\xd5\xf1\xd9\x29\x1a\x0c\x6e\x3e\x5e\xdd\x7c\x38\x3f\x19\x9c\x5e\x0f\x2e\xaf\xff\x72\x7a\xd6\x03\xd2\x5a\xe8\x1f\x8c\xf8\xf2\x71\x61\x76\x2d\x60\xe6\x79\xb6\x25\xeb\xe9\xc3\xf9\xf5\xd5\x60\x50\xa7\xa7\x33\xc3\x5c\xaf\x61\xba\xc2\x52\x6f\xe0\x37\x1f\xbb\x21\x0e\x0d\xb0\x65\x56\x31\x95\x75\x77\x7e\x71\xf5\xf1\xf2\xa2\x4e\x77\x97\x46\x71\xe2\xcb\xd0\x3f\x9e\x0d\x4e\x2f\xaf\xea\xa0\x5f\x95\xd0\x8d\xe8\xd5\x33\x5e\xcd\xbd\xac\x97\x8b\xab\xb3\xc1\xe0\xbc\x4e\x2f\xd7\xc6\x20\xa9\xb0\xca\x70\x2f\x2f\x2f\xae\x2e\x2e\xeb\xe1\x32\xc5\x7b\x09\xf2\xf5\xc5\xf9\xd9\xc5\xc7\x04\x59\x30\x07\xa4\x05\xf4\x16\x61\x50\x56\x3b\xee\x00\x96\x57\x8a\xed\x00\x56\xa3\x46\x56\x3f\xf4\x35\x2b\xd2\xb4\x09\x87\x7a\x79\x84\x4e\x88\x54\x14\x65\x3a\x30\xb9\x56\x6d\xa2\xb9\xd1\xeb\x6e\x8a\xbb\x30\xbb\x2a\xed\xa9\x63\x78\xe1\x16\xb8\x41\x56\xc1\xb9\xba\x9c\xdd\x03\x4b\xaf\x3a\xd7\xde\x28\x14\x40\xe9\x1e\x65\x78\x7f\xcf\xde\x9d\xe6\x74\x8b\x3e\x3f\x8d\x1f\x87\x4f\xdf\xd0\xdf\x47\xdf\xd0\x61\x72\x96\xd6\x67\x2e\xc6\x68\xdc\xe1\xe9\x98\x7f\x0e\x2c\xd3\xa1\xd4\xbd\x52\x8f\x7e\xf5\xf6\x8e\xe0\x0a\x44\x47\xda\x10\x2c\xae\x02\x59\x27\x45\xce\xb6\x25\x3b\x4c\xef\x86\x54\x0e\xc8\x63\x56\xea\x4e\x49\x8f\xfb\xbe\x43\x6b\x8e\x25\x54\x1e\x51\x5e\xc7\x4a\xb6\x3a\xaf\x83\xb4\x26\x2f\xef\x84\xa7\x8b\x06\x2d\x6d\xd5\xe4\xef\xda\x74\xa6\x9c\xa8\x1b\x99\x7a\x52\x6a\x4a\x05\x15\x6f\x32\x25\x9a\xd1\xd7\xa0\xf4\xea\x91\xf1\x1b\x53\x72\x58\x72\xd3\x96\x73\xcb\xf0\x79\x36\x9e\xfc\x0d\x2d\xa2\x00\xe3\x2c\xd0\xf0\x23\x09\xe7\x7d\xad\xfa\x4c\x9f\x27\x63\x58\x0f\x53\xc2\x7c\x58\xca\x94\x96\x89\x0a\xe4\xe2\xb0\x17\xcb\xf5\x11\x37\xe2\x31\xef\x9f\x35\x35\x62\x0e\x41\x68\x70\x4b\xbc\x45\x93\xc5\xc2\xfd\x4a\x0d\x95\x47\x8e\xbe\x41\xd7\x82\x19\x2d\x25\x6b\xd1\x2a\x17\xa0\x79\x6c\x92\xd7\xfe\x5a\xf0\x89\x11\xf4\x18\x95\xaa\xdb\xfd\x6a\x21\x5b\xb6\x60\x74\x30\xb2\x5c\x34\xc2\x9d\x29\xff\x15\x18\x1f\x1e\xe6\x77\xcf\x8e\x7f\xf9\x05\xf5\xc8\xf7\x05\x7a\x37\x37\xa4\xf0\x7b\x74\xd4\x47\x95\xe7\x91\x97\x3d\xd5\xd3\xa5\xe9\x2c\x92\x28\x94\xcd\x20\xb1\x56\x3c\xb5\x68\xb3\x8c\x7d\x76\xf9\x99\x6a\x59\x55\x53\x24\xad\xd2\x9a\xad\x60\xb5\x55\x97\x06\x88\x3a\xa3\x17\x67\x2a\x05\xe6\x9c\x31\xcc\x53\x2c\xb5\x54\x1c\x8b\x74\xc7\xbc\xe1\xe4\x2f\x44\xcc\x2a\xa2\xcc\x04\xe9\xfd\x4a\xee\x0a\xcb\xbe\xec\xdc\x92\x55\x09\x8e\x8d\x07\xe9\xcd\xac\x02\x2f\xde\x1d\x8d\x7e\x7a\xc9\x4a\x44\x36\xaf\x40\xb7\xa4\x69\x5b\xda\x04\xf3\x93\xe1\x3e\xf7\x62\x89\x82\x74\xfa\x7e\x7a\x17\xbc\x13\x2c\x96\xba\xe0\x40\xa0\x91\x26\x7c\x05\xd2\x57\xf1\xbb\x50\x20\xc1\x12\x2c\x16\x0d\x55\x28\x1e\xf3\x57\x95\x60\x3e\x3c\xd0\x34\xec\x30\x18\x4d\x8d\x2f\x37\x74\xe9\x4b\x0a\x6d\x6d\x5d\x84\x63\x29\xa7\xb7\x03\x0b\x1c\xf9\x8c\xaa\x5f\x83\x68\x4f\xab\x82\xa9\x97\x37\xf0\x08\x32\xdf\xb5\x68\x3c\xac\x39\x46\x73\x97\x54\xb8\x9f\xfa\xf3\x1d\x2d\xad\xaa\xec\x80\x55\x2d\xbb\x80\xac\x95\xf2\x4b\x3f\x5a\xf2\x6e\xb4\x8b\x83\xc1\x67\xac\x6f\x68\xf6\x0b\x2d\x4d\xfd\x44\x0d\xad\xc5\x18\x7d\xf9\x34\x7a\x1a\x41\x22\x21\xba\x99\xfd\x33\x8a\x02\xf2\x32\xe3\xf4\x09\x1d\x0a\x6f\x60\x27\x42\x0a\xfd\xcb\x1f\xb7\xe9\x46\xf5\x12\xaa\x72\x0d\xe5\x6e\xd0\x34\xbe\xe2\xd3\x0d\x5b\x1e\xb4\x32\x16\x66\x92\xfa\xbc\xbb\x9e\x0c\x05\xe8\x26\xc1\x5b\xff\x3b\x4d\x9d\x1b\xba\x72\xe7\x59\x49\xbf\xd4\x40\x5f\x19\xf6\xb3\x55\xef\x65\x7f\xf6\x9a\xbb\x4a\x13\x46\x56\x5f\x09\xee\x67\xbc\xde\x4b\x1b\xee\xed\x7d\x95\x5a\xbc\x46\xfa\xfa\x65\x5f\x39\x7b\x2f\x9d\xb2\xeb\x6a\x2a\x3d\x84\x35\x19\xc5\xd7\xdd\x3a\x25\x5e\x46\xe7\x66\x93\x75\x27\xb8\xf4\xc3\x76\xdd\xcc\x70\x59\x17\x3a\x3a\xd4\x4a\x92\x38\x9f\xf9\x7b\x17\x2d\x4a\x2b\x98\x90\xbb\x7a\x11\xe3\x7c\xd6\xb0\x53\xb7\xa9\xe2\x37\xce\x9b\x65\x1f\x72\x6c\x6a\x65\x09\xa6\x32\x45\x38\x3c\x4c\xef\xad\xd3\xa2\x4a\xe8\x39\xc9\x8b\x63\xd5\x2a\x8d\x48\xb0\x52\xa8\x11\x09\x96\x6a\x35\x15\xd1\x85\xb7\x5b\x6f\x22\xad\xee\x0b\xa2\x72\x02\x05\xd1\x72\xb9\x28\xcd\x09\xa9\x33\xfe\x8c\xce\xce\x98\x01\x13\x7d\xf1\x94\xd4\x7a\x7c\x07\x47\x98\x8e\xc4\xff\x02\x00\x00\xff\xff\x19\x99\x73\x7a\x1e\x55\x00\x00")
+var _blankHorizonSql = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xc4\x5d\x51\x6f\xdb\xb8\xb2\x7e\xf7\xaf\x20\xce\x8b\x13\x5c\xa7\x37\x6e\xda\x34\x4d\xd0\x03\x78\x13\xf5\x6c\x70\x52\x67\x37\x76\xee\x6e\xb1\x58\x08\xb4\x44\xdb\x3c\x95\x45\x55\xa4\x93\x78\x2f\xee\x7f\xbf\xa0\x4c\x49\x94\x48\x8a\x94\xac\x74\x1f\x13\x0e\xbf\xf9\x86\x9c\x19\x0e\x29\x4a\x3e\x39\x19\x9c\x9c\x80\x5f\x08\x65\xab\x14\xcd\x7e\xbd\x03\x21\x64\x70\x01\x29\x02\xe1\x76\x93\x0c\x4e\x4e\x06\xbc\xfd\x66\xbb\x49\x50\x08\x96\x29\xd9\x94\x02\x4f\x28\xa5\x98\xc4\xe0\xe3\x9b\xf7\x6f\x4e\x25\xa9\xc5\x0e\x24\x2b\x9f\x77\xaf\x89\x0c\x66\xde\x1c\x50\x06\x19\xda\xa0\x98\xf9\x0c\x6f\x10\xd9\x32\xf0\x09\x9c\x5e\x65\x4d\x11\x09\xbe\xa9\xff\x0d\x22\xcc\xa5\x51\x1c\x90\x10\xc7\x2b\xf0\x09\x0c\x1f\xe7\x9f\x2f\x86\x57\x39\x5c\x1c\xc2\x34\xf4\x03\x12\x2f\x49\xba\xc1\xf1\xca\xa7\x2c\xc5\xf1\x8a\x82\x4f\x80\xc4\x02\x63\x8d\x82\x6f\xfe\x72\x1b\x07\x0c\x93\xd8\x5f\x90\x10\x23\xde\xbe\x84\x11\x45\x15\x35\x1b\x1c\xfb\x1b\x44\x29\x5c\x65\x02\xcf\x30\x8d\x71\xbc\xda\x8b\xa4\xe4\xd9\xa7\x28\xd8\xa6\x98\xed\x38\xf8\x72\x79\x35\xc8\x1a\x28\x82\x69\xb0\xf6\x13\xc8\xd6\xe0\x13\x48\xb6\x8b\x08\x07\x23\x3e\x08\x01\x64\x30\x22\xab\xab\xc1\xe0\xe6\xe1\xfe\x17\x70\x3b\xbd\xf1\x7e\x07\xb7\x9f\x81\xf7\xfb\xed\x6c\x3e\x13\x92\x6f\x58\x0a\x43\xe4\xa3\xe5\x12\x05\x8c\xfa\x8b\x9d\x4f\xd2\x10\xa5\xfe\x82\x90\x6f\x57\x8d\x1d\x71\x1c\xa2\x17\x7f\x8d\x29\x23\xe9\xce\x67\x29\x8c\x29\xcc\x2c\xa4\x3e\x89\x7d\x1c\xb6\xe9\x4d\x12\x94\xc2\xa2\x2f\xdb\x25\xe8\x80\xde\x25\x93\x83\x58\xb4\xeb\x1b\xa1\x70\x85\xd2\xac\x23\x45\xdf\xb7\x28\x0e\x50\xc7\xee\x49\x8a\x9e\x30\xd9\x52\xf1\x3f\x7f\x0d\xe9\xba\x23\xd4\xe1\x08\x78\x93\x90\x94\xa1\xd4\x17\xc1\xd4\x15\xa6\xeb\x58\x06\x11\xa1\x28\xf4\x21\x6b\xd3\x3f\x77\xe6\x0e\xae\x04\x83\x80\x6c\x63\x46\xfd\x67\xcc\xd6\xdc\xa9\x31\xa3\x9d\xfa\xb7\x36\x5a\xee\x09\xc3\x30\x45\xd4\xa2\x78\xcd\x12\x1e\xae\x6b\x66\xd3\xb3\xa6\x95\x98\x58\xec\xac\xcc\xd6\x85\xf3\xb9\x08\x93\x3d\x0f\x62\x15\xc4\x94\xf9\xec\xc5\x4f\xec\x90\x5c\x92\x24\xae\x92\xc8\x55\x2c\xcf\x6e\xcd\xc2\x01\xd9\x6c\x30\xa5\x62\xac\xec\xc1\x53\x95\x87\x94\x22\xd6\xaa\xc3\x7e\xe2\x1d\x5c\x55\xdb\xaf\xb9\xcb\x22\x8f\x26\xab\x98\xdd\x4e\x57\x9d\xd9\x08\x50\x3f\x20\x21\xf2\x31\xa5\x5b\x94\x3a\xd8\x26\x90\x7d\xbe\x42\x63\xca\x70\x40\xf3\x28\xf0\x71\xf8\x72\x35\x98\xdc\xcd\xbd\x07\x30\x9f\xfc\x74\xe7\x49\x9d\xef\xa7\x77\x5f\x73\x04\xcd\x4a\xe4\x27\x30\x65\x38\xc0\x09\x8c\x19\x05\x19\xe7\xeb\xfb\xe9\x6c\xfe\x30\xb9\x9d\xce\x25\x18\x5b\x57\x3f\xf9\x86\x76\x6d\x38\x14\x2b\x49\x5b\x06\xfa\x8e\xce\xfa\x57\x24\x4d\xfc\x0d\x5e\x89\x65\xac\x41\x61\x4d\xd2\x59\x43\xe9\x83\x0d\xe0\x92\xa3\xba\xe2\x66\x4e\xd3\x00\x99\xb5\x3b\xb3\x54\xbd\xa9\x09\x5a\x75\xbd\xb6\x7a\x22\xbc\xc1\xcc\x45\xc7\x5e\xb0\x11\xbf\xe6\x4a\x46\x77\xde\xb3\xbb\xbe\xbf\x7b\xfc\x32\x05\x38\xdc\x2b\xbf\xf1\x3e\x4f\x1e\xef\xe6\x8e\xd8\x06\x37\x3d\x00\x59\x72\x8f\x03\x50\xb2\xc9\xb6\x00\x64\x7f\x59\xec\x93\xc6\x2e\x5f\x4c\x67\xde\xaf\x8f\xde\xf4\xba\xc3\x80\xfb\x38\xf4\x29\xfa\xde\x5a\x73\x05\xc4\xad\x77\x31\x2f\xee\xac\xf5\x53\xd9\x8a\xb3\x1e\xc2\xad\xaf\x28\xd9\xdc\x84\x45\x7d\xe6\x26\x2c\x22\xc7\xc2\xa3\x96\xce\x9a\x85\xe1\x36\xc4\xcc\xcf\xb6\x46\x4d\x62\x52\x3e\xe0\x54\xe0\x0a\x59\x67\x43\xed\x92\x17\xce\xf6\x69\x60\x2f\x74\xbb\xe0\x7b\x90\xd8\xa7\xdb\x85\x88\x22\x6a\x55\xf9\x8c\x16\x6b\x42\xbe\xf9\x21\x82\xa1\x1f\x21\xc6\x50\xea\x36\xed\xba\x8e\x2d\xb4\x45\xf8\x09\xa5\x18\xb5\xd5\x95\x77\x73\xd6\x44\xb7\x0b\x1a\xa4\x38\xc9\xe6\xb5\x95\xb2\x4a\x4f\xab\x3e\x69\xcd\x72\x51\x52\x8a\x5b\x91\xb3\x6c\xe6\xc4\x5c\xae\x1d\x4d\x22\x62\x21\x29\x3d\xcd\x4d\x7e\xbf\xf0\x08\x59\xef\xf7\xb9\x37\x9d\xdd\xde\x4f\x25\xf9\x35\xf7\x71\xd4\x20\x90\x44\xc9\x8a\x7e\x8f\x84\xc4\xec\xfa\x67\xef\xcb\x44\x6e\xce\xf4\x5d\xf1\x43\x9b\x93\x13\x30\x85\x1b\x74\x99\xff\x0f\xcc\x77\x09\xba\x14\x5d\xae\xc0\x2c\x58\xa3\x0d\xbc\x04\x27\x57\xe0\xfe\x39\x46\xe9\x25\xe0\x5d\x06\x83\xeb\x07\x6f\x32\xf7\x84\x58\x81\x37\xa8\x22\x0a\x12\x02\xb2\xe0\x69\x45\xad\x58\x34\xbd\x9f\xd7\xac\x02\xbf\xdd\xce\x7f\x2e\x54\xcb\x67\x27\x15\xf5\x25\x4a\x8d\xc8\xf5\xfd\x97\x2f\xde\x74\xde\x40\x63\x2f\x00\xee\xa7\x2a\x08\xb8\x9d\x81\xe1\x2f\x77\xff\x9d\xac\xf8\x19\x58\x92\x92\x00\x85\xdb\x14\x46\x20\x82\xf1\x6a\x0b\x57\x68\x58\xe7\x21\x26\xab\xb7\x51\xd8\xe3\x55\x07\x41\x3b\xfe\x25\x40\x95\x42\x37\xfb\x85\x5a\x6e\x3e\x3f\xd8\x03\x7c\x9b\x00\x96\x24\x05\xfc\xff\xfc\xb8\x8d\x6f\x24\x00\x59\x82\xa3\x6f\x68\x37\x02\x4f\x30\xda\xa2\x63\x90\x40\x9c\xd2\x6c\x48\x1c\x8f\xbf\xb8\x58\x88\x96\x70\x1b\x31\x9f\xc1\x45\x84\x68\x02\x03\xc4\xcf\xf2\x86\xb5\xd6\x6c\xd7\x4f\x70\x28\x1d\xcf\x55\xcc\xaf\x45\x93\x30\x3e\x0b\xd5\xd2\x74\x31\x72\xda\x09\xc8\x44\x6b\xd5\x20\x38\x1a\x00\x00\x80\xd8\xee\x80\x60\x0d\x53\x18\x30\x94\x82\x27\x98\xee\x70\xbc\x3a\x3a\x7f\x77\x9c\x4d\xd6\xf4\xf1\xee\x6e\xb4\x97\xe5\x89\x22\xdb\x61\x69\xc4\xc7\x6f\xeb\xe2\x1b\xf8\x22\x15\x08\xfc\xd8\x73\x81\x57\x38\x66\x79\x35\x05\x4e\x6b\x1d\x42\x88\xa3\x9d\x9f\x75\xb3\x0b\x6f\x48\xcc\xd6\x2d\xc4\x2b\x64\x70\x5c\x97\x1f\x9e\x8c\x87\x97\x97\x38\x66\x68\x85\x52\x23\xaf\x76\xfd\x64\x8a\xed\x7a\x66\xf3\x8d\x52\x5e\x11\xed\xb2\x6d\x2c\xa0\x1b\x18\x45\xae\xdd\x9f\x11\xfa\x66\x1e\x9a\xa6\x9e\x30\x8e\xb7\x30\xea\xd2\x53\xd2\x89\xe3\x8e\x2a\x5d\x3b\x0e\x8e\xaf\x06\xfa\x10\x91\x16\xa8\x43\xc3\xa4\x84\x7a\xfd\x50\x71\x98\xef\xba\x43\xef\x83\x05\xc7\x01\xd9\x20\x9b\xf3\xef\x65\xc9\x96\xb9\x08\x8b\x89\xc4\x71\x0b\x61\x47\xe8\x3c\x20\x70\xdc\x46\xda\x11\x5c\xf8\x11\x8e\x5b\x08\x3b\x42\x6f\x93\x10\xb2\xec\x40\x18\xf0\x67\x35\x94\xc1\x4d\x02\x78\xd6\xce\xfe\x04\x7f\x91\x18\x35\xf9\x26\xf7\x85\xee\xee\xc8\x7b\x0b\x0f\xc4\x61\xce\xb4\xca\x2f\xcb\x10\xfa\xe8\x72\x76\xc1\xfd\x99\x99\x93\x73\x63\xea\xc3\x98\xc4\xbb\x0d\xd9\x52\xb0\x20\x24\x42\x30\xb6\xd9\x9f\x57\xa2\x62\x18\xf2\xba\xd5\x6d\x24\x8a\x2a\x57\x86\xca\xa8\xcc\xe6\x93\x87\xf9\xbe\x82\x18\x67\xff\xb8\x9d\x5e\x3f\x78\xd9\x9a\xff\xd3\x57\xf1\xaf\xe9\x3d\xf8\x72\x3b\xfd\x9f\xc9\xdd\xa3\x57\xfc\x3d\xf9\xbd\xfc\xfb\x7a\x72\xfd\xb3\x07\xc6\x6d\x68\x83\xfb\xdf\xa6\xde\x0d\xf8\xe9\xab\x85\xff\xfe\x30\x41\x4b\xbf\x80\xd8\xff\xf7\x0d\x0e\x15\x02\xc5\x86\xb1\xb3\xef\xe4\x08\x16\xff\x81\x01\x23\x6e\x53\x4f\xb7\x8b\xff\xa0\x80\x39\xc9\xee\x4f\x33\x9c\x44\x37\x88\x41\xf0\x1f\x4a\xe2\x45\x11\x83\xc3\xff\xfd\xbf\xe1\xe5\xe5\xfe\x7f\x55\xe1\x20\x45\xd6\x68\xcc\x51\x62\xf2\x7c\x54\x57\xc6\x5e\xb2\x67\x53\x06\x62\x85\xfe\xe1\xe5\xa5\x22\x51\x43\x5a\xa0\x25\x49\x51\x76\x84\x87\xea\xf4\xe3\x6d\x14\x19\x0c\x80\x4b\xfe\x6c\xcb\xbd\xd7\xe0\xd8\xe8\x1a\x06\xff\x74\x73\x92\xd2\x2f\x6b\x70\xaf\x1e\x5a\x16\xfa\x5d\xc3\xab\x06\x2b\x85\x58\xde\xa2\x09\x33\x1c\xe6\xea\xc5\x2c\x38\x29\xdd\x07\x58\x76\x2a\x5b\xa8\x55\xce\x0e\x79\x91\x9f\x4f\x6d\x8c\x5e\xd8\x13\x8c\x8e\x86\x75\x96\xc3\xcb\xcb\x14\xad\x82\x08\x52\xda\x30\xcf\xd9\xd9\xad\xe0\x59\x9e\xf5\x76\xa6\x9a\xcd\xce\xe4\xe6\x46\x3e\x37\xae\x2a\x03\xbf\x3c\xdc\x7e\x99\x3c\x7c\x05\xff\xf6\xbe\x82\x23\x1c\x36\x70\xcb\x1e\xd1\x30\x92\xe6\xe3\x98\x3d\x6c\x71\x73\xc1\x4c\x54\x03\xc5\x77\x6c\xc5\x7f\xc1\xe3\xec\x76\xfa\x2f\xb0\x60\x29\x42\xe0\x28\x6b\x6f\x66\x23\xb2\x54\x3f\x7c\xf2\x94\x67\x66\x24\x24\x46\x22\xe3\x35\x73\x2b\xb3\x57\x3f\xf4\xa4\x6c\x68\x66\x58\x0a\x35\x93\x13\xa9\xb1\x1f\x66\x79\x9e\x35\xd3\x12\x12\xc7\xb5\xfd\xae\xe5\x3c\xb3\x96\x2c\xdc\xf8\x15\x49\xa2\x19\xfc\xb5\x33\x9f\xaa\xbd\xf3\xe2\xae\x42\x89\x55\x3e\x86\x1b\x5d\xc9\xf7\xf6\xfd\xfb\xfa\x4a\xb8\xc4\x28\x0a\xa9\xf3\xc2\x2b\xc6\x49\x5f\x43\xa0\x97\x04\xa7\x88\x36\xad\xca\x83\x63\xfb\x70\xf4\x94\xe8\x54\x60\x5d\xc6\x33\xa8\xaf\xa6\x3e\x3e\x9c\x2e\xc4\x17\x3b\xbf\x1c\x83\xce\x11\x64\x03\xe6\x89\x51\x95\xa9\x86\x55\x29\xad\xf0\x36\x1c\xf7\x77\xf5\x41\x3d\x9c\xf0\xc3\x6a\x91\xa5\x2b\x10\x5b\x6c\xa8\xf3\xeb\x4d\x7a\xe7\xcb\x1e\x5d\x30\x5b\x51\x68\xae\xa7\xf4\x76\xf4\xe4\x8b\x7a\x70\x9d\x3f\x36\xd0\xa8\xfa\x64\x9e\x35\x5d\x8c\x58\xec\x8a\x9b\x3d\x5d\x9d\xd2\x8a\xcc\xbd\x52\x2f\x54\xf5\x4c\x21\xde\x82\xba\x3c\xb3\x9d\x83\xca\x0d\xde\xd5\x08\xb9\x4f\x7d\xe9\xd2\x3f\x05\xea\x1a\x5e\x5a\x34\xcb\x5e\x6e\x9b\x46\x9a\x80\x1a\x9f\xbe\xd5\x84\x54\x90\x22\xe6\xb6\x52\x88\x43\x31\x8d\xf0\xf9\xbb\x63\xb1\x00\x3c\x21\x71\x9f\x88\xba\x61\x4a\x95\x8b\x31\x64\xf5\xfb\x38\x35\x80\xb5\x23\x25\x0a\xec\x03\x0b\x86\x26\xe8\xd7\x2e\x17\xda\x98\xd5\x71\xd3\xd4\xa4\xa2\xdc\x40\x69\xa5\x5e\x63\x33\xa5\x55\xe4\xb6\xb1\x6a\xb2\xa4\x69\x93\xa5\xef\xd7\x4f\xee\xd7\x62\xeb\x52\xbf\x99\x84\x75\x23\xa6\x79\xcc\x7d\x68\xc2\x29\xa1\x2c\xd9\x46\xa6\xeb\x9b\x84\xf6\x99\x01\x87\x9a\xb4\x30\x7e\x7b\x51\x4f\x0b\x65\x1e\xd1\xc8\x9f\x29\xe7\x96\x09\xdc\x45\x04\x86\x80\xa1\x17\x85\x1c\x83\x6c\xab\x4b\x46\xe3\x73\xe9\x9c\x27\x41\x31\xbf\x64\xef\x70\xdc\x03\x19\x43\x9b\x84\xd1\xe2\xa8\xd5\x74\x5e\xcc\xf7\xfa\xbe\x90\x76\xaa\x48\xf6\xdd\x22\x48\x99\x8f\xd2\x94\xa4\x7b\x6b\x0a\x8a\xc3\xcb\x4b\x8d\x79\xdd\xf3\xa7\x78\x2a\xb0\x9f\xe4\x66\x84\x81\x83\xbb\x89\x18\xab\x27\x24\x37\xc7\x53\x12\x91\x82\xfb\xa3\x92\xac\xd5\xa0\x03\x33\xac\x82\xaf\xa6\xd7\x52\xe4\x35\x73\x6b\xa9\xa5\x5d\x62\x55\x0c\x70\xc9\xaa\x52\xa7\x7e\x53\x6a\x09\xdc\x94\x4f\x6b\xea\x3b\x24\xd3\x4a\x4a\xe6\x86\x2f\x25\x33\x3e\xff\xfb\xef\xb0\x44\xc7\x08\x7c\xbe\x7f\xf0\x6e\xff\x35\xdd\x5b\x56\x93\x38\x06\x0f\xde\x67\xef\x81\xbb\xe3\xac\x00\x94\x65\x28\x3f\xe1\xe3\x05\xf0\x8d\x77\xe7\xcd\x3d\x70\x3d\x99\x5d\x4f\x6e\x3c\x87\xd1\xe1\xdb\x5e\x9e\xb1\x3b\x14\xe7\x8f\xd3\xdb\x5f\x1f\xf3\x1a\xbd\x01\x9a\xf3\x52\x9b\x95\xa2\x5c\x36\x78\x54\x2c\x3a\x2e\x33\xbc\xd8\xf9\xb5\x94\xdd\xc1\x9a\x26\x33\xea\x0b\x82\x8b\x41\xd9\xe2\x35\xaa\xaf\x25\x0d\xe6\x48\x17\xea\x0e\x5f\xff\x4b\x30\x4b\x05\x20\xf8\xef\x8c\xab\x7f\x6d\x66\x0e\xdd\xb5\xfc\x5d\xc5\x84\xb2\xf8\x37\xaf\xdd\xd5\xd6\x25\xc4\x51\xcf\x3b\x1d\x79\x8a\x44\x3a\xae\xaf\x57\x6e\x33\xaf\x59\xa7\x14\xe4\x1f\xb7\x04\x5b\x8d\x3a\x78\x11\x56\x34\xe8\x96\xe1\x52\xe8\x75\x17\xe2\x52\x4f\xdb\xa5\x58\x31\xc3\x6d\x31\x96\xba\xf5\xbd\x1c\x97\xd0\xcd\xcb\x58\x8d\x42\x8b\x25\x59\xea\xb9\x3f\x1a\x2a\x12\x4b\x0f\xf9\xda\x0c\x5e\x4d\xd7\xa5\x5c\xe3\x0a\xa4\x98\x21\xdd\xdd\xed\x9a\x9e\x4b\x08\x4b\x52\xfe\x86\x76\xbe\xf5\xf8\x95\x0b\x65\x97\x1b\xc5\x03\x80\x6a\xeb\x32\x82\xcc\x5f\x22\xeb\xdd\x98\x04\xa5\x01\x8a\x9d\x44\xf9\x8b\xc6\x2e\x62\xf0\xc5\x45\x8c\x61\x94\x2a\xcf\x2e\xfe\xf8\xd3\xf4\xec\x02\x46\xbc\x74\xe3\x6f\x73\x9b\x52\xb0\x2c\xb8\x8d\x19\x8e\x5a\x6c\x8a\xca\x99\x11\xd1\x58\x4f\x5b\x6e\xd3\x5c\xa4\x2b\x05\xef\xb5\x33\xb0\xd5\x80\x8e\x79\x57\xc1\x2d\xb3\x6d\xd9\xa4\xc9\xb1\xf5\x57\x2b\xba\x86\x4c\x0d\xa7\x8c\x1b\xdd\x12\x9f\x24\x11\x6e\xbb\x1d\x56\xde\x18\xe9\xca\xb4\x0e\x64\x09\xf1\xfc\xe4\xbd\xe1\x44\x56\x20\xf9\x0d\xd7\xc3\x16\xd9\x07\x08\xb2\x3b\x73\xfc\x33\x02\x09\xdc\xf1\xef\x14\x94\xb7\xba\xf2\xc0\xca\xae\x25\x6b\xfb\xee\xaf\xd0\xb5\xee\x9c\xdd\x65\xe6\x63\xcd\x2f\x3f\x89\x30\xd6\x52\x4a\x11\xa4\xc6\x5b\x44\xb9\x06\xa7\xcb\x3a\x55\xdc\xe6\x08\xd7\x76\x89\x09\x43\x4e\x27\x33\xf5\x91\xe9\xd9\x86\x12\xd8\xdd\x88\xb2\x8f\xb3\x15\x52\xe8\x26\x70\x87\xd2\x83\xf8\x9b\x03\x27\x7f\x2f\xeb\xd0\xb8\x11\x38\x22\x6c\x6a\xd1\x64\xdc\x78\xe4\x72\xd2\xdd\x70\x83\xe4\x3f\xb2\x4f\x54\xfc\xc3\x10\x48\x0d\x31\x16\x22\x06\x71\x24\x7c\xdc\x3c\x0e\xf9\xcb\x6c\x87\x8e\x83\xc0\x01\x47\xd5\xe7\xa6\x7a\x6e\xd2\xb7\x1a\x0c\xd3\x5b\x95\xd7\x7d\x26\x42\xdf\x51\x0c\x8b\xf4\x46\x62\x36\x11\x05\x0f\xd3\xb2\x5e\x4e\x84\x9b\x7c\xf1\xad\x86\x5a\x18\xf0\xf7\x0d\x8a\x48\x70\x3d\x39\xad\x74\x12\x3b\xd3\x24\x74\x96\x2d\x9c\x4c\xfc\x59\xfb\x8c\x85\x62\xcb\xb8\xc6\x8b\x11\x06\x23\x3f\x20\x38\xa6\x7a\x1f\x5c\x22\xe4\x27\x84\x44\xfa\x56\xfe\x0d\x9b\xac\x72\xca\xf5\x68\x9a\x53\x44\x51\xfa\x64\x12\xe1\xf7\xfe\xd9\x8b\xcf\x2f\xbe\x52\xfc\x97\x2a\x65\xf6\x5e\xc3\x6b\x9c\x87\x3a\xb3\x1e\x56\xf8\x36\x0e\x0d\x66\xa8\xbd\x6d\xe1\x6f\x4e\x13\x6d\x4d\x36\x94\x4f\x6e\xc6\x17\x65\x93\x93\x8e\xd7\xae\x09\x3b\x19\xda\xb1\x4e\x74\xd2\x55\xd6\x8e\xcd\xe2\x9a\x7a\x52\xe9\xd0\xa3\x6f\xda\x4a\x35\xf9\xb5\x6c\x93\x4c\x56\x7b\x06\xe2\x55\x29\xbe\xd0\x1c\xb8\xce\x88\xb3\x37\xb2\x4d\x03\x94\x7b\xb7\x21\xc3\x1f\xba\x80\x1b\xdf\x3a\x3f\x74\x80\x4d\xc0\xae\xe1\xef\x32\xee\x87\x24\x00\x13\x3f\x43\x64\xb8\x0d\x81\x12\x11\x16\x2d\x3f\x2a\x09\xb4\x34\xf6\xc0\x34\x60\xd1\xa6\x26\x02\x53\x87\x86\x54\x20\x75\xe9\xd5\x57\x73\xff\x94\xfe\xe5\x5e\x60\x89\xba\xca\x52\xb6\xb9\x66\x8b\xe6\xc0\xd7\xca\x96\xaa\xb5\xf1\x92\x55\x20\xd0\x18\x7a\xa6\xea\xed\x6f\xa9\xbf\xd8\x8b\x8f\xe2\x27\x14\x91\x44\x6c\x78\xaa\x2c\xd8\x8b\x9f\x22\xba\x8d\x98\xa1\x31\x7b\x29\x45\xdf\xc4\x47\xc1\xd4\x4c\xf1\x2a\x86\x6c\x9b\x6a\xaf\x41\x7d\x3c\x3f\xfe\xe3\xcf\xa2\xfe\xdb\xbf\xe3\xa2\x48\xfd\xf1\x67\x0d\x72\x83\x36\xc4\xf0\x10\xa3\xc4\x8a\x49\x8c\x1a\x33\x78\x89\xa5\xc2\x08\xcb\xf0\x06\xf9\x0b\xb2\x8d\xc3\xec\x8e\xc3\x45\x0a\xe3\x95\xe6\xc8\xe3\xe0\xf3\x6f\xfd\x97\x56\xb4\x07\xde\xf2\x2b\x53\x4d\x27\xdc\x07\x73\x2a\x77\xbb\x6e\xc4\xa4\xdd\xf1\x0f\x60\xa7\xd4\x1c\x95\x24\xe7\xc6\xb8\x19\xe3\x47\x5a\x21\x67\xc6\xee\x76\x98\x50\x1a\x2d\xb9\xe1\x6f\xfc\xf3\x97\xfd\xc5\xdb\x0f\xe6\x57\xeb\xc1\xcd\x64\x3e\xb1\x58\x68\x41\x2d\x2f\x6a\xf7\x86\xac\xbc\x4b\xda\x06\x4c\xc2\x10\x83\xa4\x2c\xdb\x33\xcf\x36\xab\x33\xef\xce\xbb\x9e\x4b\xdf\x3a\x78\x43\x91\x26\x56\x47\x60\x3c\xda\x9f\xfc\x99\x47\xbf\x8c\xa1\x03\x4d\x52\x82\xb1\x57\xbb\x14\x74\x27\xe3\x9a\x4e\xb0\x5d\x2c\xbc\x9d\xce\xbc\x87\x39\xb8\x9d\xce\xef\x95\x53\xec\xec\xe5\xd5\x19\x38\x1a\x8e\x7d\x1c\x63\x86\x61\xe4\xd3\x0c\xeb\x0d\xfd\x1e\x0d\x47\x60\xf8\xf6\x74\x7c\x7e\x72\x7a\x71\x72\x76\x0a\xc6\xe3\xcb\xf7\x17\x97\x6f\xdf\xbd\x19\x9f\x7e\x1c\x7f\xf8\xf8\x5f\xa7\x67\xc3\xe3\x2b\x37\xf4\xb7\xfe\xfe\x3b\x93\x95\xe8\x5a\xec\x7c\x46\x70\xd8\xa4\xe9\xed\xbb\x8f\x17\xe3\x71\x1b\x4d\x67\x3e\x5c\xad\x52\xb4\x82\x0c\xf1\xb7\x28\x50\x4c\x11\xf5\x97\x24\xcd\xeb\x72\xda\xa4\xee\xdd\xf9\xc5\xfb\x0f\xe7\x6d\xd4\x7d\x28\xea\xfd\xec\x1c\xba\x11\xfd\xfd\xd9\xf8\xf4\xc3\x45\x1b\xf4\x8b\x1a\xba\xcf\x9e\x89\xff\x0c\x77\x4d\x5a\xce\x2f\xce\xc6\xe3\x77\x6d\xb4\x7c\xf4\xc7\xe2\xf4\xbc\x09\xf7\xc3\x87\xf3\x8b\xf3\x0f\xed\x70\x4b\x7f\x6f\x42\xfe\x78\xfe\xee\xec\xfc\xbd\x40\x36\xc4\x40\x9e\xaa\xc5\x78\xf4\x96\x06\x6b\x67\xbe\x7d\xc3\xea\x8e\x62\x7b\x80\xd5\x2f\xbc\x9d\xb5\xb8\x80\xbf\x46\x3a\x74\xd2\xe8\x94\x22\x15\xa4\xde\x87\xdc\x54\x23\x74\xd6\xe3\x06\xff\x9a\xc3\x6e\xd1\xd9\x6a\xe0\x25\xac\x43\x87\xa4\x96\xf2\xfa\xb9\x5c\x52\x05\xd5\x5d\x2b\xd1\xa8\xad\x5e\x28\x11\xcf\x49\x47\xa2\xec\xe1\xdf\xc3\x18\xa9\xdf\x5b\x39\xbe\x32\x98\x53\x96\x70\x3d\xbd\x11\xa0\x02\x37\x99\x55\x53\xdf\x8f\x69\xe5\x47\x4d\x0f\xb7\x86\x63\x69\x0d\x28\x94\x58\xef\xf7\x48\xe5\x55\x3f\xa4\x4a\x40\x1d\xb3\x9a\x3a\x2b\xbd\xda\x42\xd9\xd3\xc0\xd5\x50\x75\x44\x75\x8a\xad\x6c\x95\x94\x5a\xcd\x11\xfd\x90\x6f\x56\xa2\xb3\xc5\x81\x96\xb3\x69\xc6\x04\xd8\xaf\x71\x26\x35\x4d\xe6\x35\x52\xb3\x1a\xa8\x09\x7a\x11\xe2\xd9\xd7\xa8\xbb\x5e\x71\x6b\x86\xe5\x97\xdb\x54\x89\xea\xd5\x36\x21\xae\x12\x36\x7c\x73\xfb\xc0\xab\xe0\x06\xd8\x8c\x29\x6f\xaa\x92\xe3\xda\x47\x60\x2f\x37\x02\xda\x8c\x27\x7d\x43\xbc\xeb\x20\x96\x10\x9c\x86\x66\xbe\xeb\x43\xb6\x17\x1e\x29\xc7\xaa\x3a\x72\x07\x7d\x73\x41\xf4\x77\xa3\x25\xb5\x14\x9f\x5e\xa8\xb3\x11\x9f\x6e\x3f\x80\xcf\x1e\xc1\x8d\x51\xed\xc0\x7b\xa4\x3e\x09\x53\x38\x4a\x19\xbc\x87\x99\xd5\xa2\x71\xee\x65\x43\x95\xf1\xd1\x51\x79\x73\xf2\xe4\x9f\xff\x04\xc3\x65\x4a\x36\xe2\x0a\xcd\xf1\xf1\x08\x28\xed\x8c\x14\xad\x6e\xb6\x74\x8d\xa2\x06\x83\x8a\x08\x32\x5b\xa5\x33\x2b\xeb\x56\xb0\x2f\xbe\xc4\x97\x59\xa9\x9a\x69\x92\xb6\x59\x2d\x1f\x6a\x1d\x6a\x2e\xc7\x6a\x35\x7b\x59\xb6\xa9\x32\xd7\xcc\x61\x59\x62\xd9\xa5\xf6\xb9\xc8\x75\xce\x3b\x06\x7f\x25\x63\xaa\x88\x4d\x43\x90\xdf\x0e\x1e\x81\xeb\xfb\xc9\x9d\x37\xbb\xf6\x8e\xca\xeb\xb1\x23\x30\x3c\xc1\xf1\x12\xc7\x98\xed\xb8\x09\x95\x67\x2e\xe5\x03\x97\x63\xa5\x73\x76\x17\x6d\x04\x86\x2e\x9d\x95\x21\xa9\xff\x54\xc6\x81\xe3\x51\x83\x93\x33\x51\x7e\x4d\xac\x32\x22\x6a\x79\xc2\x5f\x21\x12\x37\xbe\x4c\x64\x71\xd8\x13\x4d\x1c\x3a\x13\xcc\xa3\x99\xd3\xeb\x40\x3a\xff\x75\x93\x3e\x78\x0b\x2c\x99\x7a\xc9\x44\x2e\x7e\xba\x59\xa2\x37\x80\xbd\xf4\x67\x00\x7b\x51\x0c\x30\xd5\x6f\xee\x26\xc8\x08\x3a\x23\xa4\x9f\xad\x69\x6f\x83\x20\x5f\x62\x74\x1d\xfc\xe6\x81\xae\xfd\x0e\xcf\xa1\x63\x5d\x85\x93\x29\xe7\x57\x15\x2b\x1c\xf5\x8c\xe4\x71\xed\x8b\x96\x82\x29\x73\x93\x1a\x1d\x08\x4a\xbf\x8a\xd4\x9e\x97\x20\x54\x62\x74\x77\x49\x59\x5a\xc3\xd3\xfe\xe3\x4f\x07\x8e\xaa\x55\x81\x6c\x5a\xde\x5c\x35\x45\x08\xb6\xe0\x8e\xc3\xd7\xa3\x5d\x9d\x0c\x3d\x63\xf7\x81\x96\x7f\xdf\xab\x3d\xe5\x46\xae\x12\xb4\x13\x63\xf0\xdb\xcf\xde\x83\x07\x8e\x8e\x4c\xaf\x00\x7c\x02\x2c\xe5\x1f\x3e\xbf\x7f\x00\x47\xf5\x3b\xe4\x75\x21\x8b\xfd\xf5\x9f\x46\xeb\xc7\xf4\x1a\xaa\x6c\xb5\x68\xaa\x1a\xad\xdd\x1a\x56\x21\xb5\xbf\x01\xd7\x0f\x5b\x1d\xb4\x4c\x59\xb4\x57\x29\x17\x92\xee\xbc\xfb\x0e\x86\x0a\xb4\x95\xb0\x35\x14\x9a\x7e\xe5\xaf\xf7\x81\xae\x6b\xb0\xd3\xaf\x75\x70\x37\x46\x2c\x71\x7d\x14\xf3\x2e\x3a\xac\x96\x48\xb2\xee\x46\x68\x7f\x04\xf2\xb5\xac\xd1\xbe\x4a\x60\x33\x4b\xd7\xc9\xdd\xbe\xfc\x90\xe1\xd5\x66\x28\x57\x60\x9d\x9e\x5c\xd0\xc2\xbd\xa8\xd3\x5e\x25\xb4\xeb\xe8\x32\xeb\xb2\xad\x65\x80\x57\x41\xab\xf5\x48\x07\xfa\x76\xde\x55\x15\x2e\x36\x54\x7b\xb4\xb3\xa7\xbf\xe5\x4b\x05\x76\xe2\x6e\x5f\xc4\x24\xf3\x5e\xc5\x6d\x54\x7c\x99\xb8\xdc\x6a\x75\x9d\xa6\x9f\x01\xee\x3a\xca\x0d\x98\x32\x4f\x21\x50\xa5\x78\x74\x94\x5f\xa2\xcf\x0e\x7d\x28\x89\x42\xdf\x70\x3e\x64\x12\x54\x8e\x88\x4c\x82\xb5\x53\x22\x45\x74\x41\xb6\xab\x35\x73\x52\x5f\x11\x6d\x26\x50\x11\xad\x1f\x54\xe5\x35\x21\x37\x16\x7c\x02\x67\x67\xd2\x84\x99\x7e\x2f\x9b\x9f\xbf\x25\x11\x62\x68\x70\x72\x32\x18\xfc\xff\x00\xcc\xa8\x1a\x36\x5c\x7b\x00\x00")
 
 func blankHorizonSqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -271,7 +271,7 @@ func blankHorizonSql() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "blank-horizon.sql", size: 21790, mode: os.FileMode(420), modTime: time.Unix(1472739164, 0)}
+	info := bindataFileInfo{name: "blank-horizon.sql", size: 31580, mode: os.FileMode(420), modTime: time.Unix(1472739164, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -68,6 +68,16 @@ DROP TABLE IF EXISTS public.history_ledgers;
 DROP TABLE IF EXISTS public.history_effects;
 DROP TABLE IF EXISTS public.history_accounts;
 DROP TABLE IF EXISTS public.gorp_migrations;
+DROP TABLE IF EXISTS public.audit_log;
+DROP TABLE IF EXISTS public.statistics_storage;
+DROP SEQUENCE IF EXISTS public.statistics_storage_version_seq;
+DROP TABLE IF EXISTS public.txsub_open_submissions;
+DROP SEQUENCE IF EXISTS public.webhook_dead_letters_id_seq;
+DROP TABLE IF EXISTS public.webhook_dead_letters;
+DROP SEQUENCE IF EXISTS public.webhook_deliveries_id_seq;
+DROP TABLE IF EXISTS public.webhook_deliveries;
+DROP SEQUENCE IF EXISTS public.webhook_subscriptions_id_seq;
+DROP TABLE IF EXISTS public.webhook_subscriptions;
 DROP SEQUENCE IF EXISTS public.commission_id_seq;
 DROP TABLE IF EXISTS public.commission;
 DROP SEQUENCE IF EXISTS public.asset_id_seq;
@@ -130,7 +140,12 @@ CREATE TABLE account_limits (
     monthly_max_out bigint DEFAULT 0 NOT NULL,
     max_operation_in bigint DEFAULT '-1'::integer NOT NULL,
     daily_max_in bigint DEFAULT '-1'::integer NOT NULL,
-    monthly_max_in bigint DEFAULT '-1'::integer NOT NULL
+    monthly_max_in bigint DEFAULT '-1'::integer NOT NULL,
+    counterparty_type smallint DEFAULT '-1'::integer NOT NULL,
+    weekly_max_out bigint DEFAULT '-1'::integer NOT NULL,
+    annual_max_out bigint DEFAULT '-1'::integer NOT NULL,
+    weekly_max_in bigint DEFAULT '-1'::integer NOT NULL,
+    annual_max_in bigint DEFAULT '-1'::integer NOT NULL
 );
 
 
@@ -186,6 +201,338 @@ CREATE SEQUENCE asset_id_seq
 ALTER SEQUENCE asset_id_seq OWNED BY asset.id;
 
 
+--
+-- Name: audit_log; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE audit_log (
+    id bigint NOT NULL,
+    actor character varying(64) NOT NULL,
+    subject character varying(64) NOT NULL,
+    action character varying(64) NOT NULL,
+    meta jsonb DEFAULT '{}'::jsonb NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    tx_hash character varying(64) DEFAULT ''::character varying NOT NULL,
+    before_state jsonb DEFAULT 'null'::jsonb NOT NULL,
+    after_state jsonb DEFAULT 'null'::jsonb NOT NULL
+);
+
+
+--
+-- Name: audit_log_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+--
+
+CREATE SEQUENCE audit_log_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: audit_log_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: -
+--
+
+ALTER SEQUENCE audit_log_id_seq OWNED BY audit_log.id;
+
+
+--
+-- Name: id; Type: DEFAULT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY audit_log ALTER COLUMN id SET DEFAULT nextval('audit_log_id_seq'::regclass);
+
+
+--
+-- Name: audit_log_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY audit_log
+    ADD CONSTRAINT audit_log_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: audit_log_by_actor; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX audit_log_by_actor ON audit_log USING btree (actor);
+
+
+--
+-- Name: audit_log_by_subject; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX audit_log_by_subject ON audit_log USING btree (subject, action);
+
+
+--
+-- Name: audit_log_by_created_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX audit_log_by_created_at ON audit_log USING btree (created_at);
+
+
+--
+-- Name: audit_log_by_tx_hash; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX audit_log_by_tx_hash ON audit_log USING btree (tx_hash);
+
+--
+-- Name: statistics_storage_version_seq; Type: SEQUENCE; Schema: public; Owner: -
+--
+
+CREATE SEQUENCE statistics_storage_version_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: statistics_storage; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE statistics_storage (
+    name character varying(255) NOT NULL,
+    fields jsonb DEFAULT '{}'::jsonb NOT NULL,
+    version bigint NOT NULL,
+    expires_at timestamp with time zone
+);
+
+
+--
+-- Name: statistics_storage_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY statistics_storage
+    ADD CONSTRAINT statistics_storage_pkey PRIMARY KEY (name);
+
+
+--
+-- Name: statistics_storage_by_expires_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX statistics_storage_by_expires_at ON statistics_storage USING btree (expires_at);
+
+
+--
+-- Name: txsub_open_submissions; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE txsub_open_submissions (
+    hash character(64) NOT NULL,
+    address character varying(64) NOT NULL,
+    sequence bigint NOT NULL,
+    submitted_at timestamp with time zone NOT NULL
+);
+
+
+--
+-- Name: txsub_open_submissions_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY txsub_open_submissions
+    ADD CONSTRAINT txsub_open_submissions_pkey PRIMARY KEY (hash);
+
+
+--
+-- Name: txsub_open_submissions_by_address; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX txsub_open_submissions_by_address ON txsub_open_submissions USING btree (address);
+
+
+--
+-- Name: txsub_open_submissions_by_submitted_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX txsub_open_submissions_by_submitted_at ON txsub_open_submissions USING btree (submitted_at);
+
+--
+-- Name: webhook_subscriptions; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE webhook_subscriptions (
+    id bigint NOT NULL,
+    url character varying(1024) NOT NULL,
+    secret character varying(255) NOT NULL,
+    account character varying(64),
+    event_types character varying(255) NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
+--
+-- Name: webhook_subscriptions_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+--
+
+CREATE SEQUENCE webhook_subscriptions_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: webhook_subscriptions_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: -
+--
+
+ALTER SEQUENCE webhook_subscriptions_id_seq OWNED BY webhook_subscriptions.id;
+
+
+--
+-- Name: id; Type: DEFAULT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY webhook_subscriptions ALTER COLUMN id SET DEFAULT nextval('webhook_subscriptions_id_seq'::regclass);
+
+
+--
+-- Name: webhook_subscriptions_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY webhook_subscriptions
+    ADD CONSTRAINT webhook_subscriptions_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: webhook_deliveries; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE webhook_deliveries (
+    id bigint NOT NULL,
+    subscription_id bigint NOT NULL,
+    event_id character varying(128) NOT NULL,
+    event_type character varying(32) NOT NULL,
+    payload text NOT NULL,
+    status character varying(16) DEFAULT 'pending'::character varying NOT NULL,
+    attempts integer DEFAULT 0 NOT NULL,
+    next_attempt_at timestamp with time zone NOT NULL,
+    last_error text DEFAULT ''::text NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    delivered_at timestamp with time zone
+);
+
+
+--
+-- Name: webhook_deliveries_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+--
+
+CREATE SEQUENCE webhook_deliveries_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: webhook_deliveries_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: -
+--
+
+ALTER SEQUENCE webhook_deliveries_id_seq OWNED BY webhook_deliveries.id;
+
+
+--
+-- Name: id; Type: DEFAULT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY webhook_deliveries ALTER COLUMN id SET DEFAULT nextval('webhook_deliveries_id_seq'::regclass);
+
+
+--
+-- Name: webhook_deliveries_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY webhook_deliveries
+    ADD CONSTRAINT webhook_deliveries_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: webhook_deliveries_subscription_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY webhook_deliveries
+    ADD CONSTRAINT webhook_deliveries_subscription_id_fkey FOREIGN KEY (subscription_id) REFERENCES webhook_subscriptions(id) ON DELETE CASCADE;
+
+
+--
+-- Name: webhook_deliveries_by_event; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX webhook_deliveries_by_event ON webhook_deliveries USING btree (subscription_id, event_id);
+
+
+--
+-- Name: webhook_deliveries_by_next_attempt_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX webhook_deliveries_by_next_attempt_at ON webhook_deliveries USING btree (status, next_attempt_at);
+
+
+--
+-- Name: webhook_dead_letters; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE webhook_dead_letters (
+    id bigint NOT NULL,
+    delivery_id bigint NOT NULL,
+    subscription_id bigint NOT NULL,
+    url character varying(1024) NOT NULL,
+    event_id character varying(128) NOT NULL,
+    event_type character varying(32) NOT NULL,
+    payload text NOT NULL,
+    attempts integer NOT NULL,
+    last_error text NOT NULL,
+    failed_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
+--
+-- Name: webhook_dead_letters_id_seq; Type: SEQUENCE; Schema: public; Owner: -
+--
+
+CREATE SEQUENCE webhook_dead_letters_id_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+--
+-- Name: webhook_dead_letters_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: -
+--
+
+ALTER SEQUENCE webhook_dead_letters_id_seq OWNED BY webhook_dead_letters.id;
+
+
+--
+-- Name: id; Type: DEFAULT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY webhook_dead_letters ALTER COLUMN id SET DEFAULT nextval('webhook_dead_letters_id_seq'::regclass);
+
+
+--
+-- Name: webhook_dead_letters_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY webhook_dead_letters
+    ADD CONSTRAINT webhook_dead_letters_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: webhook_dead_letters_by_subscription; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX webhook_dead_letters_by_subscription ON webhook_dead_letters USING btree (subscription_id);
+
+
 --
 -- Name: commission; Type: TABLE; Schema: public; Owner: -
 --
@@ -195,7 +542,12 @@ CREATE TABLE commission (
     key_hash character(64) NOT NULL,
     key_value jsonb NOT NULL,
     flat_fee bigint DEFAULT 0 NOT NULL,
-    percent_fee bigint DEFAULT 0 NOT NULL
+    percent_fee bigint DEFAULT 0 NOT NULL,
+    min_fee bigint DEFAULT 0 NOT NULL,
+    max_fee bigint DEFAULT 0 NOT NULL,
+    tiers jsonb DEFAULT '[]'::jsonb NOT NULL,
+    valid_from timestamp with time zone,
+    valid_until timestamp with time zone
 );
 
 
@@ -238,7 +590,14 @@ CREATE TABLE history_accounts (
     account_type integer NOT NULL,
     block_incoming_payments boolean DEFAULT false NOT NULL,
     block_outcoming_payments boolean DEFAULT false NOT NULL,
-    limited_assets jsonb
+    limited_assets jsonb,
+    block_incoming_reason character varying(64) DEFAULT ''::character varying NOT NULL,
+    block_incoming_until timestamp with time zone,
+    block_incoming_note text DEFAULT ''::text NOT NULL,
+    block_outcoming_reason character varying(64) DEFAULT ''::character varying NOT NULL,
+    block_outcoming_until timestamp with time zone,
+    block_outcoming_note text DEFAULT ''::text NOT NULL,
+    commission_payer character varying(64) DEFAULT ''::character varying NOT NULL
 );
 
 
@@ -518,7 +877,7 @@ SELECT pg_catalog.setval('history_transaction_participants_id_seq', 1, false);
 --
 
 ALTER TABLE ONLY account_limits
-    ADD CONSTRAINT account_limits_pkey PRIMARY KEY (address, asset_code);
+    ADD CONSTRAINT account_limits_pkey PRIMARY KEY (address, asset_code, counterparty_type);
 
 
 --
@@ -629,7 +988,7 @@ CREATE INDEX commission_by_asset ON commission USING btree (((key_value ->> 'ass
 -- Name: commission_by_hash; Type: INDEX; Schema: public; Owner: -
 --
 
-CREATE UNIQUE INDEX commission_by_hash ON commission USING btree (key_hash);
+CREATE UNIQUE INDEX commission_by_hash ON commission USING btree (key_hash, COALESCE(valid_from, '-infinity'::timestamp with time zone), COALESCE(valid_until, 'infinity'::timestamp with time zone));
 
 
 --
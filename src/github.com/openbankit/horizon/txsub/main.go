@@ -25,6 +25,29 @@ type SequenceProvider interface {
 	Get(addresses []string) (map[string]uint64, error)
 }
 
+// PriorityProvider decides, whether submissions of an account are buffered in the priority
+// lane of the submission queue
+type PriorityProvider interface {
+	IsPriority(address string) (bool, error)
+}
+
+// StatisticsReservations commits or releases account statistics reserved by transaction
+// during its validation.
+type StatisticsReservations interface {
+	// Commit is called, when transaction is applied by stellar-core
+	Commit(txHash string) int
+
+	// Release is called, when transaction is rejected by stellar-core
+	Release(txHash string, now time.Time) error
+}
+
+// ResultObserver is notified of final results of open submissions, i.e. transactions applied
+// or rejected by stellar-core. With shared storage, the same result may be observed by several
+// horizon instances.
+type ResultObserver interface {
+	Finished(context.Context, Result)
+}
+
 // Listener represents some client who is interested in retrieving the result
 // of a specific transaction.
 type Listener chan<- Result
@@ -54,6 +77,30 @@ type OpenSubmissionList interface {
 	Pending(context.Context) []string
 }
 
+// SharedStorage stores open submissions, so that they are shared between horizon instances
+// and survive restart. Listeners are always kept in memory of instance the client is
+// connected to, while the storage makes any instance aware of pending transactions and
+// sequences of their source accounts.
+type SharedStorage interface {
+	// AddSubmission stores transaction accepted by stellar-core
+	AddSubmission(hash string, address string, sequence uint64, submittedAt time.Time) error
+
+	// DeleteSubmission removes submission, when its result is found
+	DeleteSubmission(hash string) error
+
+	// DeleteSubmissionsBefore removes submissions submitted before the provided time
+	DeleteSubmissionsBefore(submittedAt time.Time) error
+
+	// IsSubmitted returns true, if submission with the hash is stored
+	IsSubmitted(hash string) (bool, error)
+
+	// Submissions returns hashes of all stored submissions
+	Submissions() ([]string, error)
+
+	// Sequences returns max sequence of stored submissions for each of the addresses having any
+	Sequences(addresses []string) (map[string]uint64, error)
+}
+
 // Submitter represents the low-level "submit a transaction to stellar-core"
 // provider.
 type Submitter interface {
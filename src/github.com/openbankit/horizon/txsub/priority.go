@@ -0,0 +1,41 @@
+package txsub
+
+import (
+	"database/sql"
+
+	"github.com/openbankit/go-base/xdr"
+	"github.com/openbankit/horizon/cache"
+)
+
+// PriorityAccountTypes are types of accounts, which submissions use priority lane of the
+// submission queue by default, so that they are not starved by bursts of user submissions.
+var PriorityAccountTypes = []xdr.AccountType{
+	xdr.AccountTypeAccountBank,
+	xdr.AccountTypeAccountDistributionAgent,
+	xdr.AccountTypeAccountSettlementAgent,
+	xdr.AccountTypeAccountExchangeAgent,
+}
+
+// AccountTypePriority implements PriorityProvider, giving priority to accounts of the provided types
+type AccountTypePriority struct {
+	Accounts *cache.HistoryAccount
+	Types    []xdr.AccountType
+}
+
+// IsPriority implements PriorityProvider. Accounts not ingested yet have no priority.
+func (p *AccountTypePriority) IsPriority(address string) (bool, error) {
+	account, err := p.Accounts.Get(address)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, err
+	}
+
+	for _, accountType := range p.Types {
+		if account.AccountType == accountType {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -3,6 +3,7 @@ package results
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/openbankit/go-base/xdr"
 	"github.com/openbankit/horizon/admin"
@@ -183,7 +184,11 @@ func (err *ExceededLimitError) Error() string {
 // RestrictedForAccountError represent an error that occurred because
 // operation is restricted for specified accounts
 type RestrictedForAccountError struct {
-	Reason  string
+	Reason string
+	// BlockReason is a code of the reason, account is blocked for. Empty, if not specified by administrator
+	BlockReason string
+	// BlockedUntil is time, when block expires. Nil, if block is permanent
+	BlockedUntil *time.Time
 }
 
 func (err *RestrictedForAccountError) Error() string {
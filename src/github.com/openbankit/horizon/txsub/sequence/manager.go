@@ -11,17 +11,34 @@ import (
 // registered using the Push() method, and as the system is updated with
 // account sequence information (through the Update() method) requests are
 // notified that they can safely submit to stellar-core.
+//
+// Submissions are buffered in two lanes with separate capacities: the default
+// one and the priority one, registered using PushPriority(). Lane of an address
+// is chosen when its first submission is buffered. To keep a single address
+// from filling its lane, number of its submissions can be limited.
 type Manager struct {
-	mutex   sync.Mutex
+	mutex sync.Mutex
+	// MaxSize is a max number of submissions buffered in the default lane
 	MaxSize int
-	queues  map[string]*Queue
+	// MaxAccountSize is a max number of submissions buffered for an address in
+	// either lane. Zero means no limit.
+	MaxAccountSize int
+	// MaxPrioritySize is a max number of submissions buffered in the priority lane.
+	// If zero, priority submissions are buffered in the default lane.
+	MaxPrioritySize int
+	queues          map[string]*Queue
+	priority        map[string]bool
 }
 
+// DefaultMaxSize is a default capacity of the default lane
+const DefaultMaxSize = 1024
+
 // NewManager returns a new manager
 func NewManager() *Manager {
 	return &Manager{
-		MaxSize: 1024, //TODO: make MaxSize configurable
-		queues:  map[string]*Queue{},
+		MaxSize:  DefaultMaxSize,
+		queues:   map[string]*Queue{},
+		priority: map[string]bool{},
 	}
 }
 
@@ -45,6 +62,14 @@ func (m *Manager) Size() int {
 	return m.size()
 }
 
+// PrioritySize returns the count of submissions buffered within the priority
+// lane of this manager.
+func (m *Manager) PrioritySize() int {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return m.laneSize(true)
+}
+
 func (m *Manager) Addresses() []string {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
@@ -59,19 +84,48 @@ func (m *Manager) Addresses() []string {
 
 // Push registers an intent to submit a transaction for the provided address at
 // the provided sequence.  A channel is returned that will be written to when
-// the requester should attempt the submission.
+// the requester should attempt the submission. If the lane of the address is
+// full, ErrNoMoreRoom is written. If the address has too many submissions
+// buffered, ErrAccountQueueFull is written.
 func (m *Manager) Push(address string, sequence uint64) <-chan error {
+	return m.push(address, sequence, false)
+}
+
+// PushPriority registers an intent to submit a transaction the same way Push
+// does, but buffers submissions of the address in the priority lane.
+func (m *Manager) PushPriority(address string, sequence uint64) <-chan error {
+	return m.push(address, sequence, m.MaxPrioritySize > 0)
+}
+
+func (m *Manager) push(address string, sequence uint64, priority bool) <-chan error {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
-	if m.size() >= m.MaxSize {
-		return m.getError(ErrNoMoreRoom)
+	aq, ok := m.queues[address]
+	if ok {
+		priority = m.priority[address]
+	}
+
+	if priority {
+		if m.laneSize(true) >= m.MaxPrioritySize {
+			return m.getError(ErrNoMoreRoom)
+		}
+	} else {
+		if m.laneSize(false) >= m.MaxSize {
+			return m.getError(ErrNoMoreRoom)
+		}
+	}
+
+	if ok && m.MaxAccountSize > 0 && aq.Size() >= m.MaxAccountSize {
+		return m.getError(ErrAccountQueueFull)
 	}
 
-	aq, ok := m.queues[address]
 	if !ok {
 		aq = NewQueue()
 		m.queues[address] = aq
+		if priority {
+			m.priority[address] = true
+		}
 	}
 
 	return aq.Push(sequence)
@@ -92,6 +146,7 @@ func (m *Manager) Update(updates map[string]uint64) {
 		queue.Update(seq)
 		if queue.Size() == 0 {
 			delete(m.queues, address)
+			delete(m.priority, address)
 		}
 	}
 }
@@ -107,6 +162,19 @@ func (m *Manager) size() int {
 	return result
 }
 
+// laneSize returns the count of submissions buffered within the lane. This
+// internal version assumes you have locked the manager previously.
+func (m *Manager) laneSize(priority bool) int {
+	var result int
+	for address, q := range m.queues {
+		if m.priority[address] == priority {
+			result += q.Size()
+		}
+	}
+
+	return result
+}
+
 func (m *Manager) getError(err error) <-chan error {
 	ch := make(chan error, 1)
 	ch <- err
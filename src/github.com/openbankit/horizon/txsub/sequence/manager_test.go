@@ -49,5 +49,52 @@ func TestManager(t *testing.T) {
 			So(mgr.Size(), ShouldEqual, 1024)
 			So(<-mgr.Push("1", 2), ShouldEqual, ErrNoMoreRoom)
 		})
+
+		Convey("Push returns ErrAccountQueueFull when address has too many submissions", func() {
+			mgr.MaxAccountSize = 2
+			mgr.Push("1", 2)
+			mgr.Push("1", 3)
+
+			So(<-mgr.Push("1", 4), ShouldEqual, ErrAccountQueueFull)
+			So(len(mgr.Push("2", 2)), ShouldEqual, 0)
+			So(mgr.Size(), ShouldEqual, 3)
+		})
+
+		Convey("PushPriority", func() {
+			mgr.MaxSize = 2
+			mgr.MaxPrioritySize = 1
+			mgr.Push("1", 2)
+			mgr.Push("2", 2)
+			So(<-mgr.Push("3", 2), ShouldEqual, ErrNoMoreRoom)
+
+			// priority lane is not affected by full default lane
+			result := mgr.PushPriority("4", 2)
+			So(len(result), ShouldEqual, 0)
+			So(mgr.Size(), ShouldEqual, 3)
+			So(mgr.PrioritySize(), ShouldEqual, 1)
+			So(<-mgr.PushPriority("5", 2), ShouldEqual, ErrNoMoreRoom)
+
+			// lane of address is kept while it has buffered submissions
+			So(<-mgr.Push("4", 3), ShouldEqual, ErrNoMoreRoom)
+			So(<-mgr.PushPriority("1", 3), ShouldEqual, ErrNoMoreRoom)
+
+			mgr.Update(map[string]uint64{"4": 1})
+			So(<-result, ShouldBeNil)
+			So(mgr.PrioritySize(), ShouldEqual, 0)
+
+			Convey("limits number of submissions of address in priority lane", func() {
+				mgr.MaxPrioritySize = 3
+				mgr.MaxAccountSize = 1
+				mgr.PushPriority("4", 2)
+
+				So(<-mgr.PushPriority("4", 3), ShouldEqual, ErrAccountQueueFull)
+				So(mgr.PrioritySize(), ShouldEqual, 1)
+			})
+
+			Convey("uses default lane when priority lane is disabled", func() {
+				mgr.MaxPrioritySize = 0
+				So(<-mgr.PushPriority("5", 2), ShouldEqual, ErrNoMoreRoom)
+			})
+		})
 	})
 }
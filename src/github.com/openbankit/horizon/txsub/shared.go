@@ -0,0 +1,133 @@
+package txsub
+
+import (
+	"time"
+
+	"github.com/openbankit/horizon/log"
+	"github.com/openbankit/horizon/txsub/transactions"
+	"golang.org/x/net/context"
+)
+
+// This file contains helpers combining in-memory open submission list of the system
+// with optional shared storage. Failures of shared storage are logged and do not
+// affect submissions made through this instance.
+
+// pending returns hashes of open submissions of this instance and of shared storage
+func (sys *System) pending(ctx context.Context) []string {
+	hashes := sys.Pending.Pending(ctx)
+	if sys.Shared == nil {
+		return hashes
+	}
+
+	shared, err := sys.Shared.Submissions()
+	if err != nil {
+		log.Ctx(ctx).WithStack(err).Error("Failed to load shared open submissions")
+		return hashes
+	}
+
+	known := make(map[string]bool, len(hashes))
+	for _, hash := range hashes {
+		known[hash] = true
+	}
+
+	for _, hash := range shared {
+		if !known[hash] {
+			known[hash] = true
+			hashes = append(hashes, hash)
+		}
+	}
+	return hashes
+}
+
+// isPending returns true, if transaction is open submission of this or any other instance
+func (sys *System) isPending(ctx context.Context, hash string) bool {
+	for _, pending := range sys.Pending.Pending(ctx) {
+		if pending == hash {
+			return true
+		}
+	}
+
+	if sys.Shared == nil {
+		return false
+	}
+
+	isSubmitted, err := sys.Shared.IsSubmitted(hash)
+	if err != nil {
+		log.Ctx(ctx).WithField("hash", hash).WithStack(err).Error("Failed to look up shared open submission")
+		return false
+	}
+	return isSubmitted
+}
+
+// addPending registers listener of transaction accepted by stellar-core and shares submission
+// with other instances
+func (sys *System) addPending(ctx context.Context, info *transactions.EnvelopeInfo, l Listener) error {
+	err := sys.Pending.Add(ctx, info.ContentHash, l)
+	if err != nil || sys.Shared == nil {
+		return err
+	}
+
+	err = sys.Shared.AddSubmission(info.ContentHash, info.SourceAddress, info.Sequence, time.Now())
+	if err != nil {
+		log.Ctx(ctx).WithField("hash", info.ContentHash).WithStack(err).Error("Failed to share open submission")
+	}
+	return nil
+}
+
+// finishPending forwards result to listeners of this instance and removes shared submission.
+// Listeners of other instances are finished by their own ticks. Observer, if any, is notified
+// of the result.
+func (sys *System) finishPending(ctx context.Context, r Result) error {
+	if sys.Observer != nil {
+		sys.Observer.Finished(ctx, r)
+	}
+
+	if sys.Shared != nil {
+		err := sys.Shared.DeleteSubmission(r.Hash)
+		if err != nil {
+			log.Ctx(ctx).WithField("hash", r.Hash).WithStack(err).Error("Failed to remove shared open submission")
+		}
+	}
+	return sys.Pending.Finish(ctx, r)
+}
+
+// cleanPending removes open submissions, which timed out
+func (sys *System) cleanPending(ctx context.Context) error {
+	if sys.Shared != nil {
+		err := sys.Shared.DeleteSubmissionsBefore(time.Now().Add(-sys.SubmissionTimeout))
+		if err != nil {
+			log.Ctx(ctx).WithStack(err).Error("Failed to clean shared open submissions")
+		}
+	}
+
+	_, err := sys.Pending.Clean(ctx, sys.SubmissionTimeout)
+	return err
+}
+
+// sequences returns current sequences of the accounts. If shared storage is used, sequences of transactions
+// accepted by stellar-core through any instance are taken into account, so that submission queues
+// of all instances proceed in the same order: submission buffered by one instance is released as soon as
+// its predecessor is accepted through another one. Buffered submissions themselves stay in memory of the
+// instance the client waits on, as nobody else can respond to it.
+func (sys *System) sequences(ctx context.Context, addresses []string) (map[string]uint64, error) {
+	current, err := sys.Sequences.Get(addresses)
+	if err != nil || sys.Shared == nil {
+		return current, err
+	}
+
+	submitted, err := sys.Shared.Sequences(addresses)
+	if err != nil {
+		log.Ctx(ctx).WithStack(err).Error("Failed to load shared sequences")
+		return current, nil
+	}
+
+	result := make(map[string]uint64, len(current))
+	for address, seq := range current {
+		result[address] = seq
+		// accounts missing in stellar-core are left absent
+		if submittedSeq, ok := submitted[address]; ok && submittedSeq > seq {
+			result[address] = submittedSeq
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,44 @@
+package txsub
+
+import (
+	"github.com/openbankit/horizon/txsub/results"
+	"golang.org/x/net/context"
+)
+
+// TransactionStatus describes state of the submitted transaction
+type TransactionStatus string
+
+const (
+	// TransactionPending - transaction is accepted by stellar-core and waits to be included into ledger
+	TransactionPending TransactionStatus = "pending"
+	// TransactionApplied - transaction is successfully applied
+	TransactionApplied TransactionStatus = "applied"
+	// TransactionFailed - transaction is included into ledger, but failed
+	TransactionFailed TransactionStatus = "failed"
+	// TransactionUnknown - transaction is neither pending nor included into ledger: it was never
+	// submitted to this horizon, was rejected by stellar-core or its submission timed out
+	TransactionUnknown TransactionStatus = "unknown"
+)
+
+// Status returns state of the transaction with the provided hash. Result is set for applied and
+// failed transactions. Error is returned, if result provider failed to look up the transaction.
+func (sys *System) Status(ctx context.Context, hash string) (TransactionStatus, Result, error) {
+	r := sys.Results.ResultByHash(ctx, hash)
+	if r.Err == nil {
+		return TransactionApplied, r, nil
+	}
+
+	if _, ok := r.Err.(*results.FailedTransactionError); ok {
+		return TransactionFailed, r, nil
+	}
+
+	if r.Err != results.ErrNoResults {
+		return "", r, r.Err
+	}
+
+	if sys.isPending(ctx, hash) {
+		return TransactionPending, r, nil
+	}
+
+	return TransactionUnknown, r, nil
+}
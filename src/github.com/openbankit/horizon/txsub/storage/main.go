@@ -0,0 +1,100 @@
+// Package storage provides an implementation of the txsub.SharedStorage interface
+// backed by the horizon database, which is shared by all horizon instances.
+package storage
+
+import (
+	"fmt"
+	"time"
+
+	sq "github.com/lann/squirrel"
+	"github.com/openbankit/horizon/db2"
+)
+
+// Storages of open submissions
+const (
+	StorageMemory   = "memory"
+	StoragePostgres = "postgres"
+)
+
+// minServerVersion is the first postgres version supporting INSERT ... ON CONFLICT
+const minServerVersion = 90500
+
+// DB stores open submissions in `txsub_open_submissions` table of horizon db. Requires postgres 9.5 or later.
+type DB struct {
+	Repo *db2.Repo
+}
+
+// CheckVersion returns error, if horizon db does not support the queries of the storage
+func (s *DB) CheckVersion() error {
+	var version int
+	err := s.Repo.GetRaw(&version, "SELECT current_setting('server_version_num')::integer")
+	if err != nil {
+		return err
+	}
+
+	if version < minServerVersion {
+		return fmt.Errorf("postgres 9.5 or later is required to share open submissions, server version is %d", version)
+	}
+	return nil
+}
+
+type submittedSequence struct {
+	Address  string `db:"address"`
+	Sequence uint64 `db:"sequence"`
+}
+
+// AddSubmission implements txsub.SharedStorage
+func (s *DB) AddSubmission(hash string, address string, sequence uint64, submittedAt time.Time) error {
+	_, err := s.Repo.ExecRaw(`INSERT INTO txsub_open_submissions (hash, address, sequence, submitted_at)
+		VALUES (?, ?, ?, ?) ON CONFLICT (hash) DO NOTHING`,
+		hash, address, int64(sequence), submittedAt)
+	return err
+}
+
+// DeleteSubmission implements txsub.SharedStorage
+func (s *DB) DeleteSubmission(hash string) error {
+	_, err := s.Repo.ExecRaw("DELETE FROM txsub_open_submissions WHERE hash = ?", hash)
+	return err
+}
+
+// DeleteSubmissionsBefore implements txsub.SharedStorage
+func (s *DB) DeleteSubmissionsBefore(submittedAt time.Time) error {
+	_, err := s.Repo.ExecRaw("DELETE FROM txsub_open_submissions WHERE submitted_at < ?", submittedAt)
+	return err
+}
+
+// IsSubmitted implements txsub.SharedStorage
+func (s *DB) IsSubmitted(hash string) (bool, error) {
+	var count int
+	err := s.Repo.GetRaw(&count, "SELECT COUNT(*) FROM txsub_open_submissions WHERE hash = ?", hash)
+	return count > 0, err
+}
+
+// Submissions implements txsub.SharedStorage
+func (s *DB) Submissions() ([]string, error) {
+	var hashes []string
+	err := s.Repo.SelectRaw(&hashes, "SELECT hash FROM txsub_open_submissions")
+	return hashes, err
+}
+
+// Sequences implements txsub.SharedStorage
+func (s *DB) Sequences(addresses []string) (map[string]uint64, error) {
+	result := make(map[string]uint64)
+	if len(addresses) == 0 {
+		return result, nil
+	}
+
+	var rows []submittedSequence
+	err := s.Repo.Select(&rows, sq.Select("address", "MAX(sequence) AS sequence").
+		From("txsub_open_submissions").
+		Where(sq.Eq{"address": addresses}).
+		GroupBy("address"))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		result[row.Address] = row.Sequence
+	}
+	return result, nil
+}
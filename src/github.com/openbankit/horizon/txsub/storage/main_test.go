@@ -0,0 +1,56 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openbankit/horizon/test"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDB(t *testing.T) {
+	tt := test.Start(t).Scenario("base")
+	defer tt.Finish()
+
+	Convey("DB shared storage", t, func() {
+		storage := &DB{Repo: tt.HorizonRepo()}
+		So(storage.CheckVersion(), ShouldBeNil)
+		now := time.Now()
+		hash1 := "1111111111111111111111111111111111111111111111111111111111111111"
+		hash2 := "2222222222222222222222222222222222222222222222222222222222222222"
+
+		So(storage.AddSubmission(hash1, "GA", 10, now.Add(-time.Minute)), ShouldBeNil)
+		So(storage.AddSubmission(hash2, "GA", 11, now), ShouldBeNil)
+		// duplicate is ignored
+		So(storage.AddSubmission(hash2, "GA", 11, now), ShouldBeNil)
+
+		hashes, err := storage.Submissions()
+		So(err, ShouldBeNil)
+		So(hashes, ShouldHaveLength, 2)
+
+		isSubmitted, err := storage.IsSubmitted(hash1)
+		So(err, ShouldBeNil)
+		So(isSubmitted, ShouldBeTrue)
+		isSubmitted, err = storage.IsSubmitted("3333333333333333333333333333333333333333333333333333333333333333")
+		So(err, ShouldBeNil)
+		So(isSubmitted, ShouldBeFalse)
+
+		sequences, err := storage.Sequences([]string{"GA", "GB"})
+		So(err, ShouldBeNil)
+		So(sequences, ShouldResemble, map[string]uint64{"GA": 11})
+
+		Convey("delete submission", func() {
+			So(storage.DeleteSubmission(hash2), ShouldBeNil)
+			sequences, err := storage.Sequences([]string{"GA"})
+			So(err, ShouldBeNil)
+			So(sequences, ShouldResemble, map[string]uint64{"GA": 10})
+		})
+
+		Convey("delete old submissions", func() {
+			So(storage.DeleteSubmissionsBefore(now.Add(-time.Second)), ShouldBeNil)
+			hashes, err := storage.Submissions()
+			So(err, ShouldBeNil)
+			So(hashes, ShouldResemble, []string{hash2})
+		})
+	})
+}
@@ -7,7 +7,6 @@ import (
 	"time"
 
 	"github.com/openbankit/go-base/xdr"
-	"github.com/openbankit/horizon/accounttypes"
 	"github.com/openbankit/horizon/cache"
 	"github.com/openbankit/horizon/commissions"
 	conf "github.com/openbankit/horizon/config"
@@ -36,10 +35,11 @@ func NewDefaultSubmitter(
 	url string,
 	coreDb *core.Q,
 	historyDb *history.Q,
-	config *conf.Config,
+	config conf.Provider,
 	sharedCache *cache.SharedCache,
+	statsManager statistics.ManagerInterface,
 ) Submitter {
-	return createSubmitter(h, url, coreDb, historyDb, config, sharedCache)
+	return createSubmitter(h, url, coreDb, historyDb, config, sharedCache, statsManager)
 }
 
 // coreSubmissionResponse is the json response from stellar-core's tx endpoint
@@ -57,22 +57,22 @@ type submitter struct {
 	coreURL  string
 	coreQ    *core.Q
 	historyQ *history.Q
-	config   *conf.Config
+	config   conf.Provider
 	Log      *log.Entry
 
 	defaultTxValidator TransactionValidatorInterface
 	commissionManager  *commissions.CommissionsManager
 }
 
-func createSubmitter(h *http.Client, url string, coreDb *core.Q, historyDb *history.Q, config *conf.Config, sharedCache *cache.SharedCache) *submitter {
+func createSubmitter(h *http.Client, url string, coreDb *core.Q, historyDb *history.Q, config conf.Provider, sharedCache *cache.SharedCache, statsManager statistics.ManagerInterface) *submitter {
 	return &submitter{
 		http:               h,
 		coreURL:            url,
 		coreQ:              coreDb,
 		historyQ:           historyDb,
 		config:             config,
-		commissionManager:  commissions.New(sharedCache, historyDb),
-		defaultTxValidator: NewTransactionValidator(transactions.NewManager(coreDb, historyDb, statistics.NewManager(historyDb, accounttype.GetAll(), config), config, sharedCache)),
+		commissionManager:  commissions.New(sharedCache, historyDb).SetCommissionAccount(config.Config().BankCommissionKey),
+		defaultTxValidator: NewTransactionValidator(transactions.NewManager(coreDb, historyDb, statsManager, config, sharedCache)),
 		Log:                log.WithField("service", "submitter"),
 	}
 }
@@ -86,6 +86,10 @@ func (sub *submitter) Submit(ctx context.Context, env *transactions.EnvelopeInfo
 	sub.Log.Debug("Setting commission")
 	err := sub.commissionManager.SetCommissions(env.Tx)
 	if err != nil {
+		if sponsorshipErr, ok := err.(*commissions.SponsorshipError); ok {
+			result.Err = sponsorshipErr
+			return
+		}
 		log.WithField("Error", err).Error("Failed to set commissions")
 		result.Err = &problem.ServerError
 		return
@@ -2,6 +2,7 @@ package txsub
 
 import (
 	"github.com/openbankit/go-base/build"
+	"github.com/openbankit/horizon/accounttypes"
 	"github.com/openbankit/horizon/cache"
 	"github.com/openbankit/horizon/config"
 	"github.com/openbankit/horizon/db2/core"
@@ -9,6 +10,7 @@ import (
 	"github.com/openbankit/horizon/log"
 	"github.com/openbankit/horizon/test"
 	"github.com/openbankit/horizon/txsub/results"
+	"github.com/openbankit/horizon/txsub/transactions/statistics"
 	. "github.com/smartystreets/goconvey/convey"
 	"github.com/stretchr/testify/mock"
 	"net/http"
@@ -117,7 +119,7 @@ func TestDefaultSubmitter(t *testing.T) {
 func createSubmitterWithTxV(h *http.Client, url string, coreDb *core.Q, historyDb *history.Q, config *config.Config, txValidator TransactionValidatorInterface) *submitter {
 	sub := createSubmitter(h, url, coreDb, historyDb, config, &cache.SharedCache{
 		AccountHistoryCache: cache.NewHistoryAccount(historyDb),
-	})
+	}, statistics.NewManager(historyDb, accounttype.GetAll(), config))
 	sub.defaultTxValidator = txValidator
 	return sub
 }
@@ -22,8 +22,12 @@ type System struct {
 	Pending           OpenSubmissionList
 	Results           ResultProvider
 	Sequences         SequenceProvider
+	Priority          PriorityProvider
 	Submitter         Submitter
 	SubmissionQueue   *sequence.Manager
+	Statistics        StatisticsReservations
+	Shared            SharedStorage
+	Observer          ResultObserver
 	NetworkPassphrase string
 	SubmissionTimeout time.Duration
 
@@ -36,6 +40,18 @@ type System struct {
 		// behind this system's SubmissionQueue
 		BufferedSubmissionsGauge metrics.Gauge
 
+		// PriorityBufferedSubmissionsGauge tracks the count of submissions buffered
+		// in the priority lane of this system's SubmissionQueue
+		PriorityBufferedSubmissionsGauge metrics.Gauge
+
+		// QueueFullMeter tracks the rate of submissions rejected, because lane of
+		// SubmissionQueue was full
+		QueueFullMeter metrics.Meter
+
+		// AccountQueueFullMeter tracks the rate of submissions rejected, because
+		// source account had too many submissions buffered
+		AccountQueueFullMeter metrics.Meter
+
 		// OpenSubmissionsGauge tracks the count of "open" submissions (i.e.
 		// submissions whose transactions haven't been confirmed successful or failed
 		OpenSubmissionsGauge metrics.Gauge
@@ -50,12 +66,37 @@ type System struct {
 	}
 }
 
+// AsyncSubmission is returned by SubmitAsync
+type AsyncSubmission struct {
+	// Hash of the submitted transaction. Empty, if envelope is malformed
+	Hash string
+
+	// Pending is true, if transaction was accepted by stellar-core and waits to be
+	// included into ledger. Its state can be then checked using Status.
+	Pending bool
+
+	// Result emits result of the submission. If submission is not pending, result is
+	// already available.
+	Result <-chan Result
+}
+
 // Submit submits the provided base64 encoded transaction envelope to the
 // network using this submission system.
 func (sys *System) Submit(ctx context.Context, env string) (result <-chan Result) {
+	return sys.submit(ctx, env).Result
+}
+
+// SubmitAsync submits the provided base64 encoded transaction envelope the same way
+// Submit does, but returns as soon as transaction is accepted by stellar-core without
+// waiting for it to be included into ledger.
+func (sys *System) SubmitAsync(ctx context.Context, env string) AsyncSubmission {
+	return sys.submit(ctx, env)
+}
+
+func (sys *System) submit(ctx context.Context, env string) (submission AsyncSubmission) {
 	sys.Init()
 	response := make(chan Result, 1)
-	result = response
+	submission.Result = response
 
 	// calculate hash of transaction
 	info, err := extractEnvelopeInfo(ctx, env, sys.NetworkPassphrase)
@@ -63,6 +104,7 @@ func (sys *System) Submit(ctx context.Context, env string) (result <-chan Result
 		sys.finish(response, Result{Err: err, EnvelopeXDR: env})
 		return
 	}
+	submission.Hash = info.ContentHash
 
 	// check the configured result provider for an existing result
 	r := sys.Results.ResultByHash(ctx, info.ContentHash)
@@ -72,7 +114,15 @@ func (sys *System) Submit(ctx context.Context, env string) (result <-chan Result
 		return
 	}
 
-	curSeq, err := sys.Sequences.Get([]string{info.SourceAddress})
+	// if transaction was already accepted by stellar-core through another instance,
+	// wait for its result instead of resubmitting it
+	if sys.Shared != nil && sys.isPending(ctx, info.ContentHash) {
+		sys.Pending.Add(ctx, info.ContentHash, response)
+		submission.Pending = true
+		return
+	}
+
+	curSeq, err := sys.sequences(ctx, []string{info.SourceAddress})
 	if err != nil {
 		sys.finish(response, Result{Err: err, EnvelopeXDR: env})
 		return
@@ -87,7 +137,12 @@ func (sys *System) Submit(ctx context.Context, env string) (result <-chan Result
 
 	// queue the submission and get the channel that will emit when
 	// submission is valid
-	seq := sys.SubmissionQueue.Push(info.SourceAddress, info.Sequence)
+	var seq <-chan error
+	if sys.isPriority(ctx, info.SourceAddress) {
+		seq = sys.SubmissionQueue.PushPriority(info.SourceAddress, info.Sequence)
+	} else {
+		seq = sys.SubmissionQueue.Push(info.SourceAddress, info.Sequence)
+	}
 
 	// update the submission queue with the source accounts current sequence value
 	// which will cause the channel returned by Push() to emit if possible.
@@ -95,9 +150,14 @@ func (sys *System) Submit(ctx context.Context, env string) (result <-chan Result
 
 	select {
 	case err := <-seq:
-		if err == sequence.ErrBadSequence {
+		switch err {
+		case sequence.ErrBadSequence:
 			// convert the internal only ErrBadSequence into the FailedTransactionError
 			err = results.ErrBadSequence
+		case sequence.ErrNoMoreRoom:
+			sys.Metrics.QueueFullMeter.Mark(1)
+		case sequence.ErrAccountQueueFull:
+			sys.Metrics.AccountQueueFullMeter.Mark(1)
 		}
 
 		if err != nil {
@@ -110,7 +170,8 @@ func (sys *System) Submit(ctx context.Context, env string) (result <-chan Result
 		// if submission succeeded
 		if sr.Err == nil {
 			// add transactions to open list
-			sys.Pending.Add(ctx, info.ContentHash, response)
+			sys.addPending(ctx, &info, response)
+			submission.Pending = true
 			// update the submission queue, allowing the next submission to proceed
 			sys.SubmissionQueue.Update(map[string]uint64{info.SourceAddress: info.Sequence})
 			return
@@ -124,6 +185,7 @@ func (sys *System) Submit(ctx context.Context, env string) (result <-chan Result
 		}
 
 		if !isBad {
+			sys.settleStatistics(ctx, info.ContentHash, sr.Err)
 			sys.finish(response, Result{Err: sr.Err, EnvelopeXDR: env})
 			return
 		}
@@ -133,8 +195,11 @@ func (sys *System) Submit(ctx context.Context, env string) (result <-chan Result
 
 		if r.Err == nil {
 			// If the found use it as the result
+			sys.settleStatistics(ctx, info.ContentHash, nil)
 			sys.finish(response, r)
 		} else {
+			// transaction is rejected by stellar-core
+			sys.settleStatistics(ctx, info.ContentHash, sr.Err)
 			// finally, return the bad_seq error if no result was found on 2nd attempt
 			sys.finish(response, Result{Err: sr.Err, EnvelopeXDR: env})
 		}
@@ -182,7 +247,7 @@ func (sys *System) Tick(ctx context.Context) {
 
 	addys := sys.SubmissionQueue.Addresses()
 	if len(addys) > 0 {
-		curSeq, err := sys.Sequences.Get(addys)
+		curSeq, err := sys.sequences(ctx, addys)
 		if err != nil {
 			logger.WithStack(err).Error(err)
 		} else {
@@ -190,12 +255,13 @@ func (sys *System) Tick(ctx context.Context) {
 		}
 	}
 
-	for _, hash := range sys.Pending.Pending(ctx) {
+	for _, hash := range sys.pending(ctx) {
 		r := sys.Results.ResultByHash(ctx, hash)
 
 		if r.Err == nil {
 			logger.WithField("hash", hash).Debug("finishing open submission")
-			sys.Pending.Finish(ctx, r)
+			sys.settleStatistics(ctx, hash, nil)
+			sys.finishPending(ctx, r)
 			continue
 		}
 
@@ -203,7 +269,8 @@ func (sys *System) Tick(ctx context.Context) {
 
 		if ok {
 			logger.WithField("hash", hash).Debug("finishing open submission")
-			sys.Pending.Finish(ctx, r)
+			sys.settleStatistics(ctx, hash, r.Err)
+			sys.finishPending(ctx, r)
 			continue
 		}
 
@@ -212,13 +279,16 @@ func (sys *System) Tick(ctx context.Context) {
 		}
 	}
 
-	stillOpen, err := sys.Pending.Clean(ctx, sys.SubmissionTimeout)
+	// statistics reserved by timed out submissions are kept: transaction may still be applied
+	err := sys.cleanPending(ctx)
 	if err != nil {
 		logger.WithStack(err).Error(err)
 	}
+	stillOpen := sys.pending(ctx)
 
-	sys.Metrics.OpenSubmissionsGauge.Update(int64(stillOpen))
+	sys.Metrics.OpenSubmissionsGauge.Update(int64(len(stillOpen)))
 	sys.Metrics.BufferedSubmissionsGauge.Update(int64(sys.SubmissionQueue.Size()))
+	sys.Metrics.PriorityBufferedSubmissionsGauge.Update(int64(sys.SubmissionQueue.PrioritySize()))
 }
 
 // Init initializes `sys`
@@ -229,6 +299,9 @@ func (sys *System) Init() {
 		sys.Metrics.SubmissionTimer = metrics.NewTimer()
 		sys.Metrics.OpenSubmissionsGauge = metrics.NewGauge()
 		sys.Metrics.BufferedSubmissionsGauge = metrics.NewGauge()
+		sys.Metrics.PriorityBufferedSubmissionsGauge = metrics.NewGauge()
+		sys.Metrics.QueueFullMeter = metrics.NewMeter()
+		sys.Metrics.AccountQueueFullMeter = metrics.NewMeter()
 
 		if sys.SubmissionTimeout == 0 {
 			sys.SubmissionTimeout = 1 * time.Minute
@@ -236,6 +309,45 @@ func (sys *System) Init() {
 	})
 }
 
+// isPriority returns true, if submissions of the account must use priority lane of the submission queue.
+// Failure to decide is logged and the default lane is used.
+func (sys *System) isPriority(ctx context.Context, address string) bool {
+	if sys.Priority == nil {
+		return false
+	}
+
+	priority, err := sys.Priority.IsPriority(address)
+	if err != nil {
+		log.Ctx(ctx).WithField("address", address).WithStack(err).Error("Failed to check submission priority")
+		return false
+	}
+	return priority
+}
+
+// settleStatistics commits statistics reserved by transaction, if it was applied (err is nil),
+// and releases them, if it failed. Reservation is kept, while result of the transaction is unknown
+// (submission timed out or was canceled, no result is ingested yet), and expires with its processed ops.
+func (sys *System) settleStatistics(ctx context.Context, hash string, err error) {
+	if sys.Statistics == nil {
+		return
+	}
+
+	switch err {
+	case results.ErrTimeout, results.ErrCanceled, results.ErrNoResults:
+		return
+	}
+
+	if err == nil {
+		sys.Statistics.Commit(hash)
+		return
+	}
+
+	err = sys.Statistics.Release(hash, time.Now())
+	if err != nil {
+		log.Ctx(ctx).WithField("hash", hash).WithStack(err).Error("Failed to release reserved statistics")
+	}
+}
+
 func (sys *System) finish(response chan<- Result, r Result) {
 	response <- r
 	close(response)
@@ -24,6 +24,7 @@ func TestTxsub(t *testing.T) {
 		submitter := &MockSubmitter{}
 		results := &MockResultProvider{}
 		sequences := &MockSequenceProvider{}
+		reservations := &MockStatisticsReservations{}
 
 		system := &System{
 			Pending:           NewDefaultSubmissionList(),
@@ -31,6 +32,7 @@ func TestTxsub(t *testing.T) {
 			Results:           results,
 			Sequences:         sequences,
 			SubmissionQueue:   sequence.NewManager(),
+			Statistics:        reservations,
 			NetworkPassphrase: build.TestNetwork.Passphrase,
 		}
 
@@ -67,6 +69,8 @@ func TestTxsub(t *testing.T) {
 				So(system.Metrics.SuccessfulSubmissionsMeter.Count(), ShouldEqual, 0)
 				So(system.Metrics.FailedSubmissionsMeter.Count(), ShouldEqual, 1)
 				So(system.Metrics.SubmissionTimer.Count(), ShouldEqual, 1)
+				So(reservations.Released, ShouldResemble, []string{successTx.Hash})
+				So(reservations.Committed, ShouldBeEmpty)
 			})
 
 			Convey("if the error is bad_seq and the result at the transaction's sequence number is for the same hash, return result", func() {
@@ -78,6 +82,7 @@ func TestTxsub(t *testing.T) {
 				So(r.Err, ShouldBeNil)
 				So(r.Hash, ShouldEqual, successTx.Hash)
 				So(submitter.WasSubmittedTo, ShouldBeTrue)
+				So(reservations.Committed, ShouldResemble, []string{successTx.Hash})
 			})
 
 			Convey("if error is bad_seq and no result is found, return error", func() {
@@ -86,6 +91,7 @@ func TestTxsub(t *testing.T) {
 
 				So(r.Err, ShouldNotBeNil)
 				So(submitter.WasSubmittedTo, ShouldBeTrue)
+				So(reservations.Released, ShouldResemble, []string{successTx.Hash})
 			})
 
 			Convey("if no result found and no error submitting, add to open transaction list", func() {
@@ -99,6 +105,204 @@ func TestTxsub(t *testing.T) {
 			})
 		})
 
+		Convey("SubmitAsync", func() {
+			Convey("returns pending submission, if transaction is accepted by stellar-core", func() {
+				submission := system.SubmitAsync(ctx, successTx.EnvelopeXDR)
+
+				So(submission.Pending, ShouldBeTrue)
+				So(submission.Hash, ShouldEqual, successTx.Hash)
+				So(len(submission.Result), ShouldEqual, 0)
+				So(system.Pending.Pending(ctx), ShouldResemble, []string{successTx.Hash})
+			})
+
+			Convey("returns finished submission, if submission failed", func() {
+				submitter.R.Err = errors.New("busted for some reason")
+				submission := system.SubmitAsync(ctx, successTx.EnvelopeXDR)
+
+				So(submission.Pending, ShouldBeFalse)
+				So(submission.Hash, ShouldEqual, successTx.Hash)
+				r := <-submission.Result
+				So(r.Err, ShouldNotBeNil)
+			})
+
+			Convey("returns finished submission, if envelope is malformed", func() {
+				submission := system.SubmitAsync(ctx, "not an envelope")
+
+				So(submission.Pending, ShouldBeFalse)
+				So(submission.Hash, ShouldEqual, "")
+				r := <-submission.Result
+				So(r.Err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("Status", func() {
+			Convey("applied, if result is found", func() {
+				results.Results = []Result{successTx}
+				status, r, err := system.Status(ctx, successTx.Hash)
+				So(err, ShouldBeNil)
+				So(status, ShouldEqual, TransactionApplied)
+				So(r.LedgerSequence, ShouldEqual, successTx.LedgerSequence)
+			})
+
+			Convey("failed, if failed result is found", func() {
+				results.Results = []Result{{Err: subResults.ErrBadSequence}}
+				status, r, err := system.Status(ctx, successTx.Hash)
+				So(err, ShouldBeNil)
+				So(status, ShouldEqual, TransactionFailed)
+				So(r.Err, ShouldEqual, subResults.ErrBadSequence)
+			})
+
+			Convey("pending, if transaction is in open submission list", func() {
+				system.Pending.Add(ctx, successTx.Hash, make(chan Result, 1))
+				status, _, err := system.Status(ctx, successTx.Hash)
+				So(err, ShouldBeNil)
+				So(status, ShouldEqual, TransactionPending)
+			})
+
+			Convey("unknown, if transaction is not found", func() {
+				status, _, err := system.Status(ctx, successTx.Hash)
+				So(err, ShouldBeNil)
+				So(status, ShouldEqual, TransactionUnknown)
+			})
+
+			Convey("returns error of result provider", func() {
+				results.Results = []Result{{Err: errors.New("db is down")}}
+				_, _, err := system.Status(ctx, successTx.Hash)
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("with shared storage", func() {
+			shared := &MockSharedStorage{}
+			system.Shared = shared
+			otherHash := "1111111111111111111111111111111111111111111111111111111111111111"
+
+			Convey("Submit shares accepted transaction", func() {
+				submission := system.SubmitAsync(ctx, successTx.EnvelopeXDR)
+				So(submission.Pending, ShouldBeTrue)
+				So(shared.Submitted[successTx.Hash].Address, ShouldEqual, account.Address())
+				So(shared.Submitted[successTx.Hash].Sequence, ShouldEqual, 1)
+			})
+
+			Convey("Submit waits for transaction accepted by another instance", func() {
+				shared.AddSubmission(successTx.Hash, account.Address(), 1, time.Now())
+				submission := system.SubmitAsync(ctx, successTx.EnvelopeXDR)
+
+				So(submission.Pending, ShouldBeTrue)
+				So(submitter.WasSubmittedTo, ShouldBeFalse)
+				So(system.Pending.Pending(ctx), ShouldResemble, []string{successTx.Hash})
+
+				status, _, err := system.Status(ctx, successTx.Hash)
+				So(err, ShouldBeNil)
+				So(status, ShouldEqual, TransactionPending)
+			})
+
+			Convey("Submit uses sequences of transactions accepted by another instance", func() {
+				shared.AddSubmission(otherHash, account.Address(), 1, time.Now())
+				r := <-system.Submit(ctx, successTx.EnvelopeXDR)
+
+				So(r.Err, ShouldEqual, subResults.ErrBadSequence)
+				So(submitter.WasSubmittedTo, ShouldBeFalse)
+			})
+
+			Convey("Tick releases submission waiting for transaction accepted by another instance", func() {
+				nextTx := getResultWithSequence(account, 2)
+				result := make(chan Result, 1)
+				go func() {
+					result <- <-system.Submit(ctx, nextTx.EnvelopeXDR)
+				}()
+
+				for system.SubmissionQueue.Size() == 0 {
+					time.Sleep(time.Millisecond)
+				}
+				So(submitter.WasSubmittedTo, ShouldBeFalse)
+
+				shared.AddSubmission(otherHash, account.Address(), 1, time.Now())
+				system.Tick(ctx)
+
+				for len(system.Pending.Pending(ctx)) == 0 {
+					time.Sleep(time.Millisecond)
+				}
+				results.Results = []Result{nextTx}
+				system.Tick(ctx)
+
+				r := <-result
+				So(r.Err, ShouldBeNil)
+				So(submitter.WasSubmittedTo, ShouldBeTrue)
+				So(system.SubmissionQueue.Size(), ShouldEqual, 0)
+			})
+
+			Convey("Status looks up shared submission by hash", func() {
+				shared.AddSubmission(otherHash, account.Address(), 1, time.Now())
+				status, _, err := system.Status(ctx, otherHash)
+				So(err, ShouldBeNil)
+				So(status, ShouldEqual, TransactionPending)
+			})
+
+			Convey("Tick finishes shared submissions", func() {
+				l := make(chan Result, 1)
+				system.Pending.Add(ctx, successTx.Hash, l)
+				shared.AddSubmission(otherHash, account.Address(), 1, time.Now())
+				So(len(system.pending(ctx)), ShouldEqual, 2)
+
+				otherTx := successTx
+				otherTx.Hash = otherHash
+				results.Results = []Result{successTx, otherTx}
+				system.Tick(ctx)
+
+				So(len(l), ShouldEqual, 1)
+				So(system.pending(ctx), ShouldBeEmpty)
+				So(shared.Submitted, ShouldBeEmpty)
+			})
+
+			Convey("Tick removes timed out shared submissions", func() {
+				system.SubmissionTimeout = time.Minute
+				shared.AddSubmission(otherHash, account.Address(), 1, time.Now().Add(-2*time.Minute))
+				system.Tick(ctx)
+
+				So(shared.Submitted, ShouldBeEmpty)
+				So(reservations.Released, ShouldResemble, []string{otherHash})
+			})
+		})
+
+		Convey("Submit of priority account", func() {
+			system.Priority = &MockPriorityProvider{Priority: map[string]bool{account.Address(): true}}
+			system.SubmissionQueue.MaxPrioritySize = 2
+			system.SubmissionQueue.MaxAccountSize = 1
+
+			queuedTx := getResultWithSequence(account, 2)
+			result := make(chan Result, 1)
+			go func() {
+				result <- <-system.Submit(ctx, queuedTx.EnvelopeXDR)
+			}()
+
+			for system.SubmissionQueue.PrioritySize() == 0 {
+				time.Sleep(time.Millisecond)
+			}
+
+			Convey("is buffered in priority lane", func() {
+				So(system.SubmissionQueue.Size(), ShouldEqual, 1)
+				So(system.SubmissionQueue.PrioritySize(), ShouldEqual, 1)
+			})
+
+			Convey("is limited by max account size", func() {
+				r := <-system.Submit(ctx, getResultWithSequence(account, 3).EnvelopeXDR)
+				So(r.Err, ShouldEqual, sequence.ErrAccountQueueFull)
+				So(system.Metrics.AccountQueueFullMeter.Count(), ShouldEqual, 1)
+			})
+
+			sequences.Results[account.Address()] = 1
+			system.Tick(ctx)
+			for len(system.Pending.Pending(ctx)) == 0 {
+				time.Sleep(time.Millisecond)
+			}
+			results.Results = []Result{queuedTx}
+			system.Tick(ctx)
+
+			So((<-result).Err, ShouldBeNil)
+			So(system.SubmissionQueue.PrioritySize(), ShouldEqual, 0)
+		})
+
 		Convey("Tick", func() {
 
 			Convey("no-ops if there are no open submissions", func() {
@@ -117,6 +321,8 @@ func TestTxsub(t *testing.T) {
 
 				So(len(l), ShouldEqual, 1)
 				So(len(system.Pending.Pending(ctx)), ShouldEqual, 0)
+				So(reservations.Committed, ShouldResemble, []string{successTx.Hash})
+				So(reservations.Released, ShouldBeEmpty)
 			})
 
 			Convey("removes old submissions that have timed out", func() {
@@ -127,6 +333,9 @@ func TestTxsub(t *testing.T) {
 				system.Tick(ctx)
 
 				So(len(system.Pending.Pending(ctx)), ShouldEqual, 0)
+				// transaction may still be applied, so reservation is kept
+				So(reservations.Released, ShouldBeEmpty)
+				So(reservations.Committed, ShouldBeEmpty)
 				So(len(l), ShouldEqual, 1)
 				<-l
 				select {
@@ -143,8 +352,12 @@ func TestTxsub(t *testing.T) {
 }
 
 func getSuccessResult(account *keypair.Full) Result {
+	return getResultWithSequence(account, 1)
+}
+
+func getResultWithSequence(account *keypair.Full, seq uint64) Result {
 	createAccount := build.CreateAccount(build.Destination{account.Address()})
-	tx := build.Transaction(createAccount, build.Sequence{1}, build.SourceAccount{account.Address()}, build.Network{build.TestNetwork.Passphrase})
+	tx := build.Transaction(createAccount, build.Sequence{seq}, build.SourceAccount{account.Address()}, build.Network{build.TestNetwork.Passphrase})
 	hash, err := tx.HashHex()
 	So(err, ShouldBeNil)
 	txE := tx.Sign(account.Seed())
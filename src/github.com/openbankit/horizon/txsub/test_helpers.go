@@ -7,6 +7,8 @@ package txsub
 // txsub and use these mocks in their own tests
 
 import (
+	"time"
+
 	"golang.org/x/net/context"
 	subResults "github.com/openbankit/horizon/txsub/results"
 	"github.com/openbankit/horizon/txsub/transactions"
@@ -53,3 +55,101 @@ type MockSequenceProvider struct {
 func (results *MockSequenceProvider) Get(addresses []string) (map[string]uint64, error) {
 	return results.Results, results.Err
 }
+
+// MockPriorityProvider is a test helper that simplements the PriorityProvider
+// interface
+type MockPriorityProvider struct {
+	Priority map[string]bool
+}
+
+// IsPriority implements `txsub.PriorityProvider`
+func (p *MockPriorityProvider) IsPriority(address string) (bool, error) {
+	return p.Priority[address], nil
+}
+
+// MockStatisticsReservations is a test helper that simplements the StatisticsReservations
+// interface
+type MockStatisticsReservations struct {
+	Committed []string
+	Released  []string
+}
+
+// Commit implements `txsub.StatisticsReservations`
+func (r *MockStatisticsReservations) Commit(txHash string) int {
+	r.Committed = append(r.Committed, txHash)
+	return 0
+}
+
+// Release implements `txsub.StatisticsReservations`
+func (r *MockStatisticsReservations) Release(txHash string, now time.Time) error {
+	r.Released = append(r.Released, txHash)
+	return nil
+}
+
+// MockSharedStorage is a test helper that simplements the SharedStorage
+// interface in memory
+type MockSharedStorage struct {
+	Submitted map[string]MockSharedSubmission
+}
+
+// MockSharedSubmission is a submission stored in MockSharedStorage
+type MockSharedSubmission struct {
+	Address     string
+	Sequence    uint64
+	SubmittedAt time.Time
+}
+
+// AddSubmission implements `txsub.SharedStorage`
+func (s *MockSharedStorage) AddSubmission(hash string, address string, sequence uint64, submittedAt time.Time) error {
+	if s.Submitted == nil {
+		s.Submitted = make(map[string]MockSharedSubmission)
+	}
+	if _, ok := s.Submitted[hash]; !ok {
+		s.Submitted[hash] = MockSharedSubmission{Address: address, Sequence: sequence, SubmittedAt: submittedAt}
+	}
+	return nil
+}
+
+// DeleteSubmission implements `txsub.SharedStorage`
+func (s *MockSharedStorage) DeleteSubmission(hash string) error {
+	delete(s.Submitted, hash)
+	return nil
+}
+
+// DeleteSubmissionsBefore implements `txsub.SharedStorage`
+func (s *MockSharedStorage) DeleteSubmissionsBefore(submittedAt time.Time) error {
+	for hash, submission := range s.Submitted {
+		if submission.SubmittedAt.Before(submittedAt) {
+			delete(s.Submitted, hash)
+		}
+	}
+	return nil
+}
+
+// IsSubmitted implements `txsub.SharedStorage`
+func (s *MockSharedStorage) IsSubmitted(hash string) (bool, error) {
+	_, ok := s.Submitted[hash]
+	return ok, nil
+}
+
+// Submissions implements `txsub.SharedStorage`
+func (s *MockSharedStorage) Submissions() ([]string, error) {
+	hashes := make([]string, 0, len(s.Submitted))
+	for hash := range s.Submitted {
+		hashes = append(hashes, hash)
+	}
+	return hashes, nil
+}
+
+// Sequences implements `txsub.SharedStorage`
+func (s *MockSharedStorage) Sequences(addresses []string) (map[string]uint64, error) {
+	result := make(map[string]uint64)
+	for _, address := range addresses {
+		for _, submission := range s.Submitted {
+			if submission.Address == address && submission.Sequence > result[address] {
+				result[address] = submission.Sequence
+			}
+		}
+	}
+	return result, nil
+}
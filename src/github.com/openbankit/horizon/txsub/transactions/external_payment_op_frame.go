@@ -55,7 +55,7 @@ func (p *ExternalPaymentOpFrame) createPathPayment(manager *Manager) *PathPaymen
     opFrame.innerOp = nil
     innerOp, _ := opFrame.GetInnerOp()
     ppayment := innerOp.(*PathPaymentOpFrame)
-    ppayment.accountTypeValidator = p.GetAccountTypeValidator()
+    ppayment.accountTypeValidator = p.GetAccountTypeValidator(manager.HistoryQ)
     ppayment.assetsValidator = p.GetAssetsValidator(manager.HistoryQ)
     ppayment.traitsValidator = p.GetTraitsValidator()
     ppayment.defaultOutLimitsValidator = p.defaultOutLimitsValidator
@@ -111,9 +111,9 @@ func (p *ExternalPaymentOpFrame) DoCheckValid(manager *Manager) (bool, error) {
     return true, nil
 }
 
-func (p *ExternalPaymentOpFrame) GetAccountTypeValidator() validators.AccountTypeValidatorInterface {
+func (p *ExternalPaymentOpFrame) GetAccountTypeValidator(historyQ history.QInterface) validators.AccountTypeValidatorInterface {
     if p.accountTypeValidator == nil {
-        p.accountTypeValidator = validators.NewAccountTypeValidator()
+        p.accountTypeValidator = validators.NewAccountTypeValidator(historyQ)
     }
     return p.accountTypeValidator
 }
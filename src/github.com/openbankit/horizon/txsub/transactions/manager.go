@@ -13,10 +13,10 @@ type Manager struct {
 	CoreQ        core.QInterface
 	HistoryQ     history.QInterface
 	StatsManager statistics.ManagerInterface
-	Config       *config.Config
+	Config       config.Provider
 }
 
-func NewManager(core core.QInterface, history history.QInterface, statsManager statistics.ManagerInterface, config *config.Config, sharedCache *cache.SharedCache) *Manager {
+func NewManager(core core.QInterface, history history.QInterface, statsManager statistics.ManagerInterface, config config.Provider, sharedCache *cache.SharedCache) *Manager {
 	return &Manager{
 		CoreQ:        core,
 		HistoryQ:     history,
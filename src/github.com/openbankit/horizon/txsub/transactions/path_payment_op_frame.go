@@ -40,9 +40,9 @@ func (p *PathPaymentOpFrame) GetTraitsValidator() validators.TraitsValidatorInte
 	return p.traitsValidator
 }
 
-func (p *PathPaymentOpFrame) GetAccountTypeValidator() validators.AccountTypeValidatorInterface {
+func (p *PathPaymentOpFrame) GetAccountTypeValidator(historyQ history.QInterface) validators.AccountTypeValidatorInterface {
 	if p.accountTypeValidator == nil {
-		p.accountTypeValidator = validators.NewAccountTypeValidator()
+		p.accountTypeValidator = validators.NewAccountTypeValidator(historyQ)
 	}
 	return p.accountTypeValidator
 }
@@ -51,14 +51,14 @@ func (p *PathPaymentOpFrame) GetOutgoingLimitsValidator(paymentData *statistics.
 	if p.defaultOutLimitsValidator != nil {
 		return p.defaultOutLimitsValidator
 	}
-	return validators.NewOutgoingLimitsValidator(paymentData, manager.StatsManager, manager.HistoryQ, manager.Config.AnonymousUserRestrictions, *p.now)
+	return validators.NewOutgoingLimitsValidator(paymentData, manager.StatsManager, manager.HistoryQ, manager.Config.Config().AnonymousUserRestrictions, *p.now)
 }
 
 func (p *PathPaymentOpFrame) GetIncomingLimitsValidator(paymentData *statistics.PaymentData, manager *Manager) validators.IncomingLimitsValidatorInterface {
 	if p.defaultInLimitsValidator != nil {
 		return p.defaultInLimitsValidator
 	}
-	return validators.NewIncomingLimitsValidator(paymentData, manager.HistoryQ, manager.StatsManager, manager.Config.AnonymousUserRestrictions, *p.now)
+	return validators.NewIncomingLimitsValidator(paymentData, manager.HistoryQ, manager.StatsManager, manager.Config.Config().AnonymousUserRestrictions, *p.now)
 }
 
 func (p *PathPaymentOpFrame) GetAssetsValidator(historyQ history.QInterface) validators.AssetsValidatorInterface {
@@ -165,7 +165,11 @@ func (p *PathPaymentOpFrame) checkLimits(manager *Manager) (bool, error) {
 
 	// 1. Check account types
 	p.log.Debug("Validating account types")
-	accountTypesRestricted := p.GetAccountTypeValidator().VerifyAccountTypesForPayment(p.SourceAccount.AccountType, p.destAccount.AccountType)
+	accountTypesRestricted, err := p.GetAccountTypeValidator(manager.HistoryQ).VerifyAccountTypesForPayment(p.SourceAccount.AccountType, p.destAccount.AccountType)
+	if err != nil {
+		return false, err
+	}
+
 	if accountTypesRestricted != nil {
 		p.getInnerResult().Code = xdr.PathPaymentResultCodePathPaymentMalformed
 		p.Result.Info = results.AdditionalErrorInfoError(accountTypesRestricted)
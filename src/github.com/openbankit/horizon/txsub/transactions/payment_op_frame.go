@@ -21,9 +21,9 @@ type PaymentOpFrame struct {
 	pathPayment               *PathPaymentOpFrame
 }
 
-func (p *PaymentOpFrame) GetAccountTypeValidator() validators.AccountTypeValidatorInterface {
+func (p *PaymentOpFrame) GetAccountTypeValidator(historyQ history.QInterface) validators.AccountTypeValidatorInterface {
 	if p.accountTypeValidator == nil {
-		p.accountTypeValidator = validators.NewAccountTypeValidator()
+		p.accountTypeValidator = validators.NewAccountTypeValidator(historyQ)
 	}
 	return p.accountTypeValidator
 }
@@ -111,7 +111,7 @@ func (p *PaymentOpFrame) createPathPayment(manager *Manager) *PathPaymentOpFrame
 	opFrame.innerOp = nil
 	innerOp, _ := opFrame.GetInnerOp()
 	ppayment := innerOp.(*PathPaymentOpFrame)
-	ppayment.accountTypeValidator = p.GetAccountTypeValidator()
+	ppayment.accountTypeValidator = p.GetAccountTypeValidator(manager.HistoryQ)
 	ppayment.assetsValidator = p.GetAssetsValidator(manager.HistoryQ)
 	ppayment.traitsValidator = p.GetTraitsValidator()
 	ppayment.defaultOutLimitsValidator = p.defaultOutLimitsValidator
@@ -107,14 +107,14 @@ func TestPaymentOpFrame(t *testing.T) {
 	Convey("Account type restricted", t, func() {
 		accountTypeVMock.On("VerifyAccountTypesForPayment", mock.Anything, mock.Anything).Return(&results.RestrictedForAccountTypeError{
 			Reason: fmt.Sprintf("Payments from %s to %s are restricted.", fromType.String(), toType.String()),
-		}).Once()
+		}, nil).Once()
 		isValid, err := opFrame.CheckValid(manager)
 		So(err, ShouldBeNil)
 		So(isValid, ShouldBeFalse)
 		So(opFrame.GetResult().Result.MustTr().MustPaymentResult().Code, ShouldEqual, xdr.PaymentResultCodePaymentMalformed)
 		So(opFrame.GetResult().Info.GetError(), ShouldEqual, fmt.Sprintf("Payments from %s to %s are restricted.", fromType.String(), toType.String()))
 	})
-	accountTypeVMock.On("VerifyAccountTypesForPayment", mock.Anything, mock.Anything).Return(nil)
+	accountTypeVMock.On("VerifyAccountTypesForPayment", mock.Anything, mock.Anything).Return(nil, nil)
 	Convey("Failed to get traits", t, func() {
 		errorData := "failed to get traits"
 		traitsMock.On("CheckTraits", &from, &to).Return(nil, errors.New(errorData)).Once()
@@ -0,0 +1,63 @@
+package statistics
+
+import (
+	"time"
+
+	"github.com/openbankit/horizon/redis"
+)
+
+// DryRunManager updates statistics in memory only. Statistics are loaded from redis or history db
+// on first use and are never saved, so it can be used to validate transactions without submitting them.
+type DryRunManager struct {
+	manager *Manager
+	stats   map[string]*redis.AccountStatistics
+}
+
+func NewDryRunManager(manager *Manager) *DryRunManager {
+	return &DryRunManager{
+		manager: manager,
+		stats:   make(map[string]*redis.AccountStatistics),
+	}
+}
+
+// UpdateGet gets statistics for account-asset pair, updates it in memory with opAmount and returns stats
+func (m *DryRunManager) UpdateGet(paymentData *PaymentData, paymentDirection PaymentDirection, now time.Time) (*redis.AccountStatistics, error) {
+	accountStats, err := m.get(paymentData, paymentDirection, now)
+	if err != nil {
+		return nil, err
+	}
+
+	counterparty := paymentData.GetCounterparty(paymentDirection)
+	m.manager.updateStats(accountStats, counterparty.AccountType, paymentDirection.IsIncoming(), paymentData.Amount, now)
+	return accountStats, nil
+}
+
+// CancelOp subtracts op amount from statistics updated by UpdateGet
+func (m *DryRunManager) CancelOp(paymentData *PaymentData, paymentDirection PaymentDirection, now time.Time) error {
+	account := paymentData.GetAccount(paymentDirection)
+	accountStats, ok := m.stats[redis.GetAccountStatisticsKey(account.Address, paymentData.Asset.Code)]
+	if !ok {
+		return nil
+	}
+
+	counterparty := paymentData.GetCounterparty(paymentDirection)
+	m.manager.updateStats(accountStats, counterparty.AccountType, paymentDirection.IsIncoming(), -paymentData.Amount, now)
+	return nil
+}
+
+func (m *DryRunManager) get(paymentData *PaymentData, paymentDirection PaymentDirection, now time.Time) (*redis.AccountStatistics, error) {
+	account := paymentData.GetAccount(paymentDirection)
+	key := redis.GetAccountStatisticsKey(account.Address, paymentData.Asset.Code)
+	accountStats, ok := m.stats[key]
+	if ok {
+		return accountStats, nil
+	}
+
+	accountStats, err := m.manager.Get(account.Address, paymentData.Asset, paymentData.GetAccountTrustLine(paymentDirection), now)
+	if err != nil {
+		return nil, err
+	}
+
+	m.stats[key] = accountStats
+	return accountStats, nil
+}
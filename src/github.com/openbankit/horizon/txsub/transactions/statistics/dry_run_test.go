@@ -0,0 +1,64 @@
+package statistics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openbankit/go-base/keypair"
+	"github.com/openbankit/go-base/xdr"
+	"github.com/openbankit/horizon/accounttypes"
+	"github.com/openbankit/horizon/db2/history"
+	"github.com/openbankit/horizon/redis"
+	"github.com/openbankit/horizon/test"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDryRunManager(t *testing.T) {
+	counterparties := accounttype.GetAll()
+	config := test.NewTestConfig()
+	sourceKP, err := keypair.Random()
+	assert.Nil(t, err)
+	destKP, err := keypair.Random()
+	assert.Nil(t, err)
+	now := time.Now()
+	source := &history.Account{Address: sourceKP.Address(), AccountType: xdr.AccountTypeAccountRegisteredUser}
+	dest := &history.Account{Address: destKP.Address(), AccountType: xdr.AccountTypeAccountMerchant}
+	paymentData := NewPaymentData(dest, nil, history.Asset{Code: "UAH"}, 100, NewOperationData(source, 0, "random_tx_hash"))
+	direction := PaymentDirectionOutgoing
+
+	Convey("Dry run", t, func() {
+		historyQ := &history.QMock{}
+		manager := NewManager(historyQ, counterparties, &config)
+		connProvider := &redis.ConnectionProviderMock{}
+		conn := &redis.ConnectionMock{}
+		conn.On("Close").Return(nil)
+		connProvider.On("GetConnection").Return(conn)
+		manager.connectionProvider = connProvider
+		accountStatsProvider := &redis.AccountStatisticsProviderMock{}
+		manager.defaultAccountStatsProvider = accountStatsProvider
+
+		cachedStats := history.NewAccountStatistics(source.Address, "UAH", xdr.AccountTypeAccountMerchant)
+		cachedStats.DailyOutcome = 50
+		cachedStats.UpdatedAt = now
+		cached := redis.NewAccountStatistics(source.Address, "UAH", 0, map[xdr.AccountType]history.AccountStatistics{
+			xdr.AccountTypeAccountMerchant: cachedStats,
+		})
+		accountStatsProvider.On("Get", source.Address, "UAH", counterparties).Return(cached, nil).Once()
+
+		dryRun := NewDryRunManager(manager)
+		stats, err := dryRun.UpdateGet(&paymentData, direction, now)
+		So(err, ShouldBeNil)
+		So(stats.AccountsStatistics[xdr.AccountTypeAccountMerchant].DailyOutcome, ShouldEqual, 150)
+		// second payment is applied to statistics in memory
+		stats, err = dryRun.UpdateGet(&paymentData, direction, now)
+		So(err, ShouldBeNil)
+		So(stats.AccountsStatistics[xdr.AccountTypeAccountMerchant].DailyOutcome, ShouldEqual, 250)
+		err = dryRun.CancelOp(&paymentData, direction, now)
+		So(err, ShouldBeNil)
+		So(stats.AccountsStatistics[xdr.AccountTypeAccountMerchant].DailyOutcome, ShouldEqual, 150)
+
+		accountStatsProvider.AssertNotCalled(t, "Insert")
+		conn.AssertNotCalled(t, "Multi")
+	})
+}
@@ -26,6 +26,8 @@ type Manager struct {
 	numOfRetires       int
 	log                *log.Entry
 
+	reservations *Reservations
+
 	historyQ                    history.QInterface
 	connectionProvider          redis.ConnectionProviderInterface
 	defaultProcessedOpProvider  redis.ProcessedOpProviderInterface
@@ -57,6 +59,12 @@ func (m *Manager) SetProcessedOpTimeout(timeout time.Duration) *Manager {
 	return m
 }
 
+// SetReservations makes manager track statistics reserved by transactions, which can later be committed or released
+func (m *Manager) SetReservations(reservations *Reservations) *Manager {
+	m.reservations = reservations
+	return m
+}
+
 func (m *Manager) getConnectionProvider() redis.ConnectionProviderInterface {
 	if m.connectionProvider == nil {
 		m.connectionProvider = redis.NewConnectionProvider()
@@ -91,6 +99,12 @@ func (m *Manager) CancelOp(paymentData *PaymentData, paymentDirection PaymentDir
 		}
 
 		if !needRetry {
+			if m.reservations != nil {
+				err = m.reservations.remove(paymentData.TxHash, paymentData.Index, paymentDirection)
+				if err != nil {
+					m.log.WithField("tx_hash", paymentData.TxHash).WithError(err).Error("Failed to remove reservation of canceled op")
+				}
+			}
 			return nil
 		}
 	}
@@ -98,6 +112,50 @@ func (m *Manager) CancelOp(paymentData *PaymentData, paymentDirection PaymentDir
 	return errors.New("Failed to cancel op")
 }
 
+// Commit marks statistics reserved by transaction as final. Must be called, when transaction is applied by stellar-core.
+// Returns number of committed reservations.
+func (m *Manager) Commit(txHash string) int {
+	if m.reservations == nil {
+		return 0
+	}
+
+	reservations, err := m.reservations.take(txHash)
+	if err != nil {
+		m.log.WithField("tx_hash", txHash).WithError(err).Error("Failed to commit reservations")
+		return 0
+	}
+
+	m.reservations.Metrics.CommittedMeter.Mark(int64(len(reservations)))
+	return len(reservations)
+}
+
+// Release subtracts statistics reserved by transaction. Must be called, when stellar-core rejects transaction.
+// Reservation can only be released while its processed op is stored.
+func (m *Manager) Release(txHash string, now time.Time) error {
+	if m.reservations == nil {
+		return nil
+	}
+
+	reservations, err := m.reservations.take(txHash)
+	if err != nil {
+		return err
+	}
+
+	var result error
+	for _, reservation := range reservations {
+		err := m.CancelOp(reservation.paymentData(), reservation.Direction, now)
+		if err != nil {
+			m.log.WithField("tx_hash", txHash).WithField("op_index", reservation.OpIndex).WithError(err).Error("Failed to release reservation")
+			if result == nil {
+				result = err
+			}
+			continue
+		}
+		m.reservations.Metrics.ReleasedMeter.Mark(1)
+	}
+	return result
+}
+
 // Returns true if retry needed
 func (m *Manager) cancelOp(paymentData *PaymentData, direction PaymentDirection, now time.Time) (bool, error) {
 	m.log.Debug("Getting new connection")
@@ -176,6 +234,32 @@ func (m *Manager) cancelOp(paymentData *PaymentData, direction PaymentDirection,
 
 }
 
+// Get returns current statistics for account-asset pair without updating it. Statistics cached in redis
+// are preferred, history db is used otherwise. Balance is taken from trustLine, if it is not known.
+func (m *Manager) Get(account string, asset history.Asset, trustLine *core.Trustline, now time.Time) (*redis.AccountStatistics, error) {
+	conn := m.getConnectionProvider().GetConnection()
+	defer conn.Close()
+
+	accountStats, err := m.getAccountStatsProvider(conn).Get(account, asset.Code, m.counterparties)
+	if err != nil {
+		return nil, err
+	}
+
+	if accountStats == nil {
+		return m.tryGetStatisticsFromDB(account, asset, trustLine, now)
+	}
+
+	if accountStats.Balance == 0 && trustLine != nil {
+		accountStats.Balance = int64(trustLine.Balance)
+	}
+
+	for key, value := range accountStats.AccountsStatistics {
+		value.ClearObsoleteStats(now)
+		accountStats.AccountsStatistics[key] = value
+	}
+	return accountStats, nil
+}
+
 func (m *Manager) UpdateGet(paymentData *PaymentData, paymentDirection PaymentDirection, now time.Time) (result *redis.AccountStatistics, err error) {
 	var accountStats *redis.AccountStatistics
 	for i := 0; i < m.numOfRetires; i++ {
@@ -241,6 +325,15 @@ func (m *Manager) updateGet(paymentData *PaymentData, direction PaymentDirection
 	}
 	m.log.WithField("account_stats", accountStats).Debug("Got account stats")
 
+	// reservation is stored in the same transaction, so that statistics are never updated without it
+	var reserved []redis.ReservedOp
+	if m.reservations != nil {
+		reserved, err = m.reservations.watch(conn, paymentData.TxHash)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
 	// 4. Update stats and set op processed
 	counterparty := paymentData.GetCounterparty(direction)
 	m.updateStats(accountStats, counterparty.AccountType, direction.IsIncoming(), paymentData.Amount, now)
@@ -264,6 +357,13 @@ func (m *Manager) updateGet(paymentData *PaymentData, direction PaymentDirection
 		return nil, false, err
 	}
 
+	if m.reservations != nil {
+		err = m.reservations.store(conn, paymentData.TxHash, withReservation(reserved, newReservation(paymentData, direction, now)))
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
 	// commit
 	m.log.Debug("Exec multi")
 	isOk, err := conn.Exec()
@@ -274,6 +374,7 @@ func (m *Manager) updateGet(paymentData *PaymentData, direction PaymentDirection
 	if !isOk {
 		return nil, true, nil
 	}
+
 	return accountStats, false, nil
 }
 
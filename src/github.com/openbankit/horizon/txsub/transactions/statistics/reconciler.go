@@ -0,0 +1,301 @@
+package statistics
+
+import (
+	"time"
+
+	"github.com/openbankit/go-base/xdr"
+	"github.com/openbankit/horizon/config"
+	"github.com/openbankit/horizon/db2/history"
+	"github.com/openbankit/horizon/errors"
+	"github.com/openbankit/horizon/log"
+	"github.com/openbankit/horizon/redis"
+	"github.com/rcrowley/go-metrics"
+)
+
+// Mismatch describes account/asset/counterparty, which counters stored in redis differ from ones in history db
+type Mismatch struct {
+	Account          string
+	AssetCode        string
+	CounterpartyType xdr.AccountType
+	// Stored - statistics from history db
+	Stored history.AccountStatistics
+	// Cached - statistics from redis
+	Cached history.AccountStatistics
+	// Healed is true, if cached statistics were dropped from redis to be reloaded from history db
+	Healed bool
+}
+
+// ReconcileResult is a summary of single reconciliation
+type ReconcileResult struct {
+	// number of account/asset pairs, which statistics were compared
+	Checked int
+	// number of account/asset pairs skipped, as they were updated within grace period
+	Skipped int
+	// number of account/asset pairs dropped from redis
+	Healed     int
+	Mismatches []Mismatch
+}
+
+// Reconciler compares account statistics cached in redis with statistics stored in history db.
+// Statistics are only compared for account/asset pairs present in both stores - missing redis
+// entries are loaded from history db on demand by Manager.
+type Reconciler struct {
+	counterparties    []xdr.AccountType
+	statisticsTimeOut time.Duration
+	// statistics updated in redis within grace period are skipped as they may
+	// contain operations that are not ingested yet
+	gracePeriod time.Duration
+	heal        bool
+	log         *log.Entry
+	tick        *time.Ticker
+
+	historyQ                    history.QInterface
+	connectionProvider          redis.ConnectionProviderInterface
+	defaultAccountStatsProvider redis.AccountStatisticsProviderInterface
+
+	Metrics struct {
+		// ReconcileTimer exposes timing metrics about reconciliation runs
+		ReconcileTimer metrics.Timer
+
+		// CheckedGauge tracks the count of account/asset pairs compared during last run
+		CheckedGauge metrics.Gauge
+
+		// MismatchesGauge tracks the count of mismatches found during last run
+		MismatchesGauge metrics.Gauge
+
+		// MismatchesMeter tracks the rate of found mismatches
+		MismatchesMeter metrics.Meter
+
+		// HealedMeter tracks the rate of account/asset pairs dropped from redis
+		HealedMeter metrics.Meter
+
+		// FailedMeter tracks the rate of failed reconciliations
+		FailedMeter metrics.Meter
+	}
+}
+
+// NewReconciler creates new statistics reconciler. counterparties MUST BE FULL ARRAY OF COUTERPARTIES.
+// Statistics written to redis within processed op timeout are not compared.
+func NewReconciler(historyQ history.QInterface, counterparties []xdr.AccountType, config *config.Config) *Reconciler {
+	r := &Reconciler{
+		historyQ:          historyQ,
+		counterparties:    counterparties,
+		statisticsTimeOut: config.StatisticsTimeout,
+		gracePeriod:       config.ProcessedOpTimeout,
+		log:               log.WithField("service", "statistics_reconciler"),
+	}
+	r.Metrics.ReconcileTimer = metrics.NewTimer()
+	r.Metrics.CheckedGauge = metrics.NewGauge()
+	r.Metrics.MismatchesGauge = metrics.NewGauge()
+	r.Metrics.MismatchesMeter = metrics.NewMeter()
+	r.Metrics.HealedMeter = metrics.NewMeter()
+	r.Metrics.FailedMeter = metrics.NewMeter()
+	return r
+}
+
+// Start runs reconciliation of all statistics every interval.
+// If heal is true, mismatching statistics are dropped from redis.
+func (r *Reconciler) Start(interval time.Duration, heal bool) {
+	r.heal = heal
+	r.tick = time.NewTicker(interval)
+	go r.run()
+}
+
+// Close stops background reconciliation
+func (r *Reconciler) Close() {
+	if r.tick == nil {
+		return
+	}
+	r.log.Info("canceling statistics reconciler")
+	r.tick.Stop()
+}
+
+func (r *Reconciler) run() {
+	for _ = range r.tick.C {
+		r.log.Debug("ticking statistics reconciler")
+		r.runOnce()
+	}
+}
+
+func (r *Reconciler) runOnce() {
+	defer func() {
+		if rec := recover(); rec != nil {
+			err := errors.FromPanic(rec)
+			r.log.WithStack(err).Errorf("statistics reconciler panicked: %s", err)
+			errors.ReportToSentry(err, nil)
+		}
+	}()
+
+	result, err := r.Reconcile("", "", r.heal, time.Now())
+	if err != nil {
+		r.log.WithError(err).Error("Failed to reconcile statistics")
+		return
+	}
+
+	for _, mismatch := range result.Mismatches {
+		r.log.WithFields(log.F{
+			"account":           mismatch.Account,
+			"asset":             mismatch.AssetCode,
+			"counterparty_type": int(mismatch.CounterpartyType),
+			"stored":            mismatch.Stored,
+			"cached":            mismatch.Cached,
+			"healed":            mismatch.Healed,
+		}).Warn("Statistics mismatch")
+	}
+}
+
+// Reconcile compares statistics of account/asset pairs stored in history db with redis.
+// Empty account or assetCode are ignored. If heal is true, mismatching statistics are dropped from redis
+// to be reloaded from history db.
+func (r *Reconciler) Reconcile(account, assetCode string, heal bool, now time.Time) (*ReconcileResult, error) {
+	var result ReconcileResult
+	var err error
+	r.Metrics.ReconcileTimer.Time(func() {
+		err = r.reconcile(&result, account, assetCode, heal, now)
+	})
+
+	if err != nil {
+		r.Metrics.FailedMeter.Mark(1)
+		return nil, err
+	}
+
+	r.Metrics.CheckedGauge.Update(int64(result.Checked))
+	r.Metrics.MismatchesGauge.Update(int64(len(result.Mismatches)))
+	r.Metrics.MismatchesMeter.Mark(int64(len(result.Mismatches)))
+	r.Metrics.HealedMeter.Mark(int64(result.Healed))
+	return &result, nil
+}
+
+func (r *Reconciler) reconcile(result *ReconcileResult, account, assetCode string, heal bool, now time.Time) error {
+	var pairs []history.AccountAssetPair
+	err := r.historyQ.GetStatisticsAccountAssetPairs(&pairs, account, assetCode)
+	if err != nil {
+		return err
+	}
+
+	result.Mismatches = []Mismatch{}
+	for _, pair := range pairs {
+		err = r.reconcilePair(result, pair, heal, now)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *Reconciler) reconcilePair(result *ReconcileResult, pair history.AccountAssetPair, heal bool, now time.Time) error {
+	conn := r.getConnectionProvider().GetConnection()
+	defer conn.Close()
+
+	statsKey := redis.GetAccountStatisticsKey(pair.Account, pair.AssetCode)
+	err := conn.Watch(statsKey)
+	if err != nil {
+		return err
+	}
+
+	cached, err := r.getAccountStatsProvider(conn).Get(pair.Account, pair.AssetCode, r.counterparties)
+	if err != nil {
+		return err
+	}
+
+	if cached == nil {
+		return conn.UnWatch()
+	}
+
+	// each write to redis resets expiration of statistics, so ttl shows how long ago they were written
+	ttl, err := conn.TTL(statsKey)
+	if err != nil {
+		return err
+	}
+
+	if ttl > r.statisticsTimeOut-r.gracePeriod {
+		result.Skipped++
+		return conn.UnWatch()
+	}
+
+	stored := make(map[xdr.AccountType]history.AccountStatistics)
+	err = r.historyQ.GetStatisticsByAccountAndAsset(stored, pair.Account, pair.AssetCode, now)
+	if err != nil {
+		return err
+	}
+
+	result.Checked++
+	mismatches := r.compare(pair, stored, cached.AccountsStatistics, now)
+	if len(mismatches) == 0 || !heal {
+		result.Mismatches = append(result.Mismatches, mismatches...)
+		return conn.UnWatch()
+	}
+
+	// drop stats from redis - Manager will reload them from history db on next payment
+	err = conn.Multi()
+	if err != nil {
+		return err
+	}
+
+	err = conn.Delete(statsKey)
+	if err != nil {
+		return err
+	}
+
+	isOk, err := conn.Exec()
+	if err != nil {
+		return err
+	}
+
+	if isOk {
+		result.Healed++
+		for i := range mismatches {
+			mismatches[i].Healed = true
+		}
+	}
+	result.Mismatches = append(result.Mismatches, mismatches...)
+	return nil
+}
+
+func (r *Reconciler) compare(pair history.AccountAssetPair, stored, cached map[xdr.AccountType]history.AccountStatistics, now time.Time) []Mismatch {
+	var result []Mismatch
+	for _, counterparty := range r.counterparties {
+		storedStats, isStored := stored[counterparty]
+		cachedStats, isCached := cached[counterparty]
+		if !isStored && !isCached {
+			continue
+		}
+
+		if !isStored {
+			storedStats = history.NewAccountStatistics(pair.Account, pair.AssetCode, counterparty)
+		}
+
+		if isCached {
+			cachedStats.ClearObsoleteStats(now)
+		} else {
+			cachedStats = history.NewAccountStatistics(pair.Account, pair.AssetCode, counterparty)
+		}
+
+		if storedStats.CountersEqual(&cachedStats) {
+			continue
+		}
+
+		result = append(result, Mismatch{
+			Account:          pair.Account,
+			AssetCode:        pair.AssetCode,
+			CounterpartyType: counterparty,
+			Stored:           storedStats,
+			Cached:           cachedStats,
+		})
+	}
+	return result
+}
+
+func (r *Reconciler) getConnectionProvider() redis.ConnectionProviderInterface {
+	if r.connectionProvider == nil {
+		r.connectionProvider = redis.NewConnectionProvider()
+	}
+	return r.connectionProvider
+}
+
+func (r *Reconciler) getAccountStatsProvider(conn redis.ConnectionInterface) redis.AccountStatisticsProviderInterface {
+	if r.defaultAccountStatsProvider != nil {
+		return r.defaultAccountStatsProvider
+	}
+	return redis.NewAccountStatisticsProvider(conn)
+}
@@ -0,0 +1,143 @@
+package statistics
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/openbankit/go-base/keypair"
+	"github.com/openbankit/go-base/xdr"
+	"github.com/openbankit/horizon/accounttypes"
+	"github.com/openbankit/horizon/db2/history"
+	"github.com/openbankit/horizon/redis"
+	"github.com/openbankit/horizon/test"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReconciler(t *testing.T) {
+	counterparties := accounttype.GetAll()
+	config := test.NewTestConfig()
+	config.StatisticsTimeout = time.Minute
+	config.ProcessedOpTimeout = 30 * time.Second
+
+	account, err := keypair.Random()
+	assert.Nil(t, err)
+	assetCode := "UAH"
+	pair := history.AccountAssetPair{Account: account.Address(), AssetCode: assetCode}
+	statsKey := redis.GetAccountStatisticsKey(pair.Account, pair.AssetCode)
+	now := time.Now()
+	counterparty := xdr.AccountTypeAccountAnonymousUser
+
+	Convey("Reconcile", t, func() {
+		historyQ := &history.QMock{}
+		reconciler := NewReconciler(historyQ, counterparties, &config)
+		connProvider := &redis.ConnectionProviderMock{}
+		conn := &redis.ConnectionMock{}
+		conn.On("Close").Return(nil)
+		connProvider.On("GetConnection").Return(conn)
+		reconciler.connectionProvider = connProvider
+		accountStatsProvider := &redis.AccountStatisticsProviderMock{}
+		reconciler.defaultAccountStatsProvider = accountStatsProvider
+
+		stored := history.NewAccountStatistics(pair.Account, pair.AssetCode, counterparty)
+		stored.DailyIncome = 100
+		stored.UpdatedAt = now
+		storedStats := map[xdr.AccountType]history.AccountStatistics{
+			counterparty: stored,
+		}
+
+		Convey("Failed to get pairs", func() {
+			historyQ.On("GetStatisticsAccountAssetPairs", "", "").Return(nil, errors.New("db is down")).Once()
+			_, err := reconciler.Reconcile("", "", false, now)
+			So(err, ShouldNotBeNil)
+			So(reconciler.Metrics.FailedMeter.Count(), ShouldEqual, 1)
+		})
+		historyQ.On("GetStatisticsAccountAssetPairs", pair.Account, pair.AssetCode).Return([]history.AccountAssetPair{pair}, nil)
+		conn.On("Watch", statsKey).Return(nil)
+		conn.On("UnWatch").Return(nil)
+		Convey("Stats are not cached", func() {
+			accountStatsProvider.On("Get", pair.Account, pair.AssetCode, counterparties).Return(nil, nil).Once()
+			result, err := reconciler.Reconcile(pair.Account, pair.AssetCode, true, now)
+			So(err, ShouldBeNil)
+			So(result.Checked, ShouldEqual, 0)
+			So(result.Mismatches, ShouldBeEmpty)
+		})
+		cached := redis.NewAccountStatistics(pair.Account, pair.AssetCode, 0, map[xdr.AccountType]history.AccountStatistics{
+			counterparty: stored,
+		})
+		Convey("Stats updated within grace period", func() {
+			accountStatsProvider.On("Get", pair.Account, pair.AssetCode, counterparties).Return(cached, nil).Once()
+			conn.On("TTL", statsKey).Return(50*time.Second, nil).Once()
+			result, err := reconciler.Reconcile(pair.Account, pair.AssetCode, true, now)
+			So(err, ShouldBeNil)
+			So(result.Checked, ShouldEqual, 0)
+			So(result.Skipped, ShouldEqual, 1)
+		})
+		conn.On("TTL", statsKey).Return(10*time.Second, nil)
+		historyQ.On("GetStatisticsByAccountAndAsset", pair.Account, pair.AssetCode, now).Return(storedStats, nil)
+		Convey("Stats match", func() {
+			accountStatsProvider.On("Get", pair.Account, pair.AssetCode, counterparties).Return(cached, nil).Once()
+			result, err := reconciler.Reconcile(pair.Account, pair.AssetCode, true, now)
+			So(err, ShouldBeNil)
+			So(result.Checked, ShouldEqual, 1)
+			So(result.Mismatches, ShouldBeEmpty)
+			So(reconciler.Metrics.CheckedGauge.Value(), ShouldEqual, 1)
+		})
+		Convey("Stats mismatch", func() {
+			mismatched := stored
+			mismatched.DailyIncome = 200
+			otherCounterparty := xdr.AccountTypeAccountBank
+			other := history.NewAccountStatistics(pair.Account, pair.AssetCode, otherCounterparty)
+			other.AnnualOutcome = 10
+			other.UpdatedAt = now
+			cached = redis.NewAccountStatistics(pair.Account, pair.AssetCode, 0, map[xdr.AccountType]history.AccountStatistics{
+				counterparty:      mismatched,
+				otherCounterparty: other,
+			})
+			accountStatsProvider.On("Get", pair.Account, pair.AssetCode, counterparties).Return(cached, nil).Once()
+			Convey("Report only", func() {
+				result, err := reconciler.Reconcile(pair.Account, pair.AssetCode, false, now)
+				So(err, ShouldBeNil)
+				So(result.Checked, ShouldEqual, 1)
+				So(result.Healed, ShouldEqual, 0)
+				So(len(result.Mismatches), ShouldEqual, 2)
+				for _, mismatch := range result.Mismatches {
+					So(mismatch.Healed, ShouldBeFalse)
+					switch mismatch.CounterpartyType {
+					case counterparty:
+						So(mismatch.Stored.DailyIncome, ShouldEqual, 100)
+						So(mismatch.Cached.DailyIncome, ShouldEqual, 200)
+					case otherCounterparty:
+						So(mismatch.Stored.IsZero(), ShouldBeTrue)
+						So(mismatch.Cached.AnnualOutcome, ShouldEqual, 10)
+					default:
+						t.Errorf("unexpected counterparty %d", mismatch.CounterpartyType)
+					}
+				}
+				So(reconciler.Metrics.MismatchesGauge.Value(), ShouldEqual, 2)
+				conn.AssertNotCalled(t, "Delete", statsKey)
+			})
+			Convey("Heal", func() {
+				conn.On("Multi").Return(nil).Once()
+				conn.On("Delete", statsKey).Return(nil).Once()
+				Convey("Stats changed concurrently", func() {
+					conn.On("Exec").Return(false, nil).Once()
+					result, err := reconciler.Reconcile(pair.Account, pair.AssetCode, true, now)
+					So(err, ShouldBeNil)
+					So(result.Healed, ShouldEqual, 0)
+					So(result.Mismatches[0].Healed, ShouldBeFalse)
+				})
+				Convey("Success", func() {
+					conn.On("Exec").Return(true, nil).Once()
+					result, err := reconciler.Reconcile(pair.Account, pair.AssetCode, true, now)
+					So(err, ShouldBeNil)
+					So(result.Healed, ShouldEqual, 1)
+					So(len(result.Mismatches), ShouldEqual, 2)
+					So(result.Mismatches[0].Healed, ShouldBeTrue)
+					So(reconciler.Metrics.HealedMeter.Count(), ShouldEqual, 1)
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,265 @@
+package statistics
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/openbankit/go-base/xdr"
+	"github.com/openbankit/horizon/db2/history"
+	"github.com/openbankit/horizon/redis"
+	"github.com/rcrowley/go-metrics"
+)
+
+// Reservation is an amount added to account statistics for operation of transaction, which is not applied
+// by stellar-core yet. Reservation is committed, when transaction is applied, or released (subtracted from
+// statistics), when stellar-core rejects transaction. Reservation of transaction with unknown result expires
+// together with its processed ops.
+type Reservation struct {
+	TxHash           string
+	OpIndex          int
+	Account          string
+	AssetCode        string
+	CounterpartyType xdr.AccountType
+	Amount           int64
+	Direction        PaymentDirection
+	ReservedAt       time.Time
+}
+
+func newReservation(paymentData *PaymentData, direction PaymentDirection, now time.Time) Reservation {
+	return Reservation{
+		TxHash:           paymentData.TxHash,
+		OpIndex:          paymentData.Index,
+		Account:          paymentData.GetAccount(direction).Address,
+		AssetCode:        paymentData.Asset.Code,
+		CounterpartyType: paymentData.GetCounterparty(direction).AccountType,
+		Amount:           paymentData.Amount,
+		Direction:        direction,
+		ReservedAt:       now,
+	}
+}
+
+func readReservation(op redis.ReservedOp) Reservation {
+	direction := PaymentDirectionOutgoing
+	if op.IsIncoming {
+		direction = PaymentDirectionIncoming
+	}
+	return Reservation{
+		TxHash:           op.TxHash,
+		OpIndex:          op.Index,
+		Account:          op.Account,
+		AssetCode:        op.AssetCode,
+		CounterpartyType: op.CounterpartyType,
+		Amount:           op.Amount,
+		Direction:        direction,
+		ReservedAt:       op.ReservedAt,
+	}
+}
+
+func (r *Reservation) toReservedOp() redis.ReservedOp {
+	return redis.ReservedOp{
+		TxHash:           r.TxHash,
+		Index:            r.OpIndex,
+		Account:          r.Account,
+		AssetCode:        r.AssetCode,
+		CounterpartyType: r.CounterpartyType,
+		Amount:           r.Amount,
+		IsIncoming:       r.Direction.IsIncoming(),
+		ReservedAt:       r.ReservedAt,
+	}
+}
+
+// paymentData restores payment data required to cancel the reserved op. Counterparty is known by type only.
+func (r *Reservation) paymentData() *PaymentData {
+	account := &history.Account{Address: r.Account}
+	counterparty := &history.Account{AccountType: r.CounterpartyType}
+	source, destination := account, counterparty
+	if r.Direction.IsIncoming() {
+		source, destination = counterparty, account
+	}
+
+	result := NewPaymentData(destination, nil, history.Asset{Code: r.AssetCode}, r.Amount, NewOperationData(source, r.OpIndex, r.TxHash))
+	return &result
+}
+
+// Reservations tracks statistics reserved by transactions. Reservations are kept in the statistics storage
+// next to the counters, so that they are shared by horizon instances and survive restart.
+type Reservations struct {
+	timeout            time.Duration
+	numOfRetries       int
+	connectionProvider redis.ConnectionProviderInterface
+
+	Metrics struct {
+		// CommittedMeter tracks the rate of reservations committed after transaction was applied
+		CommittedMeter metrics.Meter
+
+		// ReleasedMeter tracks the rate of reservations released after transaction was rejected
+		ReleasedMeter metrics.Meter
+	}
+}
+
+// NewReservations creates reservations, which expire after timeout. Timeout must not be greater than
+// timeout of processed ops, as reservation can only be released while its processed op is stored.
+func NewReservations(timeout time.Duration) *Reservations {
+	r := &Reservations{
+		timeout:      timeout,
+		numOfRetries: 5,
+	}
+	r.Metrics.CommittedMeter = metrics.NewMeter()
+	r.Metrics.ReleasedMeter = metrics.NewMeter()
+	return r
+}
+
+func (r *Reservations) getConnectionProvider() redis.ConnectionProviderInterface {
+	if r.connectionProvider == nil {
+		r.connectionProvider = redis.NewConnectionProvider()
+	}
+	return r.connectionProvider
+}
+
+// Get returns active reservations of account ordered by reservation time
+func (r *Reservations) Get(account string) ([]Reservation, error) {
+	conn := r.getConnectionProvider().GetConnection()
+	defer conn.Close()
+
+	provider := redis.NewReservedOpProvider(conn)
+	txHashes, err := provider.GetTxHashes(account)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Reservation, 0)
+	for _, txHash := range txHashes {
+		ops, err := provider.Get(txHash)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, op := range ops {
+			if op.Account == account {
+				result = append(result, readReservation(op))
+			}
+		}
+	}
+
+	sort.Sort(byReservedAt(result))
+	return result, nil
+}
+
+// watch watches reservations of transaction and returns them. Watched reservations can be replaced
+// using store within transaction of the same connection.
+func (r *Reservations) watch(conn redis.ConnectionInterface, txHash string) ([]redis.ReservedOp, error) {
+	err := conn.Watch(redis.GetReservedTxKey(txHash))
+	if err != nil {
+		return nil, err
+	}
+
+	return redis.NewReservedOpProvider(conn).Get(txHash)
+}
+
+func (r *Reservations) store(conn redis.ConnectionInterface, txHash string, ops []redis.ReservedOp) error {
+	return redis.NewReservedOpProvider(conn).Replace(txHash, ops, r.timeout)
+}
+
+// withReservation returns stored ops with reservation added instead of the same op, if any
+func withReservation(stored []redis.ReservedOp, reservation Reservation) []redis.ReservedOp {
+	added := reservation.toReservedOp()
+	result := make([]redis.ReservedOp, 0, len(stored)+1)
+	for i := range stored {
+		if !stored[i].IsSameOp(&added) {
+			result = append(result, stored[i])
+		}
+	}
+	return append(result, added)
+}
+
+func (r *Reservations) remove(txHash string, opIndex int, direction PaymentDirection) error {
+	removed := redis.ReservedOp{TxHash: txHash, Index: opIndex, IsIncoming: direction.IsIncoming()}
+	_, err := r.update(txHash, func(stored []redis.ReservedOp) []redis.ReservedOp {
+		result := make([]redis.ReservedOp, 0, len(stored))
+		for i := range stored {
+			if !stored[i].IsSameOp(&removed) {
+				result = append(result, stored[i])
+			}
+		}
+		return result
+	})
+	return err
+}
+
+// take removes and returns all reservations of transaction
+func (r *Reservations) take(txHash string) ([]Reservation, error) {
+	stored, err := r.update(txHash, func([]redis.ReservedOp) []redis.ReservedOp {
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Reservation, len(stored))
+	for i := range stored {
+		result[i] = readReservation(stored[i])
+	}
+	return result, nil
+}
+
+// update atomically replaces reserved ops of transaction with result of apply. Returns ops stored before update.
+func (r *Reservations) update(txHash string, apply func([]redis.ReservedOp) []redis.ReservedOp) ([]redis.ReservedOp, error) {
+	for i := 0; i < r.numOfRetries; i++ {
+		stored, needRetry, err := r.tryUpdate(txHash, apply)
+		if err != nil {
+			if !redis.IsConnectionClosed(err) {
+				return nil, err
+			}
+			needRetry = true
+		}
+
+		if !needRetry {
+			return stored, nil
+		}
+	}
+
+	return nil, errors.New("Failed to update reservations of transaction")
+}
+
+// Returns true if retry needed
+func (r *Reservations) tryUpdate(txHash string, apply func([]redis.ReservedOp) []redis.ReservedOp) ([]redis.ReservedOp, bool, error) {
+	conn := r.getConnectionProvider().GetConnection()
+	defer conn.Close()
+
+	stored, err := r.watch(conn, txHash)
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = conn.Multi()
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = r.store(conn, txHash, apply(stored))
+	if err != nil {
+		return nil, false, err
+	}
+
+	isOk, err := conn.Exec()
+	if err != nil {
+		return nil, false, err
+	}
+
+	return stored, !isOk, nil
+}
+
+type byReservedAt []Reservation
+
+func (s byReservedAt) Len() int      { return len(s) }
+func (s byReservedAt) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s byReservedAt) Less(i, j int) bool {
+	if !s[i].ReservedAt.Equal(s[j].ReservedAt) {
+		return s[i].ReservedAt.Before(s[j].ReservedAt)
+	}
+	if s[i].TxHash != s[j].TxHash {
+		return s[i].TxHash < s[j].TxHash
+	}
+	return s[i].OpIndex < s[j].OpIndex
+}
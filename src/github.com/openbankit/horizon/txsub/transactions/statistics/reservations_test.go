@@ -0,0 +1,134 @@
+package statistics
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openbankit/go-base/keypair"
+	"github.com/openbankit/go-base/xdr"
+	"github.com/openbankit/horizon/accounttypes"
+	"github.com/openbankit/horizon/db2/history"
+	"github.com/openbankit/horizon/redis"
+	"github.com/openbankit/horizon/test"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReservations(t *testing.T) {
+	counterparties := accounttype.GetAll()
+	config := test.NewTestConfig()
+	sourceKP, err := keypair.Random()
+	assert.Nil(t, err)
+	destKP, err := keypair.Random()
+	assert.Nil(t, err)
+	now := time.Now()
+	source := &history.Account{Address: sourceKP.Address(), AccountType: xdr.AccountTypeAccountRegisteredUser}
+	dest := &history.Account{Address: destKP.Address(), AccountType: xdr.AccountTypeAccountMerchant}
+	txHash := "reserved_tx_hash"
+	paymentData := NewPaymentData(dest, nil, history.Asset{Code: "UAH"}, 100, NewOperationData(source, 0, txHash))
+	direction := PaymentDirectionOutgoing
+
+	Convey("Reservations", t, func() {
+		historyQ := &history.QMock{}
+		historyQ.On("GetStatisticsByAccountAndAsset", source.Address, "UAH", now).Return(nil, nil)
+		storage := redis.NewMemoryStorage()
+		reservations := NewReservations(config.ProcessedOpTimeout)
+		reservations.connectionProvider = storage
+		manager := NewManager(historyQ, counterparties, &config).SetReservations(reservations)
+		manager.connectionProvider = storage
+
+		getOutcome := func() int64 {
+			conn := storage.GetConnection()
+			defer conn.Close()
+			stats, err := redis.NewAccountStatisticsProvider(conn).Get(source.Address, "UAH", counterparties)
+			So(err, ShouldBeNil)
+			return stats.AccountsStatistics[xdr.AccountTypeAccountMerchant].DailyOutcome
+		}
+
+		_, err := manager.UpdateGet(&paymentData, direction, now)
+		So(err, ShouldBeNil)
+		So(getOutcome(), ShouldEqual, 100)
+
+		getReserved := func(account string) []Reservation {
+			reserved, err := reservations.Get(account)
+			So(err, ShouldBeNil)
+			return reserved
+		}
+
+		reserved := getReserved(source.Address)
+		So(len(reserved), ShouldEqual, 1)
+		So(reserved[0].TxHash, ShouldEqual, txHash)
+		So(reserved[0].Amount, ShouldEqual, 100)
+		So(reserved[0].CounterpartyType, ShouldEqual, xdr.AccountTypeAccountMerchant)
+		So(reserved[0].Direction, ShouldEqual, direction)
+		So(getReserved(dest.Address), ShouldBeEmpty)
+
+		Convey("Commit keeps statistics", func() {
+			So(manager.Commit(txHash), ShouldEqual, 1)
+			So(getReserved(source.Address), ShouldBeEmpty)
+			So(getOutcome(), ShouldEqual, 100)
+			So(reservations.Metrics.CommittedMeter.Count(), ShouldEqual, 1)
+			// nothing left to release
+			err := manager.Release(txHash, now)
+			So(err, ShouldBeNil)
+			So(getOutcome(), ShouldEqual, 100)
+		})
+		Convey("Release subtracts statistics", func() {
+			err := manager.Release(txHash, now)
+			So(err, ShouldBeNil)
+			So(getReserved(source.Address), ShouldBeEmpty)
+			So(getOutcome(), ShouldEqual, 0)
+			So(reservations.Metrics.ReleasedMeter.Count(), ShouldEqual, 1)
+		})
+		Convey("Release by another instance", func() {
+			otherReservations := NewReservations(config.ProcessedOpTimeout)
+			otherReservations.connectionProvider = storage
+			other := NewManager(historyQ, counterparties, &config).SetReservations(otherReservations)
+			other.connectionProvider = storage
+			err := other.Release(txHash, now)
+			So(err, ShouldBeNil)
+			So(getReserved(source.Address), ShouldBeEmpty)
+			So(getOutcome(), ShouldEqual, 0)
+			So(manager.Commit(txHash), ShouldEqual, 0)
+		})
+		Convey("Statistics are not updated without reservation", func() {
+			manager.connectionProvider = &failingReservationsProvider{storage}
+			otherData := NewPaymentData(dest, nil, history.Asset{Code: "UAH"}, 50, NewOperationData(source, 0, "other_tx_hash"))
+			_, err := manager.UpdateGet(&otherData, direction, now)
+			So(err, ShouldNotBeNil)
+			So(getOutcome(), ShouldEqual, 100)
+			So(getReserved(source.Address), ShouldHaveLength, 1)
+		})
+		Convey("Cancel removes reservation", func() {
+			err := manager.CancelOp(&paymentData, direction, now)
+			So(err, ShouldBeNil)
+			So(getReserved(source.Address), ShouldBeEmpty)
+			So(getOutcome(), ShouldEqual, 0)
+			err = manager.Release(txHash, now)
+			So(err, ShouldBeNil)
+			So(getOutcome(), ShouldEqual, 0)
+		})
+	})
+}
+
+// failingReservationsProvider provides connections, which fail to store reservations
+type failingReservationsProvider struct {
+	storage *redis.MemoryStorage
+}
+
+func (p *failingReservationsProvider) GetConnection() redis.ConnectionInterface {
+	return &failingReservationsConnection{p.storage.GetConnection()}
+}
+
+type failingReservationsConnection struct {
+	redis.ConnectionInterface
+}
+
+func (c *failingReservationsConnection) HMSet(args ...interface{}) error {
+	if key, ok := args[0].(string); ok && strings.HasPrefix(key, redis.GetReservedTxKey("")) {
+		return errors.New("failed to store reservation")
+	}
+	return c.ConnectionInterface.HMSet(args...)
+}
@@ -7,10 +7,18 @@ import (
 	"time"
 )
 
+// OperationCheckResult is a result of validation of single operation
+type OperationCheckResult struct {
+	IsValid bool
+	Result  results.OperationResult
+}
+
 type TransactionFrame struct {
 	Tx       *xdr.TransactionEnvelope
 	TxHash   string
 	txResult *results.RestrictedTransactionError
+	opFrames []OperationFrame
+	opValid  []bool
 	log      *log.Entry
 }
 
@@ -55,6 +63,8 @@ func (t *TransactionFrame) checkTransaction() (bool, error) {
 
 func (t *TransactionFrame) checkOperations(manager *Manager) (bool, error) {
 	opFrames := make([]OperationFrame, len(t.Tx.Tx.Operations))
+	t.opFrames = opFrames
+	t.opValid = make([]bool, len(opFrames))
 	isValid := true
 	now := time.Now()
 	for i, op := range t.Tx.Tx.Operations {
@@ -66,6 +76,7 @@ func (t *TransactionFrame) checkOperations(manager *Manager) (bool, error) {
 			return false, err
 		}
 
+		t.opValid[i] = isOpValid
 		if !isOpValid {
 			t.log.WithField("operation_i", i).WithField("result", opFrames[i].GetResult()).Debug("Is not valid")
 			isValid = false
@@ -105,3 +116,19 @@ func (t *TransactionFrame) makeFailedTxResult(opFrames []OperationFrame) (*resul
 func (t *TransactionFrame) GetResult() *results.RestrictedTransactionError {
 	return t.txResult
 }
+
+// GetOperationResults returns results of checked operations. Returns nil, if operations were not checked.
+func (t *TransactionFrame) GetOperationResults() []OperationCheckResult {
+	if t.opFrames == nil {
+		return nil
+	}
+
+	checkResults := make([]OperationCheckResult, len(t.opFrames))
+	for i := range t.opFrames {
+		checkResults[i] = OperationCheckResult{
+			IsValid: t.opValid[i],
+			Result:  t.opFrames[i].GetResult(),
+		}
+	}
+	return checkResults
+}
@@ -1,97 +1,62 @@
 package validators
 
 import (
+	"fmt"
 	"github.com/openbankit/go-base/xdr"
+	"github.com/openbankit/horizon/db2/history"
+	"github.com/openbankit/horizon/log"
 	"github.com/openbankit/horizon/txsub/results"
-	"fmt"
 )
 
 type AccountTypeValidatorInterface interface {
-	VerifyAccountTypesForPayment(from, to xdr.AccountType) *results.RestrictedForAccountTypeError
+	VerifyAccountTypesForPayment(from, to xdr.AccountType) (*results.RestrictedForAccountTypeError, error)
 }
 
 type AccountTypeValidator struct {
+	historyQ history.QInterface
+	log      *log.Entry
 }
 
-func NewAccountTypeValidator() *AccountTypeValidator {
-	return &AccountTypeValidator{}
+func NewAccountTypeValidator(historyQ history.QInterface) *AccountTypeValidator {
+	return &AccountTypeValidator{
+		historyQ: historyQ,
+		log:      log.WithField("service", "account_type_validator"),
+	}
 }
 
 // VerifyAccountTypesForPayment performs account types check for payment operation
-func (v *AccountTypeValidator) VerifyAccountTypesForPayment(from, to xdr.AccountType) *results.RestrictedForAccountTypeError {
-	if !contains(typeRestrictions[from], to) {
+func (v *AccountTypeValidator) VerifyAccountTypesForPayment(from, to xdr.AccountType) (*results.RestrictedForAccountTypeError, error) {
+	restrictions, err := v.getRestrictions()
+	if err != nil {
+		return nil, err
+	}
+
+	isAllowed, err := restrictions.IsAllowed(from, to)
+	if err != nil {
+		v.log.WithError(err).Error("Failed to check account type restrictions")
+		return nil, err
+	}
+
+	if !isAllowed {
 		return &results.RestrictedForAccountTypeError{
 			Reason: fmt.Sprintf("Payments from %s to %s are restricted.", from.String(), to.String()),
-		}
+		}, nil
 	}
 
-	return nil
+	return nil, nil
 }
 
-func contains(list []xdr.AccountType, a xdr.AccountType) bool {
-	for _, b := range list {
-		if b == a {
-			return true
-		}
+// getRestrictions returns restrictions set by admin or default, if they were not set
+func (v *AccountTypeValidator) getRestrictions() (*history.AccountTypeRestrictions, error) {
+	stored, err := v.historyQ.OptionsByName(history.OPTIONS_ACCOUNT_TYPE_RESTRICTIONS)
+	if err != nil {
+		v.log.WithError(err).Error("Failed to get account type restrictions from db")
+		return nil, err
 	}
-	return false
-}
-
-// TODO: generate from template?
-// TODO: use sets instead of arrays
-// typeRestrictions describes who can send payments to whom
-var typeRestrictions = map[xdr.AccountType][]xdr.AccountType{
 
-	xdr.AccountTypeAccountBank: []xdr.AccountType{
-		xdr.AccountTypeAccountDistributionAgent,
-		xdr.AccountTypeAccountExchangeAgent,
-	},
-
-	xdr.AccountTypeAccountCommission: []xdr.AccountType{
-		xdr.AccountTypeAccountDistributionAgent,
-	},
-
-	xdr.AccountTypeAccountDistributionAgent: []xdr.AccountType{
-		xdr.AccountTypeAccountAnonymousUser,
-		xdr.AccountTypeAccountRegisteredUser,
-		xdr.AccountTypeAccountMerchant,
-		xdr.AccountTypeAccountSettlementAgent,
-		xdr.AccountTypeAccountScratchCard,
-	},
-
-	xdr.AccountTypeAccountSettlementAgent: []xdr.AccountType{
-		xdr.AccountTypeAccountBank,
-	},
-
-	xdr.AccountTypeAccountExchangeAgent: []xdr.AccountType{
-		xdr.AccountTypeAccountBank,
-		xdr.AccountTypeAccountAnonymousUser,
-	},
-
-	xdr.AccountTypeAccountAnonymousUser: []xdr.AccountType{
-		xdr.AccountTypeAccountAnonymousUser,
-		xdr.AccountTypeAccountRegisteredUser,
-		xdr.AccountTypeAccountMerchant,
-		xdr.AccountTypeAccountSettlementAgent,
-		xdr.AccountTypeAccountExchangeAgent,
-	},
-
-	xdr.AccountTypeAccountRegisteredUser: []xdr.AccountType{
-		xdr.AccountTypeAccountAnonymousUser,
-		xdr.AccountTypeAccountRegisteredUser,
-		xdr.AccountTypeAccountMerchant,
-		xdr.AccountTypeAccountSettlementAgent,
-	},
-
-	xdr.AccountTypeAccountMerchant: []xdr.AccountType{
-		xdr.AccountTypeAccountAnonymousUser,
-		xdr.AccountTypeAccountRegisteredUser,
-		xdr.AccountTypeAccountMerchant,
-		xdr.AccountTypeAccountSettlementAgent,
-	},
+	if stored == nil {
+		return history.NewAccountTypeRestrictions(), nil
+	}
 
-	xdr.AccountTypeAccountScratchCard: []xdr.AccountType{
-		xdr.AccountTypeAccountAnonymousUser,
-		xdr.AccountTypeAccountRegisteredUser,
-	},
+	return stored.AccountTypeRestrictions(), nil
 }
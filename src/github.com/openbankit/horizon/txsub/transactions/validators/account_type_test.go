@@ -3,17 +3,49 @@ package validators
 import (
 	"testing"
 
+	"errors"
 	"github.com/openbankit/go-base/xdr"
+	"github.com/openbankit/horizon/db2/history"
 	. "github.com/smartystreets/goconvey/convey"
 )
 
 func TestAccountTypes(t *testing.T) {
 	Convey("VerifyAccountTypesForPayment:", t, func() {
+		historyQ := &history.QMock{}
+		validator := NewAccountTypeValidator(historyQ)
 		Convey("Bank can't send to anon user", func() {
-			validator := NewAccountTypeValidator()
-			err := validator.VerifyAccountTypesForPayment(xdr.AccountTypeAccountBank, xdr.AccountTypeAccountAnonymousUser)
-			So(err, ShouldNotBeNil)
+			historyQ.On("OptionsByName", history.OPTIONS_ACCOUNT_TYPE_RESTRICTIONS).Return(nil, nil).Once()
+			result, err := validator.VerifyAccountTypesForPayment(xdr.AccountTypeAccountBank, xdr.AccountTypeAccountAnonymousUser)
+			So(err, ShouldBeNil)
+			So(result, ShouldNotBeNil)
+		})
+		Convey("Failed to load restrictions", func() {
+			expectedErr := errors.New("failed to load options")
+			historyQ.On("OptionsByName", history.OPTIONS_ACCOUNT_TYPE_RESTRICTIONS).Return(nil, expectedErr).Once()
+			result, err := validator.VerifyAccountTypesForPayment(xdr.AccountTypeAccountBank, xdr.AccountTypeAccountAnonymousUser)
+			So(err, ShouldEqual, expectedErr)
+			So(result, ShouldBeNil)
+		})
+		Convey("Restrictions set by admin", func() {
+			restrictions := history.NewAccountTypeRestrictions()
+			err := restrictions.SetRestrictions(map[xdr.AccountType][]xdr.AccountType{
+				xdr.AccountTypeAccountMerchant: []xdr.AccountType{
+					xdr.AccountTypeAccountSettlementAgent,
+				},
+			})
+			So(err, ShouldBeNil)
+			stored := history.Options(*restrictions)
+			historyQ.On("OptionsByName", history.OPTIONS_ACCOUNT_TYPE_RESTRICTIONS).Return(&stored, nil)
+			Convey("Allowed pair", func() {
+				result, err := validator.VerifyAccountTypesForPayment(xdr.AccountTypeAccountMerchant, xdr.AccountTypeAccountSettlementAgent)
+				So(err, ShouldBeNil)
+				So(result, ShouldBeNil)
+			})
+			Convey("Pair allowed by default is restricted", func() {
+				result, err := validator.VerifyAccountTypesForPayment(xdr.AccountTypeAccountMerchant, xdr.AccountTypeAccountRegisteredUser)
+				So(err, ShouldBeNil)
+				So(result, ShouldNotBeNil)
+			})
 		})
-
 	})
 }
@@ -21,9 +21,7 @@ type IncomingLimitsValidator struct {
 	limitsValidator
 	statsManager statistics.ManagerInterface
 
-	dailyIncome   *int64
-	monthlyIncome *int64
-	balance       *int64
+	balance *int64
 }
 
 func NewIncomingLimitsValidator(paymentData *statistics.PaymentData, historyQ history.QInterface,
@@ -41,58 +39,38 @@ func NewIncomingLimitsValidator(paymentData *statistics.PaymentData, historyQ hi
 
 // VerifyLimits checks incoming limits
 func (v *IncomingLimitsValidator) VerifyLimits() (*results.ExceededLimitError, error) {
-	// check account's limits
+	// check account's or account type's limits
 	result, err := v.verifyReceiverAccountLimits()
 	if result != nil || err != nil {
 		return result, err
 	}
 
+	// check account's limits for payments from counterparty's type
+	result, err = v.verifyReceiverCounterpartyLimits()
+	if result != nil || err != nil {
+		return result, err
+	}
+
+	// check global restrictions for anonymous assets
 	return v.verifyAnonymousAssetLimits()
 }
 
 func (v *IncomingLimitsValidator) verifyReceiverAccountLimits() (*results.ExceededLimitError, error) {
-	limits, err := v.GetAccountLimits()
+	limits, err := v.GetLimits()
 	if err != nil || limits == nil {
 		return nil, err
 	}
 
-	v.log.WithField("limits", limits).Debug("Checking limits")
-	if limits.MaxOperationIn >= 0 && v.paymentData.Amount > limits.MaxOperationIn {
-		description := v.opMaxAmountExceededDescription(limits.MaxOperationIn)
-		return &results.ExceededLimitError{Description: description}, nil
-	}
+	return v.verifyAccountLimits(limits)
+}
 
-	if limits.DailyMaxIn >= 0 {
-		updatedDailyIncome, err := v.getUpdatedDailyIncome()
-		if err != nil {
-			return nil, err
-		}
-
-		v.log.WithFields(log.F{
-			"newIncome": updatedDailyIncome,
-			"limit":     limits.DailyMaxIn,
-		}).Debug("Checking daily income for limits")
-		if updatedDailyIncome > limits.DailyMaxIn {
-			description := v.limitExceededDescription("Daily", false, updatedDailyIncome, limits.DailyMaxIn)
-			return &results.ExceededLimitError{Description: description}, nil
-		}
+func (v *IncomingLimitsValidator) verifyReceiverCounterpartyLimits() (*results.ExceededLimitError, error) {
+	limits, err := v.GetCounterpartyLimits()
+	if err != nil || limits == nil {
+		return nil, err
 	}
 
-	if limits.MonthlyMaxIn >= 0 {
-		updatedMonthlyIncome, err := v.getUpdatedMonthlyIncome()
-		if err != nil {
-			return nil, err
-		}
-		v.log.WithFields(log.F{
-			"newIncome": updatedMonthlyIncome,
-			"limit":     limits.MonthlyMaxIn,
-		}).Debug("Checking daily income for limits")
-		if updatedMonthlyIncome > limits.MonthlyMaxIn {
-			description := v.limitExceededDescription("Monthly", false, updatedMonthlyIncome, limits.MonthlyMaxIn)
-			return &results.ExceededLimitError{Description: description}, nil
-		}
-	}
-	return nil, nil
+	return v.verifyAccountLimits(limits)
 }
 
 // VerifyLimitsForReceiver checks limits  and restrictions for receiver
@@ -119,46 +97,6 @@ func (v *IncomingLimitsValidator) verifyAnonymousAssetLimits() (*results.Exceede
 	return nil, nil
 }
 
-func (v *IncomingLimitsValidator) getUpdatedDailyIncome() (int64, error) {
-	if v.dailyIncome != nil {
-		return *v.dailyIncome, nil
-	}
-	v.dailyIncome = new(int64)
-	stats, err := v.updateGetAccountStats()
-	if err != nil {
-		return 0, err
-	}
-
-	*v.dailyIncome = helpers.SumAccountStats(
-		stats.AccountsStatistics,
-		func(stats *history.AccountStatistics) int64 { return stats.DailyIncome },
-		xdr.AccountTypeAccountAnonymousUser,
-		xdr.AccountTypeAccountRegisteredUser,
-		xdr.AccountTypeAccountSettlementAgent,
-	)
-	return *v.dailyIncome, nil
-}
-
-func (v *IncomingLimitsValidator) getUpdatedMonthlyIncome() (int64, error) {
-	if v.monthlyIncome != nil {
-		return *v.monthlyIncome, nil
-	}
-	v.monthlyIncome = new(int64)
-	stats, err := v.updateGetAccountStats()
-	if err != nil {
-		return 0, err
-	}
-
-	*v.monthlyIncome = helpers.SumAccountStats(
-		stats.AccountsStatistics,
-		func(stats *history.AccountStatistics) int64 { return stats.MonthlyIncome },
-		xdr.AccountTypeAccountAnonymousUser,
-		xdr.AccountTypeAccountRegisteredUser,
-		xdr.AccountTypeAccountSettlementAgent,
-	)
-	return *v.monthlyIncome, nil
-}
-
 func (v *IncomingLimitsValidator) getUpdatedBalance() (int64, error) {
 	if v.balance != nil {
 		return *v.balance, nil
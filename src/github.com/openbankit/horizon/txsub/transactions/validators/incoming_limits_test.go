@@ -17,6 +17,7 @@ import (
 	"github.com/guregu/null"
 	. "github.com/smartystreets/goconvey/convey"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"time"
 )
 
@@ -47,14 +48,19 @@ func TestIncomingLimits(t *testing.T) {
 	direction := statistics.PaymentDirectionIncoming
 
 	accountLimits := history.AccountLimits{
-		Account:         paymentData.GetAccount(direction).Address,
-		AssetCode:       opAsset.Code,
-		MaxOperationOut: -1,
-		DailyMaxOut:     -1,
-		MonthlyMaxOut:   -1,
-		MaxOperationIn:  -1,
-		DailyMaxIn:      -1,
-		MonthlyMaxIn:    -1,
+		Account:          paymentData.GetAccount(direction).Address,
+		AssetCode:        opAsset.Code,
+		CounterpartyType: history.AnyCounterpartyType,
+		MaxOperationOut:  -1,
+		DailyMaxOut:      -1,
+		WeeklyMaxOut:     -1,
+		MonthlyMaxOut:    -1,
+		AnnualMaxOut:     -1,
+		MaxOperationIn:   -1,
+		DailyMaxIn:       -1,
+		WeeklyMaxIn:      -1,
+		MonthlyMaxIn:     -1,
+		AnnualMaxIn:      -1,
 	}
 
 	statsManager := &statistics.ManagerMock{}
@@ -64,6 +70,9 @@ func TestIncomingLimits(t *testing.T) {
 		Convey("No limits for account & asset is not anonymous", func() {
 			histMock := history.QMock{}
 			histMock.On("GetAccountLimits", paymentData.GetAccount(direction).Address, opAsset.Code).Return(nil, sql.ErrNoRows)
+			histMock.On("GetAccountLimitsForCounterparty", paymentData.GetAccount(direction).Address, opAsset.Code, mock.Anything).Return(nil, sql.ErrNoRows)
+			histMock.On("GetAccountTypeLimitsForCounterparty", paymentData.GetAccount(direction).AccountType, opAsset.Code, mock.Anything).Return(nil, sql.ErrNoRows)
+			histMock.On("GetAccountTypeLimits", paymentData.GetAccount(direction).AccountType, opAsset.Code).Return(nil, sql.ErrNoRows)
 			v := NewIncomingLimitsValidator(&paymentData, &histMock, statsManager, config.AnonymousUserRestrictions{}, now)
 			result, err := v.VerifyLimits()
 			So(err, ShouldBeNil)
@@ -73,6 +82,8 @@ func TestIncomingLimits(t *testing.T) {
 			histMock := history.QMock{}
 			limits := accountLimits
 			histMock.On("GetAccountLimits", paymentData.GetAccount(direction).Address, opAsset.Code).Return(limits, nil)
+			histMock.On("GetAccountLimitsForCounterparty", paymentData.GetAccount(direction).Address, opAsset.Code, mock.Anything).Return(nil, sql.ErrNoRows)
+			histMock.On("GetAccountTypeLimitsForCounterparty", paymentData.GetAccount(direction).AccountType, opAsset.Code, mock.Anything).Return(nil, sql.ErrNoRows)
 			v := NewIncomingLimitsValidator(&paymentData, &histMock, statsManager, config.AnonymousUserRestrictions{}, now)
 			result, err := v.VerifyLimits()
 			So(err, ShouldBeNil)
@@ -83,6 +94,8 @@ func TestIncomingLimits(t *testing.T) {
 			limits.MaxOperationIn = opAmount - 1
 			histMock := history.QMock{}
 			histMock.On("GetAccountLimits", paymentData.GetAccount(direction).Address, opAsset.Code).Return(limits, nil)
+			histMock.On("GetAccountLimitsForCounterparty", paymentData.GetAccount(direction).Address, opAsset.Code, mock.Anything).Return(nil, sql.ErrNoRows)
+			histMock.On("GetAccountTypeLimitsForCounterparty", paymentData.GetAccount(direction).AccountType, opAsset.Code, mock.Anything).Return(nil, sql.ErrNoRows)
 			v := NewIncomingLimitsValidator(&paymentData, &histMock, statsManager, config.AnonymousUserRestrictions{}, now)
 			result, err := v.VerifyLimits()
 			So(err, ShouldBeNil)
@@ -94,11 +107,45 @@ func TestIncomingLimits(t *testing.T) {
 				opAsset.Code,
 			)}, result)
 		})
+		Convey("No limits for account, exceeds op amount of account type limits", func() {
+			typeLimits := history.AccountTypeLimits{
+				AccountType:      paymentData.GetAccount(direction).AccountType,
+				AssetCode:        opAsset.Code,
+				CounterpartyType: history.AnyCounterpartyType,
+				MaxOperationOut:  -1,
+				DailyMaxOut:      -1,
+				WeeklyMaxOut:     -1,
+				MonthlyMaxOut:    -1,
+				AnnualMaxOut:     -1,
+				MaxOperationIn:   opAmount - 1,
+				DailyMaxIn:       -1,
+				WeeklyMaxIn:      -1,
+				MonthlyMaxIn:     -1,
+				AnnualMaxIn:      -1,
+			}
+			histMock := history.QMock{}
+			histMock.On("GetAccountLimits", paymentData.GetAccount(direction).Address, opAsset.Code).Return(nil, sql.ErrNoRows)
+			histMock.On("GetAccountLimitsForCounterparty", paymentData.GetAccount(direction).Address, opAsset.Code, mock.Anything).Return(nil, sql.ErrNoRows)
+			histMock.On("GetAccountTypeLimitsForCounterparty", paymentData.GetAccount(direction).AccountType, opAsset.Code, mock.Anything).Return(nil, sql.ErrNoRows)
+			histMock.On("GetAccountTypeLimits", paymentData.GetAccount(direction).AccountType, opAsset.Code).Return(typeLimits, nil)
+			v := NewIncomingLimitsValidator(&paymentData, &histMock, statsManager, config.AnonymousUserRestrictions{}, now)
+			result, err := v.VerifyLimits()
+			So(err, ShouldBeNil)
+			assert.Equal(t, &results.ExceededLimitError{Description: fmt.Sprintf(
+				"Maximal operation amount for account (%s) exceeded: %s of %s %s",
+				paymentData.GetAccount(direction).Address,
+				amount.String(xdr.Int64(opAmount)),
+				amount.String(xdr.Int64(typeLimits.MaxOperationIn)),
+				opAsset.Code,
+			)}, result)
+		})
 		Convey("Asset is not anonymous, exceeds daily limit with stats", func() {
 			limits := accountLimits
 			limits.DailyMaxIn = 2*opAmount - 1
 			histMock := history.QMock{}
 			histMock.On("GetAccountLimits", paymentData.GetAccount(direction).Address, opAsset.Code).Return(limits, nil)
+			histMock.On("GetAccountLimitsForCounterparty", paymentData.GetAccount(direction).Address, opAsset.Code, mock.Anything).Return(nil, sql.ErrNoRows)
+			histMock.On("GetAccountTypeLimitsForCounterparty", paymentData.GetAccount(direction).AccountType, opAsset.Code, mock.Anything).Return(nil, sql.ErrNoRows)
 			stats := &redis.AccountStatistics{
 				Balance: 0,
 				AccountsStatistics: map[xdr.AccountType]history.AccountStatistics{
@@ -125,6 +172,8 @@ func TestIncomingLimits(t *testing.T) {
 			limits.MonthlyMaxIn = 2*opAmount - 1
 			histMock := history.QMock{}
 			histMock.On("GetAccountLimits", paymentData.GetAccount(direction).Address, opAsset.Code).Return(limits, nil)
+			histMock.On("GetAccountLimitsForCounterparty", paymentData.GetAccount(direction).Address, opAsset.Code, mock.Anything).Return(nil, sql.ErrNoRows)
+			histMock.On("GetAccountTypeLimitsForCounterparty", paymentData.GetAccount(direction).AccountType, opAsset.Code, mock.Anything).Return(nil, sql.ErrNoRows)
 			stats := &redis.AccountStatistics{
 				Balance: 0,
 				AccountsStatistics: map[xdr.AccountType]history.AccountStatistics{
@@ -146,6 +195,35 @@ func TestIncomingLimits(t *testing.T) {
 				opAsset.Code,
 			)}, result)
 		})
+		Convey("Asset is not anonymous, exceeds weekly limit for counterparty type", func() {
+			counterpartyType := paymentData.GetCounterparty(direction).AccountType
+			limits := accountLimits
+			limits.CounterpartyType = int16(counterpartyType)
+			limits.WeeklyMaxIn = 2*opAmount - 1
+			histMock := history.QMock{}
+			histMock.On("GetAccountLimits", paymentData.GetAccount(direction).Address, opAsset.Code).Return(accountLimits, nil)
+			histMock.On("GetAccountLimitsForCounterparty", paymentData.GetAccount(direction).Address, opAsset.Code, int16(counterpartyType)).Return(limits, nil)
+			stats := &redis.AccountStatistics{
+				AccountsStatistics: map[xdr.AccountType]history.AccountStatistics{
+					counterpartyType: history.AccountStatistics{
+						Account:          paymentData.GetAccount(direction).Address,
+						AssetCode:        opAsset.Code,
+						CounterpartyType: int16(counterpartyType),
+						WeeklyIncome:     opAmount + opAmount,
+					},
+				},
+			}
+			statsManager.On("UpdateGet", &paymentData, direction, now).Return(stats, nil).Once()
+			v := NewIncomingLimitsValidator(&paymentData, &histMock, statsManager, config.AnonymousUserRestrictions{}, now)
+			result, err := v.VerifyLimits()
+			So(err, ShouldBeNil)
+			assert.Equal(t, &results.ExceededLimitError{Description: fmt.Sprintf("Weekly incoming payments limit for account exceeded for counterparty type %s: %s out of %s %s.",
+				counterpartyType.String(),
+				amount.String(xdr.Int64(opAmount+opAmount)),
+				amount.String(xdr.Int64(limits.WeeklyMaxIn)),
+				opAsset.Code,
+			)}, result)
+		})
 		stats := &redis.AccountStatistics{
 			Balance: 0,
 			AccountsStatistics: map[xdr.AccountType]history.AccountStatistics{
@@ -167,13 +245,16 @@ func TestIncomingLimits(t *testing.T) {
 			paymentData.Asset.IsAnonymous = true
 			histMock := history.QMock{}
 			histMock.On("GetAccountLimits", paymentData.GetAccount(direction).Address, opAsset.Code).Return(nil, sql.ErrNoRows)
+			histMock.On("GetAccountLimitsForCounterparty", paymentData.GetAccount(direction).Address, opAsset.Code, mock.Anything).Return(nil, sql.ErrNoRows)
+			histMock.On("GetAccountTypeLimitsForCounterparty", paymentData.GetAccount(direction).AccountType, opAsset.Code, mock.Anything).Return(nil, sql.ErrNoRows)
+			histMock.On("GetAccountTypeLimits", paymentData.GetAccount(direction).AccountType, opAsset.Code).Return(nil, sql.ErrNoRows)
 			statsManager.On("UpdateGet", &paymentData, direction, now).Return(stats, nil).Once()
 			v := NewIncomingLimitsValidator(&paymentData, &histMock, statsManager, limits, now)
 			result, err := v.VerifyLimits()
 			So(err, ShouldBeNil)
 			assert.Equal(t, &results.ExceededLimitError{Description: fmt.Sprintf(
 				"User's max balance exceeded: %s + %s out of %s UAH.",
-				amount.String(xdr.Int64(stats.Balance - opAmount)),
+				amount.String(xdr.Int64(stats.Balance-opAmount)),
 				amount.String(xdr.Int64(opAmount)),
 				amount.String(xdr.Int64(limits.MaxBalance)),
 			)}, result)
@@ -186,8 +267,11 @@ func TestIncomingLimits(t *testing.T) {
 			opAsset.IsAnonymous = true
 			histMock := history.QMock{}
 			histMock.On("GetAccountLimits", paymentData.GetAccount(direction).Address, opAsset.Code).Return(nil, sql.ErrNoRows)
+			histMock.On("GetAccountLimitsForCounterparty", paymentData.GetAccount(direction).Address, opAsset.Code, mock.Anything).Return(nil, sql.ErrNoRows)
+			histMock.On("GetAccountTypeLimitsForCounterparty", paymentData.GetAccount(direction).AccountType, opAsset.Code, mock.Anything).Return(nil, sql.ErrNoRows)
 			statsManager.On("UpdateGet", &paymentData, direction, now).Return(stats, nil).Once()
 			paymentData.GetAccount(direction).AccountType = xdr.AccountTypeAccountMerchant
+			histMock.On("GetAccountTypeLimits", paymentData.GetAccount(direction).AccountType, opAsset.Code).Return(nil, sql.ErrNoRows)
 			v := NewIncomingLimitsValidator(&paymentData, &histMock, statsManager, limits, now)
 			result, err := v.VerifyLimits()
 			So(err, ShouldBeNil)
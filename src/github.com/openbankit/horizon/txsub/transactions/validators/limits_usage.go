@@ -0,0 +1,320 @@
+package validators
+
+import (
+	"github.com/openbankit/go-base/xdr"
+	"github.com/openbankit/horizon/config"
+	"github.com/openbankit/horizon/db2/history"
+	"github.com/openbankit/horizon/redis"
+	"github.com/openbankit/horizon/txsub/transactions/helpers"
+	"github.com/openbankit/horizon/txsub/transactions/statistics"
+	"time"
+)
+
+// Sources of limits rules
+const (
+	LimitsSourceAccount       = "account"
+	LimitsSourceAccountType   = "account_type"
+	LimitsSourceCounterparty  = "counterparty"
+	LimitsSourceAnonymousUser = "anonymous_user"
+	// LimitsSourceAccountTypeCounterparty - default limits of account type applied to payments with counterparty type
+	LimitsSourceAccountTypeCounterparty = "account_type_counterparty"
+)
+
+// Unlimited is used as limit and remaining amount, if there is no limit
+const Unlimited int64 = -1
+
+// PeriodUsage is a limit on total amount of payments for the period and amount already used
+type PeriodUsage struct {
+	Period    string
+	Limit     int64
+	Used      int64
+	Remaining int64
+}
+
+// LimitsRuleUsage is usage of single set of limits in one direction
+type LimitsRuleUsage struct {
+	Source    string
+	Direction statistics.PaymentDirection
+	// rule is applied only to payments with counterparty of this type. AnyCounterpartyType - to all payments
+	CounterpartyType int16
+	MaxOperation     int64
+	Periods          []PeriodUsage
+	// MaxPayment is maximal amount of single payment allowed by the rule. Unlimited, if there is no limit
+	MaxPayment int64
+	// BindingPeriod is the period MaxPayment is limited by. `operation` if it is limited by MaxOperation
+	BindingPeriod string
+}
+
+// Headroom is maximal amount of single payment allowed by all rules applied to payments with any counterparty
+type Headroom struct {
+	MaxPayment    int64
+	BindingSource string
+	BindingPeriod string
+}
+
+// LimitsUsage is usage of limits and anonymous user restrictions applied to account's payments in asset
+type LimitsUsage struct {
+	AssetCode   string
+	IsAnonymous bool
+	Rules       []LimitsRuleUsage
+	Outgoing    Headroom
+	Incoming    Headroom
+}
+
+// GetLimitsUsage computes usage of limits applied to payments of account in asset using current account's statistics.
+// Amounts are computed the same way outgoing and incoming limits validators do.
+func GetLimitsUsage(historyQ history.QInterface, account *history.Account, asset history.Asset, stats *redis.AccountStatistics,
+	anonUserRestr config.AnonymousUserRestrictions, now time.Time) (*LimitsUsage, error) {
+	result := &LimitsUsage{
+		AssetCode:   asset.Code,
+		IsAnonymous: asset.IsAnonymous,
+	}
+
+	counterpartyLimits, err := getCounterpartyLimits(historyQ, account, asset.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	typeCounterpartyLimits, err := getAccountTypeCounterpartyLimits(historyQ, account, asset.Code, counterpartyLimits)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, direction := range []statistics.PaymentDirection{statistics.PaymentDirectionOutgoing, statistics.PaymentDirectionIncoming} {
+		// account is used as counterparty as well, as only rules applied to all counterparties are requested
+		paymentData := statistics.PaymentData{
+			OperationData: statistics.OperationData{Source: account},
+			Destination:   account,
+			Asset:         asset,
+		}
+		v := newLimitsValidator(direction, &paymentData, nil, historyQ, anonUserRestr, now)
+		v.accountStats = stats
+
+		accountLimits, err := v.GetAccountLimits()
+		if err != nil {
+			return nil, err
+		}
+
+		source := LimitsSourceAccount
+		if accountLimits == nil {
+			source = LimitsSourceAccountType
+			accountLimits, err = v.GetAccountTypeLimits()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		var rules []LimitsRuleUsage
+		if accountLimits != nil {
+			rule, err := v.getRuleUsage(source, accountLimits)
+			if err != nil {
+				return nil, err
+			}
+			rules = append(rules, *rule)
+		}
+
+		rule, err := v.getAnonymousRuleUsage()
+		if err != nil {
+			return nil, err
+		}
+		if rule != nil {
+			rules = append(rules, *rule)
+		}
+
+		headroom := getHeadroom(rules)
+		for i := range counterpartyLimits {
+			rule, err := v.getRuleUsage(LimitsSourceCounterparty, &counterpartyLimits[i])
+			if err != nil {
+				return nil, err
+			}
+			rules = append(rules, *rule)
+		}
+		for i := range typeCounterpartyLimits {
+			rule, err := v.getRuleUsage(LimitsSourceAccountTypeCounterparty, &typeCounterpartyLimits[i])
+			if err != nil {
+				return nil, err
+			}
+			rules = append(rules, *rule)
+		}
+
+		result.Rules = append(result.Rules, rules...)
+		if direction.IsIncoming() {
+			result.Incoming = headroom
+		} else {
+			result.Outgoing = headroom
+		}
+	}
+	return result, nil
+}
+
+// getCounterpartyLimits returns limits of the account applied to payments with counterparties of specific types
+func getCounterpartyLimits(historyQ history.QInterface, account *history.Account, assetCode string) ([]history.AccountLimits, error) {
+	limitedAssets, err := account.UnmarshalLimitedAssets()
+	if err != nil {
+		return nil, err
+	}
+	if _, contains := limitedAssets[assetCode]; !contains {
+		return nil, nil
+	}
+
+	var limits []history.AccountLimits
+	err = historyQ.GetLimitsByAccount(&limits, account.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []history.AccountLimits
+	for _, limit := range limits {
+		if limit.AssetCode == assetCode && limit.CounterpartyType != history.AnyCounterpartyType {
+			result = append(result, limit)
+		}
+	}
+	return result, nil
+}
+
+// getAccountTypeCounterpartyLimits returns default limits of account's type applied to payments with counterparties
+// of specific types, which are not overridden by the account's own counterparty limits
+func getAccountTypeCounterpartyLimits(historyQ history.QInterface, account *history.Account, assetCode string,
+	accountLimits []history.AccountLimits) ([]history.AccountLimits, error) {
+	var typeLimits []history.AccountTypeLimits
+	err := historyQ.GetCounterpartyLimitsByAccountType(&typeLimits, account.AccountType, assetCode)
+	if err != nil {
+		return nil, err
+	}
+
+	overridden := make(map[int16]bool, len(accountLimits))
+	for _, limit := range accountLimits {
+		overridden[limit.CounterpartyType] = true
+	}
+
+	var result []history.AccountLimits
+	for _, limit := range typeLimits {
+		if !overridden[limit.CounterpartyType] {
+			result = append(result, limit.ToAccountLimits(account.Address))
+		}
+	}
+	return result, nil
+}
+
+// getRuleUsage returns usage of account's limits
+func (v *limitsValidator) getRuleUsage(source string, limits *history.AccountLimits) (*LimitsRuleUsage, error) {
+	maxOperation, periodLimits := v.getDirectionLimits(limits)
+	rule := &LimitsRuleUsage{
+		Source:           source,
+		Direction:        v.paymentDirection,
+		CounterpartyType: limits.CounterpartyType,
+		MaxOperation:     maxOperation,
+	}
+
+	for _, periodLimit := range periodLimits {
+		used, err := v.getUpdatedTotal(periodLimit.getStats, limits.CounterpartyType)
+		if err != nil {
+			return nil, err
+		}
+		rule.Periods = append(rule.Periods, newPeriodUsage(periodLimit.periodName, periodLimit.limit, used))
+	}
+
+	rule.setMaxPayment()
+	return rule, nil
+}
+
+// getAnonymousRuleUsage returns usage of anonymous user restrictions. Returns nil, if restrictions are not applied
+func (v *limitsValidator) getAnonymousRuleUsage() (*LimitsRuleUsage, error) {
+	if !v.paymentData.Asset.IsAnonymous || !helpers.IsUser(v.getAccount().AccountType) {
+		return nil, nil
+	}
+
+	rule := &LimitsRuleUsage{
+		Source:           LimitsSourceAnonymousUser,
+		Direction:        v.paymentDirection,
+		CounterpartyType: history.AnyCounterpartyType,
+		MaxOperation:     Unlimited,
+	}
+
+	if v.isIncoming() {
+		stats, err := v.updateGetAccountStats()
+		if err != nil {
+			return nil, err
+		}
+		rule.Periods = append(rule.Periods, newPeriodUsage("Balance", v.anonUserRest.MaxBalance, stats.Balance))
+		rule.setMaxPayment()
+		return rule, nil
+	}
+
+	outgoing := OutgoingLimitsValidator{limitsValidator: *v}
+	periods := []struct {
+		name     string
+		limit    int64
+		getTotal func() (int64, error)
+	}{
+		{"Daily", v.anonUserRest.MaxDailyOutcome, outgoing.getUpdatedDailyOutcome},
+		{"Monthly", v.anonUserRest.MaxMonthlyOutcome, outgoing.getUpdatedMonthlyOutcome},
+		{"Annual", v.anonUserRest.MaxAnnualOutcome, outgoing.getUpdatedAnnualOutcome},
+	}
+	for _, period := range periods {
+		used, err := period.getTotal()
+		if err != nil {
+			return nil, err
+		}
+		rule.Periods = append(rule.Periods, newPeriodUsage(period.name, period.limit, used))
+	}
+
+	rule.setMaxPayment()
+	return rule, nil
+}
+
+func newPeriodUsage(period string, limit, used int64) PeriodUsage {
+	result := PeriodUsage{
+		Period:    period,
+		Limit:     limit,
+		Used:      used,
+		Remaining: Unlimited,
+	}
+
+	if limit >= 0 {
+		result.Remaining = limit - used
+		if result.Remaining < 0 {
+			result.Remaining = 0
+		}
+	}
+	return result
+}
+
+// setMaxPayment sets the smallest of max operation amount and amounts remaining for periods
+func (r *LimitsRuleUsage) setMaxPayment() {
+	r.MaxPayment = Unlimited
+	if r.MaxOperation >= 0 {
+		r.MaxPayment = r.MaxOperation
+		r.BindingPeriod = "Operation"
+	}
+
+	for _, period := range r.Periods {
+		if period.Remaining < 0 {
+			continue
+		}
+
+		if r.MaxPayment < 0 || period.Remaining < r.MaxPayment {
+			r.MaxPayment = period.Remaining
+			r.BindingPeriod = period.Period
+		}
+	}
+}
+
+// getHeadroom returns the most restrictive of rules
+func getHeadroom(rules []LimitsRuleUsage) Headroom {
+	result := Headroom{MaxPayment: Unlimited}
+	for _, rule := range rules {
+		if rule.MaxPayment < 0 {
+			continue
+		}
+
+		if result.MaxPayment < 0 || rule.MaxPayment < result.MaxPayment {
+			result = Headroom{
+				MaxPayment:    rule.MaxPayment,
+				BindingSource: rule.Source,
+				BindingPeriod: rule.BindingPeriod,
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,112 @@
+package validators
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/guregu/null"
+	"github.com/openbankit/go-base/keypair"
+	"github.com/openbankit/go-base/xdr"
+	"github.com/openbankit/horizon/config"
+	"github.com/openbankit/horizon/db2/history"
+	"github.com/openbankit/horizon/redis"
+	"github.com/openbankit/horizon/txsub/transactions/statistics"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLimitsUsage(t *testing.T) {
+	accountKey, err := keypair.Random()
+	assert.Nil(t, err)
+	asset := history.Asset{
+		Code:        "UAH",
+		IsAnonymous: true,
+	}
+	account := &history.Account{
+		Address:       accountKey.Address(),
+		AccountType:   xdr.AccountTypeAccountAnonymousUser,
+		LimitedAssets: null.StringFrom(fmt.Sprintf("{\"%s\":true}", asset.Code)),
+	}
+	limits := history.AccountLimits{
+		Account:          account.Address,
+		AssetCode:        asset.Code,
+		CounterpartyType: history.AnyCounterpartyType,
+		MaxOperationOut:  500,
+		DailyMaxOut:      1000,
+		WeeklyMaxOut:     -1,
+		MonthlyMaxOut:    -1,
+		AnnualMaxOut:     -1,
+		MaxOperationIn:   -1,
+		DailyMaxIn:       -1,
+		WeeklyMaxIn:      -1,
+		MonthlyMaxIn:     -1,
+		AnnualMaxIn:      -1,
+	}
+	merchantLimits := limits
+	merchantLimits.CounterpartyType = int16(xdr.AccountTypeAccountMerchant)
+	merchantLimits.MaxOperationOut = -1
+	merchantLimits.DailyMaxOut = 100
+
+	now := time.Now()
+	userStats := history.NewAccountStatistics(account.Address, asset.Code, xdr.AccountTypeAccountRegisteredUser)
+	userStats.DailyOutcome = 700
+	userStats.MonthlyOutcome = 700
+	userStats.AnnualOutcome = 700
+	merchantStats := history.NewAccountStatistics(account.Address, asset.Code, xdr.AccountTypeAccountMerchant)
+	merchantStats.DailyOutcome = 60
+	merchantStats.AnnualOutcome = 60
+	stats := redis.NewAccountStatistics(account.Address, asset.Code, 300, map[xdr.AccountType]history.AccountStatistics{
+		xdr.AccountTypeAccountRegisteredUser: userStats,
+		xdr.AccountTypeAccountMerchant:       merchantStats,
+	})
+	anonUserRestr := config.AnonymousUserRestrictions{
+		MaxDailyOutcome:   2000,
+		MaxMonthlyOutcome: 900,
+		MaxAnnualOutcome:  -1,
+		MaxBalance:        1000,
+	}
+
+	Convey("Limits usage", t, func() {
+		histMock := history.QMock{}
+		histMock.On("GetAccountLimits", account.Address, asset.Code).Return(limits, nil)
+		histMock.On("GetLimitsByAccount", account.Address).Return([]history.AccountLimits{limits, merchantLimits}, nil)
+		histMock.On("GetCounterpartyLimitsByAccountType", account.AccountType, asset.Code).Return([]history.AccountTypeLimits{
+			{AccountType: account.AccountType, AssetCode: asset.Code, CounterpartyType: merchantLimits.CounterpartyType,
+				MaxOperationOut: -1, DailyMaxOut: 10, WeeklyMaxOut: -1, MonthlyMaxOut: -1, AnnualMaxOut: -1,
+				MaxOperationIn: -1, DailyMaxIn: -1, WeeklyMaxIn: -1, MonthlyMaxIn: -1, AnnualMaxIn: -1},
+			{AccountType: account.AccountType, AssetCode: asset.Code, CounterpartyType: int16(xdr.AccountTypeAccountRegisteredUser),
+				MaxOperationOut: -1, DailyMaxOut: 800, WeeklyMaxOut: -1, MonthlyMaxOut: -1, AnnualMaxOut: -1,
+				MaxOperationIn: -1, DailyMaxIn: -1, WeeklyMaxIn: -1, MonthlyMaxIn: -1, AnnualMaxIn: -1},
+		}, nil)
+
+		usage, err := GetLimitsUsage(&histMock, account, asset, stats, anonUserRestr, now)
+		So(err, ShouldBeNil)
+		So(usage.IsAnonymous, ShouldBeTrue)
+		So(len(usage.Rules), ShouldEqual, 8)
+
+		accountRule := usage.Rules[0]
+		So(accountRule.Source, ShouldEqual, LimitsSourceAccount)
+		So(accountRule.Direction, ShouldEqual, statistics.PaymentDirectionOutgoing)
+		So(accountRule.Periods[0], ShouldResemble, PeriodUsage{Period: "Daily", Limit: 1000, Used: 700, Remaining: 300})
+		So(accountRule.MaxPayment, ShouldEqual, 300)
+
+		anonymousRule := usage.Rules[1]
+		So(anonymousRule.Source, ShouldEqual, LimitsSourceAnonymousUser)
+		So(anonymousRule.Periods[1], ShouldResemble, PeriodUsage{Period: "Monthly", Limit: 900, Used: 700, Remaining: 200})
+		So(anonymousRule.Periods[2], ShouldResemble, PeriodUsage{Period: "Annual", Limit: -1, Used: 760, Remaining: Unlimited})
+
+		merchantRule := usage.Rules[2]
+		So(merchantRule.Source, ShouldEqual, LimitsSourceCounterparty)
+		So(merchantRule.Periods[0], ShouldResemble, PeriodUsage{Period: "Daily", Limit: 100, Used: 60, Remaining: 40})
+
+		// account's merchant limits override account type's ones
+		userRule := usage.Rules[3]
+		So(userRule.Source, ShouldEqual, LimitsSourceAccountTypeCounterparty)
+		So(userRule.CounterpartyType, ShouldEqual, int16(xdr.AccountTypeAccountRegisteredUser))
+		So(userRule.Periods[0], ShouldResemble, PeriodUsage{Period: "Daily", Limit: 800, Used: 700, Remaining: 100})
+
+		So(usage.Outgoing, ShouldResemble, Headroom{MaxPayment: 200, BindingSource: LimitsSourceAnonymousUser, BindingPeriod: "Monthly"})
+		So(usage.Incoming, ShouldResemble, Headroom{MaxPayment: 700, BindingSource: LimitsSourceAnonymousUser, BindingPeriod: "Balance"})
+	})
+}
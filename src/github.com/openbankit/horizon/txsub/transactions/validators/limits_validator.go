@@ -7,6 +7,8 @@ import (
 	"github.com/openbankit/horizon/db2/history"
 	"github.com/openbankit/horizon/log"
 	"github.com/openbankit/horizon/redis"
+	"github.com/openbankit/horizon/txsub/results"
+	"github.com/openbankit/horizon/txsub/transactions/helpers"
 	"github.com/openbankit/horizon/txsub/transactions/statistics"
 	stat "github.com/openbankit/horizon/txsub/transactions/statistics"
 	"database/sql"
@@ -14,6 +16,13 @@ import (
 	"time"
 )
 
+// periodLimit is a limit on total amount of payments for the period
+type periodLimit struct {
+	periodName string
+	limit      int64
+	getStats   helpers.AccountStatsGetter
+}
+
 type limitsValidator struct {
 	paymentData      *statistics.PaymentData
 	statsManager     statistics.ManagerInterface
@@ -70,6 +79,17 @@ func (v *limitsValidator) limitExceededDescription(periodName string, isAnonymou
 	)
 }
 
+func (v *limitsValidator) counterpartyLimitExceededDescription(periodName string, counterpartyType xdr.AccountType, outcome, limit int64) string {
+	return fmt.Sprintf("%s %s payments limit for account exceeded for counterparty type %s: %s out of %s %s.",
+		periodName,
+		v.paymentDirection,
+		counterpartyType.String(),
+		amount.String(xdr.Int64(outcome)),
+		amount.String(xdr.Int64(limit)),
+		v.paymentData.Asset.Code,
+	)
+}
+
 func (v *limitsValidator) opMaxAmountExceededDescription(limit int64) string {
 	return fmt.Sprintf(
 		"Maximal operation amount for account (%s) exceeded: %s of %s %s",
@@ -88,6 +108,34 @@ func (v *limitsValidator) getCounterparty() *history.Account {
 	return v.paymentData.GetCounterparty(v.paymentDirection)
 }
 
+// GetLimits returns limits to be applied to the account. Limits set for the account itself have priority,
+// if there are none, default limits for the account type are used.
+func (v *limitsValidator) GetLimits() (*history.AccountLimits, error) {
+	limits, err := v.GetAccountLimits()
+	if err != nil || limits != nil {
+		return limits, err
+	}
+
+	return v.GetAccountTypeLimits()
+}
+
+// GetAccountTypeLimits returns default limits for account's type
+func (v *limitsValidator) GetAccountTypeLimits() (*history.AccountLimits, error) {
+	account := v.getAccount()
+	var typeLimits history.AccountTypeLimits
+	err := v.historyQ.GetAccountTypeLimits(&typeLimits, account.AccountType, v.paymentData.Asset.Code)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			v.log.Debug("No account type limits found")
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	limits := typeLimits.ToAccountLimits(account.Address)
+	return &limits, nil
+}
+
 func (v *limitsValidator) GetAccountLimits() (*history.AccountLimits, error) {
 	account := v.getAccount()
 	limitedAssets, err := account.UnmarshalLimitedAssets()
@@ -111,3 +159,139 @@ func (v *limitsValidator) GetAccountLimits() (*history.AccountLimits, error) {
 	}
 	return &limits, nil
 }
+
+// GetCounterpartyLimits returns limits applied only to payments with counterparty's type. Limits set for the account
+// itself have priority, if there are none, default limits of the account type for the counterparty's type are used.
+func (v *limitsValidator) GetCounterpartyLimits() (*history.AccountLimits, error) {
+	limits, err := v.GetAccountCounterpartyLimits()
+	if err != nil || limits != nil {
+		return limits, err
+	}
+
+	return v.GetAccountTypeCounterpartyLimits()
+}
+
+// GetAccountCounterpartyLimits returns limits set for the account, which are applied only to payments with counterparty's type
+func (v *limitsValidator) GetAccountCounterpartyLimits() (*history.AccountLimits, error) {
+	account := v.getAccount()
+	limitedAssets, err := account.UnmarshalLimitedAssets()
+	if err != nil {
+		v.log.WithError(err).Error("Failed to unmarshal limited assets")
+		return nil, err
+	}
+	if _, contains := limitedAssets[v.paymentData.Asset.Code]; !contains {
+		return nil, nil
+	}
+
+	var limits history.AccountLimits
+	counterpartyType := int16(v.getCounterparty().AccountType)
+	err = v.historyQ.GetAccountLimitsForCounterparty(&limits, account.Address, v.paymentData.Asset.Code, counterpartyType)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			v.log.Debug("No counterparty limits found")
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &limits, nil
+}
+
+// GetAccountTypeCounterpartyLimits returns default limits for account's type, which are applied only to payments
+// with counterparty's type
+func (v *limitsValidator) GetAccountTypeCounterpartyLimits() (*history.AccountLimits, error) {
+	account := v.getAccount()
+	var typeLimits history.AccountTypeLimits
+	counterpartyType := int16(v.getCounterparty().AccountType)
+	err := v.historyQ.GetAccountTypeLimitsForCounterparty(&typeLimits, account.AccountType, v.paymentData.Asset.Code,
+		counterpartyType)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			v.log.Debug("No account type counterparty limits found")
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	limits := typeLimits.ToAccountLimits(account.Address)
+	return &limits, nil
+}
+
+// verifyAccountLimits checks if payment does not exceed limits for operation amount and period totals
+func (v *limitsValidator) verifyAccountLimits(limits *history.AccountLimits) (*results.ExceededLimitError, error) {
+	v.log.WithField("limits", limits).Debug("Checking limits")
+	maxOperation, periodLimits := v.getDirectionLimits(limits)
+	if maxOperation >= 0 && v.paymentData.Amount > maxOperation {
+		description := v.opMaxAmountExceededDescription(maxOperation)
+		return &results.ExceededLimitError{Description: description}, nil
+	}
+
+	for _, periodLimit := range periodLimits {
+		if periodLimit.limit < 0 {
+			continue
+		}
+
+		updatedTotal, err := v.getUpdatedTotal(periodLimit.getStats, limits.CounterpartyType)
+		if err != nil {
+			return nil, err
+		}
+
+		v.log.WithFields(log.F{
+			"period":   periodLimit.periodName,
+			"newTotal": updatedTotal,
+			"limit":    periodLimit.limit,
+		}).Debug("Checking total for limits")
+		if updatedTotal <= periodLimit.limit {
+			continue
+		}
+
+		var description string
+		if limits.CounterpartyType == history.AnyCounterpartyType {
+			description = v.limitExceededDescription(periodLimit.periodName, false, updatedTotal, periodLimit.limit)
+		} else {
+			description = v.counterpartyLimitExceededDescription(periodLimit.periodName,
+				xdr.AccountType(limits.CounterpartyType), updatedTotal, periodLimit.limit)
+		}
+		return &results.ExceededLimitError{Description: description}, nil
+	}
+	return nil, nil
+}
+
+// getDirectionLimits returns max operation amount and period limits for payment direction
+func (v *limitsValidator) getDirectionLimits(limits *history.AccountLimits) (int64, []periodLimit) {
+	if v.isIncoming() {
+		return limits.MaxOperationIn, []periodLimit{
+			{"Daily", limits.DailyMaxIn, func(stats *history.AccountStatistics) int64 { return stats.DailyIncome }},
+			{"Weekly", limits.WeeklyMaxIn, func(stats *history.AccountStatistics) int64 { return stats.WeeklyIncome }},
+			{"Monthly", limits.MonthlyMaxIn, func(stats *history.AccountStatistics) int64 { return stats.MonthlyIncome }},
+			{"Annual", limits.AnnualMaxIn, func(stats *history.AccountStatistics) int64 { return stats.AnnualIncome }},
+		}
+	}
+
+	return limits.MaxOperationOut, []periodLimit{
+		{"Daily", limits.DailyMaxOut, func(stats *history.AccountStatistics) int64 { return stats.DailyOutcome }},
+		{"Weekly", limits.WeeklyMaxOut, func(stats *history.AccountStatistics) int64 { return stats.WeeklyOutcome }},
+		{"Monthly", limits.MonthlyMaxOut, func(stats *history.AccountStatistics) int64 { return stats.MonthlyOutcome }},
+		{"Annual", limits.AnnualMaxOut, func(stats *history.AccountStatistics) int64 { return stats.AnnualOutcome }},
+	}
+}
+
+// getUpdatedTotal returns total amount of payments including current one. If counterpartyType is AnyCounterpartyType
+// payments with users and settlement agents are counted, otherwise - only payments with counterparty of specified type
+func (v *limitsValidator) getUpdatedTotal(getStats helpers.AccountStatsGetter, counterpartyType int16) (int64, error) {
+	stats, err := v.updateGetAccountStats()
+	if err != nil {
+		return 0, err
+	}
+
+	if counterpartyType != history.AnyCounterpartyType {
+		return helpers.SumAccountStats(stats.AccountsStatistics, getStats, xdr.AccountType(counterpartyType)), nil
+	}
+
+	return helpers.SumAccountStats(
+		stats.AccountsStatistics,
+		getStats,
+		xdr.AccountTypeAccountAnonymousUser,
+		xdr.AccountTypeAccountRegisteredUser,
+		xdr.AccountTypeAccountSettlementAgent,
+	), nil
+}
@@ -11,13 +11,13 @@ type AccountTypeValidatorMock struct {
 	mock.Mock
 }
 
-func (v *AccountTypeValidatorMock) VerifyAccountTypesForPayment(from, to xdr.AccountType) *results.RestrictedForAccountTypeError {
+func (v *AccountTypeValidatorMock) VerifyAccountTypesForPayment(from, to xdr.AccountType) (*results.RestrictedForAccountTypeError, error) {
 	a := v.Called(from, to)
 	result := a.Get(0)
 	if result == nil {
-		return nil
+		return nil, a.Error(1)
 	}
-	return result.(*results.RestrictedForAccountTypeError)
+	return result.(*results.RestrictedForAccountTypeError), a.Error(1)
 }
 
 type AssetsValidatorMock struct {
@@ -32,58 +32,40 @@ func NewOutgoingLimitsValidator(paymentData *statistics.PaymentData, statsManage
 
 // VerifyLimits checks outgoing limits
 func (v *OutgoingLimitsValidator) VerifyLimits() (*results.ExceededLimitError, error) {
-	// check account's limits
+	// check account's or account type's limits
 	result, err := v.verifySenderAccountLimits()
 	if result != nil || err != nil {
 		return result, err
 	}
 
+	// check account's limits for payments to counterparty's type
+	result, err = v.verifySenderCounterpartyLimits()
+	if result != nil || err != nil {
+		return result, err
+	}
+
+	// check global restrictions for anonymous assets
 	return v.verifyAnonymousAssetLimits()
 }
 
 // Checks limits for sender
 func (v *OutgoingLimitsValidator) verifySenderAccountLimits() (*results.ExceededLimitError, error) {
-	limits, err := v.GetAccountLimits()
+	limits, err := v.GetLimits()
 	if err != nil || limits == nil {
 		return nil, err
 	}
 
-	v.log.WithField("limits", limits).Debug("Checking limits")
-	if limits.MaxOperationOut >= 0 && v.paymentData.Amount > limits.MaxOperationOut {
-		description := v.opMaxAmountExceededDescription(limits.MaxOperationOut)
-		return &results.ExceededLimitError{Description: description}, nil
-	}
+	return v.verifyAccountLimits(limits)
+}
 
-	if limits.DailyMaxOut >= 0 {
-		updatedDailyOutcome, err := v.getUpdatedDailyOutcome()
-		if err != nil {
-			return nil, err
-		}
-		v.log.WithFields(log.F{
-			"newOutcome": updatedDailyOutcome,
-			"limit":      limits.DailyMaxOut,
-		}).Debug("Checking daily outcome for limits")
-		if updatedDailyOutcome > limits.DailyMaxOut {
-			description := v.limitExceededDescription("Daily", false, updatedDailyOutcome, limits.DailyMaxOut)
-			return &results.ExceededLimitError{Description: description}, nil
-		}
+// Checks limits for sender's payments to counterparty's type
+func (v *OutgoingLimitsValidator) verifySenderCounterpartyLimits() (*results.ExceededLimitError, error) {
+	limits, err := v.GetCounterpartyLimits()
+	if err != nil || limits == nil {
+		return nil, err
 	}
 
-	if limits.MonthlyMaxOut >= 0 {
-		updatedMonthlyOutcome, err := v.getUpdatedMonthlyOutcome()
-		if err != nil {
-			return nil, err
-		}
-		v.log.WithFields(log.F{
-			"newOutcome": updatedMonthlyOutcome,
-			"limit":      limits.MonthlyMaxOut,
-		}).Debug("Checking daily outcome for limits")
-		if updatedMonthlyOutcome > limits.MonthlyMaxOut {
-			description := v.limitExceededDescription("Monthly", false, updatedMonthlyOutcome, limits.MonthlyMaxOut)
-			return &results.ExceededLimitError{Description: description}, nil
-		}
-	}
-	return nil, nil
+	return v.verifyAccountLimits(limits)
 }
 
 // checks limits for anonymous asset
@@ -125,19 +107,11 @@ func (v *OutgoingLimitsValidator) verifyAnonymousAssetLimits() (*results.Exceede
 	// annualOutcome does not count for payments to settlement agent
 	if v.getCounterparty().AccountType != xdr.AccountTypeAccountSettlementAgent && v.anonUserRest.MaxAnnualOutcome >= 0 {
 		// 3. Check annual outcome
-		stats, err := v.updateGetAccountStats()
+		updatedAnnualOutcome, err := v.getUpdatedAnnualOutcome()
 		if err != nil {
 			return nil, err
 		}
 
-		updatedAnnualOutcome := helpers.SumAccountStats(
-			stats.AccountsStatistics,
-			func(stats *history.AccountStatistics) int64 { return stats.AnnualOutcome },
-			xdr.AccountTypeAccountAnonymousUser,
-			xdr.AccountTypeAccountRegisteredUser,
-			xdr.AccountTypeAccountMerchant,
-		)
-
 		if updatedAnnualOutcome > v.anonUserRest.MaxAnnualOutcome {
 			description := v.limitExceededDescription("Annual", true, updatedAnnualOutcome, v.anonUserRest.MaxAnnualOutcome)
 			return &results.ExceededLimitError{Description: description}, nil
@@ -186,3 +160,18 @@ func (v *OutgoingLimitsValidator) getUpdatedMonthlyOutcome() (int64, error) {
 	)
 	return *v.monthlyOutcome, nil
 }
+
+func (v *OutgoingLimitsValidator) getUpdatedAnnualOutcome() (int64, error) {
+	stats, err := v.updateGetAccountStats()
+	if err != nil {
+		return 0, err
+	}
+
+	return helpers.SumAccountStats(
+		stats.AccountsStatistics,
+		func(stats *history.AccountStatistics) int64 { return stats.AnnualOutcome },
+		xdr.AccountTypeAccountAnonymousUser,
+		xdr.AccountTypeAccountRegisteredUser,
+		xdr.AccountTypeAccountMerchant,
+	), nil
+}
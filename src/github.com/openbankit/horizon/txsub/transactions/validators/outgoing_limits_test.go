@@ -17,6 +17,7 @@ import (
 	"github.com/guregu/null"
 	. "github.com/smartystreets/goconvey/convey"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"time"
 )
 
@@ -42,14 +43,19 @@ func TestOutgoingLimits(t *testing.T) {
 	}
 	opAmount := int64(amount.One * 100)
 	sourceLimits := history.AccountLimits{
-		Account:         source.Address,
-		AssetCode:       opAsset.Code,
-		MaxOperationOut: -1,
-		DailyMaxOut:     -1,
-		MonthlyMaxOut:   -1,
-		MaxOperationIn:  -1,
-		DailyMaxIn:      -1,
-		MonthlyMaxIn:    -1,
+		Account:          source.Address,
+		AssetCode:        opAsset.Code,
+		CounterpartyType: history.AnyCounterpartyType,
+		MaxOperationOut:  -1,
+		DailyMaxOut:      -1,
+		WeeklyMaxOut:     -1,
+		MonthlyMaxOut:    -1,
+		AnnualMaxOut:     -1,
+		MaxOperationIn:   -1,
+		DailyMaxIn:       -1,
+		WeeklyMaxIn:      -1,
+		MonthlyMaxIn:     -1,
+		AnnualMaxIn:      -1,
 	}
 
 	now := time.Now()
@@ -64,6 +70,9 @@ func TestOutgoingLimits(t *testing.T) {
 		Convey("No limits for source & asset is not anonymous", func() {
 			histMock := history.QMock{}
 			histMock.On("GetAccountLimits", paymentData.GetAccount(direction).Address, opAsset.Code).Return(nil, sql.ErrNoRows)
+			histMock.On("GetAccountLimitsForCounterparty", paymentData.GetAccount(direction).Address, opAsset.Code, mock.Anything).Return(nil, sql.ErrNoRows)
+			histMock.On("GetAccountTypeLimitsForCounterparty", paymentData.GetAccount(direction).AccountType, opAsset.Code, mock.Anything).Return(nil, sql.ErrNoRows)
+			histMock.On("GetAccountTypeLimits", paymentData.GetAccount(direction).AccountType, opAsset.Code).Return(nil, sql.ErrNoRows)
 			v := NewOutgoingLimitsValidator(&paymentData, &statsManager, &histMock, config.AnonymousUserRestrictions{}, now)
 			result, err := v.VerifyLimits()
 			So(err, ShouldBeNil)
@@ -73,6 +82,8 @@ func TestOutgoingLimits(t *testing.T) {
 			histMock := history.QMock{}
 			limits := sourceLimits
 			histMock.On("GetAccountLimits", paymentData.GetAccount(direction).Address, opAsset.Code).Return(limits, nil)
+			histMock.On("GetAccountLimitsForCounterparty", paymentData.GetAccount(direction).Address, opAsset.Code, mock.Anything).Return(nil, sql.ErrNoRows)
+			histMock.On("GetAccountTypeLimitsForCounterparty", paymentData.GetAccount(direction).AccountType, opAsset.Code, mock.Anything).Return(nil, sql.ErrNoRows)
 			v := NewOutgoingLimitsValidator(&paymentData, &statsManager, &histMock, config.AnonymousUserRestrictions{}, now)
 			result, err := v.VerifyLimits()
 			So(err, ShouldBeNil)
@@ -83,6 +94,8 @@ func TestOutgoingLimits(t *testing.T) {
 			limits.MaxOperationOut = opAmount - 1
 			histMock := history.QMock{}
 			histMock.On("GetAccountLimits", paymentData.GetAccount(direction).Address, opAsset.Code).Return(limits, nil)
+			histMock.On("GetAccountLimitsForCounterparty", paymentData.GetAccount(direction).Address, opAsset.Code, mock.Anything).Return(nil, sql.ErrNoRows)
+			histMock.On("GetAccountTypeLimitsForCounterparty", paymentData.GetAccount(direction).AccountType, opAsset.Code, mock.Anything).Return(nil, sql.ErrNoRows)
 			v := NewOutgoingLimitsValidator(&paymentData, &statsManager, &histMock, config.AnonymousUserRestrictions{}, now)
 			result, err := v.VerifyLimits()
 			So(err, ShouldBeNil)
@@ -94,11 +107,62 @@ func TestOutgoingLimits(t *testing.T) {
 				opAsset.Code,
 			)}, result)
 		})
+		Convey("No limits for source, exceeds op amount of account type limits", func() {
+			typeLimits := history.AccountTypeLimits{
+				AccountType:      paymentData.GetAccount(direction).AccountType,
+				AssetCode:        opAsset.Code,
+				CounterpartyType: history.AnyCounterpartyType,
+				MaxOperationOut:  opAmount - 1,
+				DailyMaxOut:      -1,
+				WeeklyMaxOut:     -1,
+				MonthlyMaxOut:    -1,
+				AnnualMaxOut:     -1,
+				MaxOperationIn:   -1,
+				DailyMaxIn:       -1,
+				WeeklyMaxIn:      -1,
+				MonthlyMaxIn:     -1,
+				AnnualMaxIn:      -1,
+			}
+			histMock := history.QMock{}
+			histMock.On("GetAccountLimits", paymentData.GetAccount(direction).Address, opAsset.Code).Return(nil, sql.ErrNoRows)
+			histMock.On("GetAccountLimitsForCounterparty", paymentData.GetAccount(direction).Address, opAsset.Code, mock.Anything).Return(nil, sql.ErrNoRows)
+			histMock.On("GetAccountTypeLimitsForCounterparty", paymentData.GetAccount(direction).AccountType, opAsset.Code, mock.Anything).Return(nil, sql.ErrNoRows)
+			histMock.On("GetAccountTypeLimits", paymentData.GetAccount(direction).AccountType, opAsset.Code).Return(typeLimits, nil)
+			v := NewOutgoingLimitsValidator(&paymentData, &statsManager, &histMock, config.AnonymousUserRestrictions{}, now)
+			result, err := v.VerifyLimits()
+			So(err, ShouldBeNil)
+			assert.Equal(t, &results.ExceededLimitError{Description: fmt.Sprintf(
+				"Maximal operation amount for account (%s) exceeded: %s of %s %s",
+				paymentData.GetAccount(direction).Address,
+				amount.String(xdr.Int64(opAmount)),
+				amount.String(xdr.Int64(typeLimits.MaxOperationOut)),
+				opAsset.Code,
+			)}, result)
+		})
+		Convey("Source limits have priority over account type limits", func() {
+			typeLimits := history.AccountTypeLimits{
+				AccountType:      paymentData.GetAccount(direction).AccountType,
+				AssetCode:        opAsset.Code,
+				CounterpartyType: history.AnyCounterpartyType,
+				MaxOperationOut:  opAmount - 1,
+			}
+			histMock := history.QMock{}
+			histMock.On("GetAccountLimits", paymentData.GetAccount(direction).Address, opAsset.Code).Return(sourceLimits, nil)
+			histMock.On("GetAccountLimitsForCounterparty", paymentData.GetAccount(direction).Address, opAsset.Code, mock.Anything).Return(nil, sql.ErrNoRows)
+			histMock.On("GetAccountTypeLimitsForCounterparty", paymentData.GetAccount(direction).AccountType, opAsset.Code, mock.Anything).Return(nil, sql.ErrNoRows)
+			histMock.On("GetAccountTypeLimits", paymentData.GetAccount(direction).AccountType, opAsset.Code).Return(typeLimits, nil)
+			v := NewOutgoingLimitsValidator(&paymentData, &statsManager, &histMock, config.AnonymousUserRestrictions{}, now)
+			result, err := v.VerifyLimits()
+			So(err, ShouldBeNil)
+			So(result, ShouldBeNil)
+		})
 		Convey("Asset is not anonymous, exceeds daily limit with empty stats", func() {
 			limits := sourceLimits
 			limits.DailyMaxOut = opAmount - 1
 			histMock := history.QMock{}
 			histMock.On("GetAccountLimits", paymentData.GetAccount(direction).Address, opAsset.Code).Return(limits, nil)
+			histMock.On("GetAccountLimitsForCounterparty", paymentData.GetAccount(direction).Address, opAsset.Code, mock.Anything).Return(nil, sql.ErrNoRows)
+			histMock.On("GetAccountTypeLimitsForCounterparty", paymentData.GetAccount(direction).AccountType, opAsset.Code, mock.Anything).Return(nil, sql.ErrNoRows)
 			stats := &redis.AccountStatistics{
 				AccountsStatistics: map[xdr.AccountType]history.AccountStatistics{
 					paymentData.GetCounterparty(direction).AccountType: history.AccountStatistics{
@@ -121,6 +185,8 @@ func TestOutgoingLimits(t *testing.T) {
 			limits.DailyMaxOut = 2*opAmount - 1
 			histMock := history.QMock{}
 			histMock.On("GetAccountLimits", paymentData.GetAccount(direction).Address, opAsset.Code).Return(limits, nil)
+			histMock.On("GetAccountLimitsForCounterparty", paymentData.GetAccount(direction).Address, opAsset.Code, mock.Anything).Return(nil, sql.ErrNoRows)
+			histMock.On("GetAccountTypeLimitsForCounterparty", paymentData.GetAccount(direction).AccountType, opAsset.Code, mock.Anything).Return(nil, sql.ErrNoRows)
 			stats := &redis.AccountStatistics{
 				AccountsStatistics: map[xdr.AccountType]history.AccountStatistics{
 					xdr.AccountTypeAccountSettlementAgent: history.AccountStatistics{
@@ -146,6 +212,8 @@ func TestOutgoingLimits(t *testing.T) {
 			limits.MonthlyMaxOut = 2*opAmount - 1
 			histMock := history.QMock{}
 			histMock.On("GetAccountLimits", paymentData.GetAccount(direction).Address, opAsset.Code).Return(limits, nil)
+			histMock.On("GetAccountLimitsForCounterparty", paymentData.GetAccount(direction).Address, opAsset.Code, mock.Anything).Return(nil, sql.ErrNoRows)
+			histMock.On("GetAccountTypeLimitsForCounterparty", paymentData.GetAccount(direction).AccountType, opAsset.Code, mock.Anything).Return(nil, sql.ErrNoRows)
 			stats := &redis.AccountStatistics{
 				Balance: 0,
 				AccountsStatistics: map[xdr.AccountType]history.AccountStatistics{
@@ -167,6 +235,133 @@ func TestOutgoingLimits(t *testing.T) {
 				opAsset.Code,
 			)}, result)
 		})
+		Convey("Asset is not anonymous, exceeds weekly limit", func() {
+			limits := sourceLimits
+			limits.WeeklyMaxOut = 2*opAmount - 1
+			histMock := history.QMock{}
+			histMock.On("GetAccountLimits", paymentData.GetAccount(direction).Address, opAsset.Code).Return(limits, nil)
+			stats := &redis.AccountStatistics{
+				AccountsStatistics: map[xdr.AccountType]history.AccountStatistics{
+					xdr.AccountTypeAccountRegisteredUser: history.AccountStatistics{
+						Account:          paymentData.GetAccount(direction).Address,
+						AssetCode:        opAsset.Code,
+						CounterpartyType: int16(xdr.AccountTypeAccountRegisteredUser),
+						WeeklyOutcome:    opAmount + opAmount,
+					},
+				},
+			}
+			statsManager.On("UpdateGet", &paymentData, direction, now).Return(stats, nil).Once()
+			v := NewOutgoingLimitsValidator(&paymentData, &statsManager, &histMock, config.AnonymousUserRestrictions{}, now)
+			result, err := v.VerifyLimits()
+			So(err, ShouldBeNil)
+			assert.Equal(t, &results.ExceededLimitError{Description: fmt.Sprintf("Weekly outgoing payments limit for account exceeded: %s out of %s %s.",
+				amount.String(xdr.Int64(opAmount+opAmount)),
+				amount.String(xdr.Int64(limits.WeeklyMaxOut)),
+				opAsset.Code,
+			)}, result)
+		})
+		Convey("Asset is not anonymous, exceeds annual limit", func() {
+			limits := sourceLimits
+			limits.AnnualMaxOut = 2*opAmount - 1
+			histMock := history.QMock{}
+			histMock.On("GetAccountLimits", paymentData.GetAccount(direction).Address, opAsset.Code).Return(limits, nil)
+			stats := &redis.AccountStatistics{
+				AccountsStatistics: map[xdr.AccountType]history.AccountStatistics{
+					xdr.AccountTypeAccountSettlementAgent: history.AccountStatistics{
+						Account:          paymentData.GetAccount(direction).Address,
+						AssetCode:        opAsset.Code,
+						CounterpartyType: int16(xdr.AccountTypeAccountSettlementAgent),
+						AnnualOutcome:    opAmount + opAmount,
+					},
+				},
+			}
+			statsManager.On("UpdateGet", &paymentData, direction, now).Return(stats, nil).Once()
+			v := NewOutgoingLimitsValidator(&paymentData, &statsManager, &histMock, config.AnonymousUserRestrictions{}, now)
+			result, err := v.VerifyLimits()
+			So(err, ShouldBeNil)
+			assert.Equal(t, &results.ExceededLimitError{Description: fmt.Sprintf("Annual outgoing payments limit for account exceeded: %s out of %s %s.",
+				amount.String(xdr.Int64(opAmount+opAmount)),
+				amount.String(xdr.Int64(limits.AnnualMaxOut)),
+				opAsset.Code,
+			)}, result)
+		})
+		Convey("Exceeds monthly limit for counterparty type", func() {
+			counterpartyType := paymentData.GetCounterparty(direction).AccountType
+			counterpartyLimits := sourceLimits
+			counterpartyLimits.CounterpartyType = int16(counterpartyType)
+			counterpartyLimits.MonthlyMaxOut = 2*opAmount - 1
+			histMock := history.QMock{}
+			histMock.On("GetAccountLimits", paymentData.GetAccount(direction).Address, opAsset.Code).Return(sourceLimits, nil)
+			histMock.On("GetAccountLimitsForCounterparty", paymentData.GetAccount(direction).Address, opAsset.Code, int16(counterpartyType)).Return(counterpartyLimits, nil)
+			stats := &redis.AccountStatistics{
+				AccountsStatistics: map[xdr.AccountType]history.AccountStatistics{
+					counterpartyType: history.AccountStatistics{
+						Account:          paymentData.GetAccount(direction).Address,
+						AssetCode:        opAsset.Code,
+						CounterpartyType: int16(counterpartyType),
+						MonthlyOutcome:   opAmount + opAmount,
+					},
+					xdr.AccountTypeAccountSettlementAgent: history.AccountStatistics{
+						Account:          paymentData.GetAccount(direction).Address,
+						AssetCode:        opAsset.Code,
+						CounterpartyType: int16(xdr.AccountTypeAccountSettlementAgent),
+						MonthlyOutcome:   opAmount,
+					},
+				},
+			}
+			statsManager.On("UpdateGet", &paymentData, direction, now).Return(stats, nil).Once()
+			v := NewOutgoingLimitsValidator(&paymentData, &statsManager, &histMock, config.AnonymousUserRestrictions{}, now)
+			result, err := v.VerifyLimits()
+			So(err, ShouldBeNil)
+			assert.Equal(t, &results.ExceededLimitError{Description: fmt.Sprintf("Monthly outgoing payments limit for account exceeded for counterparty type %s: %s out of %s %s.",
+				counterpartyType.String(),
+				amount.String(xdr.Int64(opAmount+opAmount)),
+				amount.String(xdr.Int64(counterpartyLimits.MonthlyMaxOut)),
+				opAsset.Code,
+			)}, result)
+		})
+		Convey("No counterparty limits for source, exceeds daily limit of account type for counterparty type", func() {
+			counterpartyType := paymentData.GetCounterparty(direction).AccountType
+			typeLimits := history.AccountTypeLimits{
+				AccountType:      paymentData.GetAccount(direction).AccountType,
+				AssetCode:        opAsset.Code,
+				CounterpartyType: int16(counterpartyType),
+				MaxOperationOut:  -1,
+				DailyMaxOut:      2*opAmount - 1,
+				WeeklyMaxOut:     -1,
+				MonthlyMaxOut:    -1,
+				AnnualMaxOut:     -1,
+				MaxOperationIn:   -1,
+				DailyMaxIn:       -1,
+				WeeklyMaxIn:      -1,
+				MonthlyMaxIn:     -1,
+				AnnualMaxIn:      -1,
+			}
+			histMock := history.QMock{}
+			histMock.On("GetAccountLimits", paymentData.GetAccount(direction).Address, opAsset.Code).Return(sourceLimits, nil)
+			histMock.On("GetAccountLimitsForCounterparty", paymentData.GetAccount(direction).Address, opAsset.Code, int16(counterpartyType)).Return(nil, sql.ErrNoRows)
+			histMock.On("GetAccountTypeLimitsForCounterparty", paymentData.GetAccount(direction).AccountType, opAsset.Code, int16(counterpartyType)).Return(typeLimits, nil)
+			stats := &redis.AccountStatistics{
+				AccountsStatistics: map[xdr.AccountType]history.AccountStatistics{
+					counterpartyType: history.AccountStatistics{
+						Account:          paymentData.GetAccount(direction).Address,
+						AssetCode:        opAsset.Code,
+						CounterpartyType: int16(counterpartyType),
+						DailyOutcome:     opAmount + opAmount,
+					},
+				},
+			}
+			statsManager.On("UpdateGet", &paymentData, direction, now).Return(stats, nil).Once()
+			v := NewOutgoingLimitsValidator(&paymentData, &statsManager, &histMock, config.AnonymousUserRestrictions{}, now)
+			result, err := v.VerifyLimits()
+			So(err, ShouldBeNil)
+			assert.Equal(t, &results.ExceededLimitError{Description: fmt.Sprintf("Daily outgoing payments limit for account exceeded for counterparty type %s: %s out of %s %s.",
+				counterpartyType.String(),
+				amount.String(xdr.Int64(opAmount+opAmount)),
+				amount.String(xdr.Int64(typeLimits.DailyMaxOut)),
+				opAsset.Code,
+			)}, result)
+		})
 		stats := &redis.AccountStatistics{
 			Balance: 0,
 			AccountsStatistics: map[xdr.AccountType]history.AccountStatistics{
@@ -187,6 +382,9 @@ func TestOutgoingLimits(t *testing.T) {
 			paymentData.Asset.IsAnonymous = true
 			histMock := history.QMock{}
 			histMock.On("GetAccountLimits", paymentData.GetAccount(direction).Address, opAsset.Code).Return(nil, sql.ErrNoRows)
+			histMock.On("GetAccountLimitsForCounterparty", paymentData.GetAccount(direction).Address, opAsset.Code, mock.Anything).Return(nil, sql.ErrNoRows)
+			histMock.On("GetAccountTypeLimitsForCounterparty", paymentData.GetAccount(direction).AccountType, opAsset.Code, mock.Anything).Return(nil, sql.ErrNoRows)
+			histMock.On("GetAccountTypeLimits", paymentData.GetAccount(direction).AccountType, opAsset.Code).Return(nil, sql.ErrNoRows)
 			statsManager.On("UpdateGet", &paymentData, direction, now).Return(stats, nil).Once()
 			v := NewOutgoingLimitsValidator(&paymentData, &statsManager, &histMock, limits, now)
 			result, err := v.VerifyLimits()
@@ -205,6 +403,9 @@ func TestOutgoingLimits(t *testing.T) {
 			paymentData.Asset.IsAnonymous = true
 			histMock := history.QMock{}
 			histMock.On("GetAccountLimits", paymentData.GetAccount(direction).Address, opAsset.Code).Return(nil, sql.ErrNoRows)
+			histMock.On("GetAccountLimitsForCounterparty", paymentData.GetAccount(direction).Address, opAsset.Code, mock.Anything).Return(nil, sql.ErrNoRows)
+			histMock.On("GetAccountTypeLimitsForCounterparty", paymentData.GetAccount(direction).AccountType, opAsset.Code, mock.Anything).Return(nil, sql.ErrNoRows)
+			histMock.On("GetAccountTypeLimits", paymentData.GetAccount(direction).AccountType, opAsset.Code).Return(nil, sql.ErrNoRows)
 			statsManager.On("UpdateGet", &paymentData, direction, now).Return(stats, nil).Once()
 			v := NewOutgoingLimitsValidator(&paymentData, &statsManager, &histMock, limits, now)
 			result, err := v.VerifyLimits()
@@ -224,6 +425,9 @@ func TestOutgoingLimits(t *testing.T) {
 			paymentData.Asset.IsAnonymous = true
 			histMock := history.QMock{}
 			histMock.On("GetAccountLimits", paymentData.GetAccount(direction).Address, opAsset.Code).Return(nil, sql.ErrNoRows)
+			histMock.On("GetAccountLimitsForCounterparty", paymentData.GetAccount(direction).Address, opAsset.Code, mock.Anything).Return(nil, sql.ErrNoRows)
+			histMock.On("GetAccountTypeLimitsForCounterparty", paymentData.GetAccount(direction).AccountType, opAsset.Code, mock.Anything).Return(nil, sql.ErrNoRows)
+			histMock.On("GetAccountTypeLimits", paymentData.GetAccount(direction).AccountType, opAsset.Code).Return(nil, sql.ErrNoRows)
 			statsManager.On("UpdateGet", &paymentData, direction, now).Return(stats, nil).Once()
 			v := NewOutgoingLimitsValidator(&paymentData, &statsManager, &histMock, limits, now)
 			result, err := v.VerifyLimits()
@@ -243,6 +447,9 @@ func TestOutgoingLimits(t *testing.T) {
 			paymentData.Asset.IsAnonymous = true
 			histMock := history.QMock{}
 			histMock.On("GetAccountLimits", paymentData.GetAccount(direction).Address, opAsset.Code).Return(nil, sql.ErrNoRows)
+			histMock.On("GetAccountLimitsForCounterparty", paymentData.GetAccount(direction).Address, opAsset.Code, mock.Anything).Return(nil, sql.ErrNoRows)
+			histMock.On("GetAccountTypeLimitsForCounterparty", paymentData.GetAccount(direction).AccountType, opAsset.Code, mock.Anything).Return(nil, sql.ErrNoRows)
+			histMock.On("GetAccountTypeLimits", paymentData.GetAccount(direction).AccountType, opAsset.Code).Return(nil, sql.ErrNoRows)
 			statsManager.On("UpdateGet", &paymentData, direction, now).Return(stats, nil).Once()
 			paymentData.GetCounterparty(direction).AccountType = xdr.AccountTypeAccountSettlementAgent
 			v := NewOutgoingLimitsValidator(&paymentData, &statsManager, &histMock, limits, now)
@@ -259,6 +466,9 @@ func TestOutgoingLimits(t *testing.T) {
 			paymentData.Asset.IsAnonymous = true
 			histMock := history.QMock{}
 			histMock.On("GetAccountLimits", paymentData.GetAccount(direction).Address, opAsset.Code).Return(nil, sql.ErrNoRows)
+			histMock.On("GetAccountLimitsForCounterparty", paymentData.GetAccount(direction).Address, opAsset.Code, mock.Anything).Return(nil, sql.ErrNoRows)
+			histMock.On("GetAccountTypeLimitsForCounterparty", paymentData.GetAccount(direction).AccountType, opAsset.Code, mock.Anything).Return(nil, sql.ErrNoRows)
+			histMock.On("GetAccountTypeLimits", paymentData.GetAccount(direction).AccountType, opAsset.Code).Return(nil, sql.ErrNoRows)
 			statsManager.On("UpdateGet", &paymentData, direction, now).Return(stats, nil).Once()
 			paymentData.GetCounterparty(direction).AccountType = xdr.AccountTypeAccountMerchant
 			v := NewOutgoingLimitsValidator(&paymentData, &statsManager, &histMock, limits, now)
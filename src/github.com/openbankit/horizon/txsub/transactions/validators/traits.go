@@ -4,6 +4,7 @@ import (
 	"github.com/openbankit/horizon/db2/history"
 	"github.com/openbankit/horizon/txsub/results"
 	"fmt"
+	"time"
 )
 
 type TraitsValidatorInterface interface {
@@ -29,19 +30,40 @@ func (v *TraitsValidator) CheckTraits(source, destination *history.Account) (*re
 	return restriction, err
 }
 
+// CheckTraitsForAccount checks if account's outcoming (for source) or incoming payments are blocked.
+// Expired blocks are ignored.
 func (v *TraitsValidator) CheckTraitsForAccount(account *history.Account, isSource bool) (*results.RestrictedForAccountError, error) {
+	now := time.Now()
 	// Check restrictions
-	if isSource && account.BlockOutcomingPayments {
-		return &results.RestrictedForAccountError{
-			Reason: fmt.Sprintf("Outcoming payments for account (%s) are restricted by administrator.", account.Address),
-		}, nil
+	if isSource && account.IsOutcomingBlocked(now) {
+		return newRestrictedForAccountError(
+			fmt.Sprintf("Outcoming payments for account (%s) are restricted by administrator.", account.Address),
+			account.BlockOutcomingReason, account.BlockOutcomingUntil,
+		), nil
 	}
 
-	if !isSource && account.BlockIncomingPayments {
-		return &results.RestrictedForAccountError{
-			Reason: fmt.Sprintf("Incoming payments for account (%s) are restricted by administrator.", account.Address),
-		}, nil
+	if !isSource && account.IsIncomingBlocked(now) {
+		return newRestrictedForAccountError(
+			fmt.Sprintf("Incoming payments for account (%s) are restricted by administrator.", account.Address),
+			account.BlockIncomingReason, account.BlockIncomingUntil,
+		), nil
 	}
 
 	return nil, nil
 }
+
+func newRestrictedForAccountError(message, blockReason string, blockedUntil *time.Time) *results.RestrictedForAccountError {
+	if blockReason != "" {
+		message += fmt.Sprintf(" Reason: %s.", blockReason)
+	}
+
+	if blockedUntil != nil {
+		message += fmt.Sprintf(" Blocked until: %s.", blockedUntil.UTC().Format(time.RFC3339))
+	}
+
+	return &results.RestrictedForAccountError{
+		Reason:       message,
+		BlockReason:  blockReason,
+		BlockedUntil: blockedUntil,
+	}
+}
@@ -10,6 +10,7 @@ import (
 	. "github.com/smartystreets/goconvey/convey"
 	"github.com/stretchr/testify/assert"
 	"math/rand"
+	"time"
 )
 
 func TestTraits(t *testing.T) {
@@ -60,6 +61,27 @@ func TestTraits(t *testing.T) {
 				Reason: fmt.Sprintf("Incoming payments for account (%s) are restricted by administrator.", dest.Address),
 			})
 		})
+		Convey("Block with reason", func() {
+			until := time.Now().Add(time.Hour)
+			source.BlockOutcomingPayments = true
+			source.BlockOutcomingReason = history.BlockReasonFraudSuspected
+			source.BlockOutcomingUntil = &until
+			result, err := traits.CheckTraits(source, dest)
+			So(err, ShouldBeNil)
+			So(result, ShouldNotBeNil)
+			So(result.BlockReason, ShouldEqual, history.BlockReasonFraudSuspected)
+			So(result.BlockedUntil, ShouldEqual, &until)
+		})
+		Convey("Expired block is ignored", func() {
+			until := time.Now().Add(-time.Hour)
+			source.BlockOutcomingPayments = true
+			source.BlockOutcomingUntil = &until
+			dest.BlockIncomingPayments = true
+			dest.BlockIncomingUntil = &until
+			result, err := traits.CheckTraits(source, dest)
+			So(err, ShouldBeNil)
+			So(result, ShouldBeNil)
+		})
 
 	})
 }
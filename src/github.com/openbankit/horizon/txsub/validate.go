@@ -0,0 +1,91 @@
+package txsub
+
+import (
+	"github.com/openbankit/go-base/xdr"
+	"github.com/openbankit/horizon/accounttypes"
+	"github.com/openbankit/horizon/cache"
+	"github.com/openbankit/horizon/commissions"
+	conf "github.com/openbankit/horizon/config"
+	"github.com/openbankit/horizon/db2/core"
+	"github.com/openbankit/horizon/db2/history"
+	"github.com/openbankit/horizon/log"
+	"github.com/openbankit/horizon/txsub/results"
+	"github.com/openbankit/horizon/txsub/transactions"
+	"github.com/openbankit/horizon/txsub/transactions/statistics"
+	"golang.org/x/net/context"
+)
+
+// ValidationResult represents the result of pre-flight validation of transaction
+type ValidationResult struct {
+	Hash                 string
+	Envelope             *xdr.TransactionEnvelope
+	EnvelopeXDR          string
+	IsValid              bool
+	Operations           []transactions.OperationCheckResult
+	TransactionErrorInfo *results.AdditionalErrorInfo
+	Fees                 []xdr.OperationFee
+	// Sponsorships are commissions paid by sponsors instead of operation sources, aligned with operations
+	Sponsorships []*commissions.Sponsorship
+}
+
+// Validator runs the same checks as submitter does, but does not submit transaction to stellar-core
+// and does not store statistics updated by transaction.
+type Validator struct {
+	coreQ       core.QInterface
+	historyQ    history.QInterface
+	config      conf.Provider
+	sharedCache *cache.SharedCache
+	passphrase  string
+	log         *log.Entry
+}
+
+func NewValidator(coreQ core.QInterface, historyQ history.QInterface, config conf.Provider, sharedCache *cache.SharedCache, passphrase string) *Validator {
+	return &Validator{
+		coreQ:       coreQ,
+		historyQ:    historyQ,
+		config:      config,
+		sharedCache: sharedCache,
+		passphrase:  passphrase,
+		log:         log.WithField("service", "transaction_validator"),
+	}
+}
+
+// Validate checks the provided base64 encoded transaction envelope. Returns MalformedTransactionError,
+// if envelope can not be decoded.
+func (v *Validator) Validate(ctx context.Context, env string) (*ValidationResult, error) {
+	info, err := extractEnvelopeInfo(ctx, env, v.passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	config := v.config.Config()
+	err = commissions.New(v.sharedCache, v.historyQ).SetCommissionAccount(config.BankCommissionKey).SetCommissions(info.Tx)
+	if err != nil {
+		v.log.WithError(err).Error("Failed to set commissions")
+		return nil, err
+	}
+
+	statsManager := statistics.NewDryRunManager(statistics.NewManager(v.historyQ, accounttype.GetAll(), &config))
+	manager := transactions.NewManager(v.coreQ, v.historyQ, statsManager, &config, v.sharedCache)
+	txFrame := transactions.NewTransactionFrame(&info)
+	isValid, err := txFrame.CheckValid(manager)
+	if err != nil {
+		v.log.WithError(err).Error("Failed to validate tx")
+		return nil, err
+	}
+
+	result := ValidationResult{
+		Hash:         info.ContentHash,
+		Envelope:     info.Tx,
+		EnvelopeXDR:  env,
+		IsValid:      isValid,
+		Operations:   txFrame.GetOperationResults(),
+		Fees:         info.Tx.OperationFees,
+		Sponsorships: commissions.GetSponsorships(info.Tx.Tx, config.BankCommissionKey),
+	}
+
+	if txResult := txFrame.GetResult(); txResult != nil {
+		result.TransactionErrorInfo = txResult.TransactionErrorInfo
+	}
+	return &result, nil
+}
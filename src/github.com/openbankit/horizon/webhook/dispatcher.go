@@ -0,0 +1,245 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/openbankit/horizon/db2"
+	"github.com/openbankit/horizon/log"
+	"github.com/rcrowley/go-metrics"
+)
+
+// maxResponseSize is a max number of bytes read from response of subscription's endpoint
+const maxResponseSize = 4096
+
+// Dispatcher sends pending deliveries to subscriptions. Failed attempts are retried with exponential
+// backoff, deliveries failed MaxAttempts times are moved to dead letters. Dispatchers of several
+// horizon instances can share the same db.
+type Dispatcher struct {
+	Q      *Q
+	Client *http.Client
+	// AllowPrivate allows deliveries to loopback, private and link-local addresses. Only checked by Client
+	// created by NewDispatcher.
+	AllowPrivate bool
+	// MaxAttempts is a number of attempts after which delivery is moved to dead letters
+	MaxAttempts int
+	// MinBackoff is a delay after the first failed attempt. It's doubled after each next one up to MaxBackoff
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+	// Lease is a time within which claimed deliveries must be sent, otherwise they are claimed again
+	Lease time.Duration
+	// BatchSize is a max number of deliveries sent during one tick
+	BatchSize uint64
+
+	log  *log.Entry
+	tick *time.Ticker
+
+	Metrics struct {
+		// DeliveredMeter tracks the rate of successful deliveries
+		DeliveredMeter metrics.Meter
+
+		// FailedMeter tracks the rate of failed delivery attempts
+		FailedMeter metrics.Meter
+
+		// DeadLetterMeter tracks the rate of deliveries moved to dead letters
+		DeadLetterMeter metrics.Meter
+	}
+}
+
+// Result describes single attempt to send delivery
+type Result struct {
+	StatusCode int
+	Duration   time.Duration
+	Err        error
+}
+
+// NewDispatcher creates dispatcher of deliveries stored in repo with default settings
+func NewDispatcher(repo *db2.Repo) *Dispatcher {
+	d := &Dispatcher{
+		Q:           &Q{Repo: repo},
+		MaxAttempts: 10,
+		MinBackoff:  10 * time.Second,
+		MaxBackoff:  time.Hour,
+		Lease:       time.Minute,
+		BatchSize:   100,
+		log:         log.WithField("service", "webhook_dispatcher"),
+	}
+	d.Client = &http.Client{
+		Timeout:   10 * time.Second,
+		Transport: &http.Transport{Dial: d.dial},
+	}
+	d.Metrics.DeliveredMeter = metrics.NewMeter()
+	d.Metrics.FailedMeter = metrics.NewMeter()
+	d.Metrics.DeadLetterMeter = metrics.NewMeter()
+	return d
+}
+
+// Start sends pending deliveries every interval
+func (d *Dispatcher) Start(interval time.Duration) {
+	d.tick = time.NewTicker(interval)
+	go d.run()
+}
+
+// Close stops background sending
+func (d *Dispatcher) Close() {
+	if d.tick == nil {
+		return
+	}
+	d.log.Info("canceling webhook dispatcher")
+	d.tick.Stop()
+}
+
+func (d *Dispatcher) run() {
+	for _ = range d.tick.C {
+		_, err := d.Tick(time.Now())
+		if err != nil {
+			d.log.WithStack(err).WithError(err).Error("Failed to send webhook deliveries")
+		}
+	}
+}
+
+// Tick sends deliveries due at now. Returns number of deliveries attempted.
+func (d *Dispatcher) Tick(now time.Time) (int, error) {
+	var deliveries []Delivery
+	err := d.Q.ClaimDeliveries(&deliveries, now, d.Lease, d.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	subscriptions := make(map[int64]*Subscription)
+	for _, delivery := range deliveries {
+		if _, ok := subscriptions[delivery.SubscriptionID]; ok {
+			continue
+		}
+
+		var subscription Subscription
+		err = d.Q.SubscriptionByID(&subscription, delivery.SubscriptionID)
+		if err != nil {
+			if d.Q.Repo.NoRows(err) {
+				// subscription was deleted after deliveries were claimed
+				subscriptions[delivery.SubscriptionID] = nil
+				continue
+			}
+			return 0, err
+		}
+		subscriptions[delivery.SubscriptionID] = &subscription
+	}
+
+	var wg sync.WaitGroup
+	for i := range deliveries {
+		subscription := subscriptions[deliveries[i].SubscriptionID]
+		if subscription == nil {
+			continue
+		}
+
+		wg.Add(1)
+		go func(delivery *Delivery) {
+			defer wg.Done()
+			d.attempt(subscription, delivery)
+		}(&deliveries[i])
+	}
+	wg.Wait()
+
+	return len(deliveries), nil
+}
+
+// attempt sends delivery and stores outcome of the attempt
+func (d *Dispatcher) attempt(subscription *Subscription, delivery *Delivery) {
+	logger := d.log.WithFields(log.F{
+		"subscription_id": subscription.ID,
+		"delivery_id":     delivery.ID,
+		"event_id":        delivery.EventID,
+	})
+
+	result := d.Send(subscription, delivery.ID, EventType(delivery.EventType), []byte(delivery.Payload))
+	delivery.Attempts++
+	now := time.Now()
+
+	var err error
+	switch {
+	case result.Err == nil:
+		d.Metrics.DeliveredMeter.Mark(1)
+		err = d.Q.MarkDelivered(delivery, now)
+	case delivery.Attempts >= d.MaxAttempts:
+		d.Metrics.DeadLetterMeter.Mark(1)
+		delivery.LastError = result.Err.Error()
+		logger.WithField("attempts", delivery.Attempts).WithError(result.Err).Warn("Moving webhook delivery to dead letters")
+		err = d.Q.MoveToDeadLetters(delivery, subscription.URL, now)
+	default:
+		d.Metrics.FailedMeter.Mark(1)
+		delivery.LastError = result.Err.Error()
+		logger.WithField("attempts", delivery.Attempts).WithError(result.Err).Debug("Webhook delivery failed")
+		err = d.Q.Reschedule(delivery, now.Add(d.Backoff(delivery.Attempts)))
+	}
+
+	if err != nil {
+		logger.WithStack(err).WithError(err).Error("Failed to store outcome of webhook delivery")
+	}
+}
+
+// Backoff returns delay before the next attempt after the provided number of failed attempts
+func (d *Dispatcher) Backoff(attempts int) time.Duration {
+	backoff := d.MinBackoff
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= d.MaxBackoff {
+			return d.MaxBackoff
+		}
+	}
+	return backoff
+}
+
+func (d *Dispatcher) dial(network, address string) (net.Conn, error) {
+	dialer := newPublicDialer(10 * time.Second)
+	if d.AllowPrivate {
+		return dialer.Dialer.Dial(network, address)
+	}
+	return dialer.Dial(network, address)
+}
+
+// Ping sends test event to subscription without storing it. Used to check subscription's endpoint.
+func (d *Dispatcher) Ping(subscription *Subscription, event Event) Result {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return Result{Err: err}
+	}
+	return d.Send(subscription, 0, event.Type, payload)
+}
+
+// Send posts signed payload to subscription's url. Any response other than 2xx is an error.
+func (d *Dispatcher) Send(subscription *Subscription, deliveryID int64, eventType EventType, payload []byte) Result {
+	start := time.Now()
+	req, err := http.NewRequest("POST", subscription.URL, bytes.NewReader(payload))
+	if err != nil {
+		return Result{Err: err}
+	}
+
+	timestamp := start.Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, string(eventType))
+	req.Header.Set(HeaderDelivery, strconv.FormatInt(deliveryID, 10))
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(subscription.Secret, timestamp, payload))
+
+	resp, err := d.Client.Do(req)
+	if err != nil {
+		return Result{Duration: time.Since(start), Err: err}
+	}
+	defer resp.Body.Close()
+	// drain body to reuse connection. Response is not needed, so only small part is read.
+	io.Copy(ioutil.Discard, io.LimitReader(resp.Body, maxResponseSize))
+
+	result := Result{StatusCode: resp.StatusCode, Duration: time.Since(start)}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		result.Err = fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return result
+}
@@ -0,0 +1,156 @@
+package webhook
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openbankit/horizon/db2"
+	"github.com/openbankit/horizon/test"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// stub is a local HTTP endpoint recording deliveries
+type stub struct {
+	sync.Mutex
+	Status   int
+	Events   []string
+	Verified []bool
+}
+
+func (s *stub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := ioutil.ReadAll(r.Body)
+	s.Lock()
+	defer s.Unlock()
+	s.Events = append(s.Events, r.Header.Get(HeaderEvent))
+	s.Verified = append(s.Verified, Verify("secret", r.Header.Get(HeaderTimestamp), r.Header.Get(HeaderSignature), body))
+	w.WriteHeader(s.Status)
+}
+
+func TestDispatcher(t *testing.T) {
+	tt := test.Start(t).Scenario("base")
+	defer tt.Finish()
+
+	Convey("Dispatcher", t, func() {
+		test.LoadScenario("base")
+		endpoint := &stub{Status: http.StatusOK}
+		server := httptest.NewServer(endpoint)
+		defer server.Close()
+
+		q := &Q{Repo: tt.HorizonRepo()}
+		subscription := Subscription{URL: server.URL, Secret: "secret", EventTypes: "payment"}
+		So(q.CreateSubscription(&subscription), ShouldBeNil)
+		So(subscription.ID, ShouldNotEqual, 0)
+
+		other := Subscription{
+			URL:        server.URL,
+			Secret:     "secret",
+			EventTypes: "payment",
+			Account:    sql.NullString{String: "GC", Valid: true},
+		}
+		So(q.CreateSubscription(&other), ShouldBeNil)
+
+		var subscriptions []Subscription
+		So(q.Subscriptions(&subscriptions), ShouldBeNil)
+		So(subscriptions, ShouldHaveLength, 2)
+
+		now := time.Now().Add(time.Minute)
+		event := NewPaymentEvent(Payment{
+			Ledger:       Ledger{OperationID: "100", ClosedAt: now},
+			From:         "GA",
+			To:           "GB",
+			SourceAmount: "10.0000000",
+		})
+
+		enqueued, err := q.Enqueue(subscriptions, event)
+		So(err, ShouldBeNil)
+		So(enqueued, ShouldEqual, 1)
+
+		// event is enqueued once per subscription
+		enqueued, err = q.Enqueue(subscriptions, event)
+		So(err, ShouldBeNil)
+		So(enqueued, ShouldEqual, 0)
+
+		d := NewDispatcher(tt.HorizonRepo())
+		d.MaxAttempts = 2
+		// endpoint is served on loopback
+		d.AllowPrivate = true
+		// db rounds time to microseconds
+		tick := now.Add(time.Second)
+
+		Convey("delivers signed event", func() {
+			attempted, err := d.Tick(tick)
+			So(err, ShouldBeNil)
+			So(attempted, ShouldEqual, 1)
+			So(endpoint.Events, ShouldResemble, []string{string(EventPayment)})
+			So(endpoint.Verified, ShouldResemble, []bool{true})
+
+			var delivery Delivery
+			So(q.DeliveryByEvent(&delivery, subscription.ID, "100"), ShouldBeNil)
+			So(delivery.Status, ShouldEqual, StatusDelivered)
+			So(delivery.Attempts, ShouldEqual, 1)
+			So(delivery.DeliveredAt, ShouldNotBeNil)
+
+			// delivered event is not sent again
+			attempted, err = d.Tick(tick.Add(time.Hour))
+			So(err, ShouldBeNil)
+			So(attempted, ShouldEqual, 0)
+		})
+
+		Convey("retries failed delivery and moves it to dead letters", func() {
+			endpoint.Lock()
+			endpoint.Status = http.StatusInternalServerError
+			endpoint.Unlock()
+
+			attempted, err := d.Tick(tick)
+			So(err, ShouldBeNil)
+			So(attempted, ShouldEqual, 1)
+
+			var delivery Delivery
+			So(q.DeliveryByEvent(&delivery, subscription.ID, "100"), ShouldBeNil)
+			So(delivery.Status, ShouldEqual, StatusPending)
+			So(delivery.Attempts, ShouldEqual, 1)
+			So(delivery.LastError, ShouldContainSubstring, "500")
+
+			// not retried before backoff passes
+			attempted, err = d.Tick(delivery.NextAttemptAt.Add(-time.Millisecond))
+			So(err, ShouldBeNil)
+			So(attempted, ShouldEqual, 0)
+
+			attempted, err = d.Tick(delivery.NextAttemptAt)
+			So(err, ShouldBeNil)
+			So(attempted, ShouldEqual, 1)
+			So(endpoint.Events, ShouldHaveLength, 2)
+
+			So(q.DeliveryByEvent(&delivery, subscription.ID, "100"), ShouldBeNil)
+			So(delivery.Status, ShouldEqual, StatusFailed)
+			So(delivery.Attempts, ShouldEqual, 2)
+
+			var deadLetters []DeadLetter
+			So(q.DeadLettersPage(&deadLetters, subscription.ID, db2.MustPageQuery("", "asc", 10)), ShouldBeNil)
+			So(deadLetters, ShouldHaveLength, 1)
+			So(deadLetters[0].DeliveryID, ShouldEqual, delivery.ID)
+			So(deadLetters[0].URL, ShouldEqual, server.URL)
+			So(deadLetters[0].Attempts, ShouldEqual, 2)
+
+			// deleting subscription keeps its dead letters
+			deleted, err := q.DeleteSubscription(subscription.ID)
+			So(err, ShouldBeNil)
+			So(deleted, ShouldBeTrue)
+			So(q.DeadLettersPage(&deadLetters, 0, db2.MustPageQuery("", "asc", 10)), ShouldBeNil)
+			So(deadLetters, ShouldHaveLength, 1)
+		})
+
+		Convey("pings subscription", func() {
+			result := d.Ping(&subscription, NewPingEvent(subscription.ID, time.Now()))
+			So(result.Err, ShouldBeNil)
+			So(result.StatusCode, ShouldEqual, http.StatusOK)
+			So(endpoint.Events, ShouldResemble, []string{string(EventPing)})
+			So(endpoint.Verified, ShouldResemble, []bool{true})
+		})
+	})
+}
@@ -0,0 +1,112 @@
+package webhook
+
+import (
+	"strconv"
+	"time"
+)
+
+// Ledger describes operation, which caused ledger event
+type Ledger struct {
+	OperationID     string    `json:"operation_id"`
+	TransactionHash string    `json:"transaction_hash"`
+	Ledger          int32     `json:"ledger"`
+	ClosedAt        time.Time `json:"closed_at"`
+}
+
+// Payment is data of payment event. Amounts are formatted the same way as in horizon resources.
+type Payment struct {
+	Ledger
+	OperationType     string `json:"operation_type"`
+	From              string `json:"from"`
+	To                string `json:"to"`
+	SourceAmount      string `json:"source_amount"`
+	SourceAsset       string `json:"source_asset_code"`
+	DestinationAmount string `json:"destination_amount"`
+	DestinationAsset  string `json:"destination_asset_code"`
+}
+
+// PaymentReversal is data of payment reversal event
+type PaymentReversal struct {
+	Ledger
+	PaymentID string `json:"payment_id"`
+	// From is a source of reversal, i.e. destination of reversed payment
+	From string `json:"from"`
+	// To is a source of reversed payment
+	To        string `json:"to"`
+	Amount    string `json:"amount"`
+	AssetCode string `json:"asset_code"`
+}
+
+// AdminOperation is data of administrative operation event
+type AdminOperation struct {
+	Ledger
+	Source string                 `json:"source_account"`
+	OpData map[string]interface{} `json:"op_data"`
+	// Applied is false, if admin action was rejected by horizon during ingestion
+	Applied bool   `json:"applied"`
+	Error   string `json:"error,omitempty"`
+}
+
+// TransactionResult is data of transaction result event
+type TransactionResult struct {
+	Hash          string `json:"hash"`
+	Status        string `json:"status"`
+	Ledger        int32  `json:"ledger"`
+	SourceAccount string `json:"source_account"`
+	EnvelopeXDR   string `json:"envelope_xdr"`
+	ResultXDR     string `json:"result_xdr"`
+}
+
+// NewPaymentEvent creates event of payment
+func NewPaymentEvent(data Payment) Event {
+	return Event{
+		ID:        data.OperationID,
+		Type:      EventPayment,
+		CreatedAt: data.ClosedAt,
+		Data:      data,
+		Accounts:  []string{data.From, data.To},
+	}
+}
+
+// NewPaymentReversalEvent creates event of payment reversal
+func NewPaymentReversalEvent(data PaymentReversal) Event {
+	return Event{
+		ID:        data.OperationID,
+		Type:      EventPaymentReversal,
+		CreatedAt: data.ClosedAt,
+		Data:      data,
+		Accounts:  []string{data.From, data.To},
+	}
+}
+
+// NewAdminOperationEvent creates event of administrative operation
+func NewAdminOperationEvent(data AdminOperation) Event {
+	return Event{
+		ID:        data.OperationID,
+		Type:      EventAdminOperation,
+		CreatedAt: data.ClosedAt,
+		Data:      data,
+		Accounts:  []string{data.Source},
+	}
+}
+
+// NewTransactionResultEvent creates event of final result of submitted transaction
+func NewTransactionResultEvent(data TransactionResult, now time.Time) Event {
+	return Event{
+		ID:        data.Hash,
+		Type:      EventTransactionResult,
+		CreatedAt: now,
+		Data:      data,
+		Accounts:  []string{data.SourceAccount},
+	}
+}
+
+// NewPingEvent creates test event
+func NewPingEvent(subscriptionID int64, now time.Time) Event {
+	return Event{
+		ID:        "ping-" + strconv.FormatInt(subscriptionID, 10),
+		Type:      EventPing,
+		CreatedAt: now,
+		Data:      map[string]interface{}{"subscription_id": strconv.FormatInt(subscriptionID, 10)},
+	}
+}
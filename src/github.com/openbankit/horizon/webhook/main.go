@@ -0,0 +1,208 @@
+// Package webhook notifies HTTP endpoints registered by subscriptions about account payments,
+// payment reversals, administrative operations and final results of submitted transactions.
+//
+// Events are stored as deliveries in horizon db. Ledger events are enqueued in the same
+// transaction as ingested ledger, so each event is delivered exactly once per subscription.
+// Dispatcher sends signed deliveries, retrying failed ones with exponential backoff, and moves
+// deliveries failed max attempts times to dead letters.
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// EventType is a type of event subscription can be notified about
+type EventType string
+
+const (
+	// EventPayment - payment, path payment or external payment was ingested
+	EventPayment EventType = "payment"
+	// EventPaymentReversal - payment reversal was ingested
+	EventPaymentReversal EventType = "payment_reversal"
+	// EventAdminOperation - administrative operation was ingested
+	EventAdminOperation EventType = "admin_operation"
+	// EventTransactionResult - transaction submitted through horizon was applied or rejected
+	EventTransactionResult EventType = "transaction_result"
+	// EventPing is only sent by test deliveries
+	EventPing EventType = "ping"
+)
+
+// EventTypes are all the event types subscription can be notified about
+var EventTypes = []EventType{EventPayment, EventPaymentReversal, EventAdminOperation, EventTransactionResult}
+
+// Statuses of delivery
+const (
+	StatusPending   = "pending"
+	StatusDelivered = "delivered"
+	StatusFailed    = "failed"
+)
+
+// Headers of delivery request
+const (
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderDelivery  = "X-Webhook-Delivery"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+// Event is a notification delivered to subscriptions
+type Event struct {
+	// ID is unique among all events: operation id for ledger events, hash for transaction results
+	ID        string      `json:"id"`
+	Type      EventType   `json:"type"`
+	CreatedAt time.Time   `json:"created_at"`
+	Data      interface{} `json:"data"`
+	// Accounts participating in the event. Used to match subscriptions filtered by account
+	Accounts []string `json:"-"`
+}
+
+// Subscription is a row of data from the `webhook_subscriptions` table
+type Subscription struct {
+	ID  int64  `db:"id"`
+	URL string `db:"url"`
+	// Secret is a key used to sign deliveries
+	Secret string `db:"secret"`
+	// Account limits subscription to events of the account. If null, subscription receives events of all accounts
+	Account sql.NullString `db:"account"`
+	// EventTypes is a comma separated list of event types
+	EventTypes string    `db:"event_types"`
+	CreatedAt  time.Time `db:"created_at"`
+}
+
+// Delivery is a row of data from the `webhook_deliveries` table
+type Delivery struct {
+	ID             int64      `db:"id"`
+	SubscriptionID int64      `db:"subscription_id"`
+	EventID        string     `db:"event_id"`
+	EventType      string     `db:"event_type"`
+	Payload        string     `db:"payload"`
+	Status         string     `db:"status"`
+	Attempts       int        `db:"attempts"`
+	NextAttemptAt  time.Time  `db:"next_attempt_at"`
+	LastError      string     `db:"last_error"`
+	CreatedAt      time.Time  `db:"created_at"`
+	DeliveredAt    *time.Time `db:"delivered_at"`
+}
+
+// DeadLetter is a row of data from the `webhook_dead_letters` table
+type DeadLetter struct {
+	ID             int64     `db:"id"`
+	DeliveryID     int64     `db:"delivery_id"`
+	SubscriptionID int64     `db:"subscription_id"`
+	URL            string    `db:"url"`
+	EventID        string    `db:"event_id"`
+	EventType      string    `db:"event_type"`
+	Payload        string    `db:"payload"`
+	Attempts       int       `db:"attempts"`
+	LastError      string    `db:"last_error"`
+	FailedAt       time.Time `db:"failed_at"`
+}
+
+// ParseEventTypes parses comma separated list of event types. Empty list means all event types.
+func ParseEventTypes(raw string) ([]EventType, error) {
+	if strings.TrimSpace(raw) == "" {
+		return EventTypes, nil
+	}
+
+	var result []EventType
+	for _, rawType := range strings.Split(raw, ",") {
+		eventType := EventType(strings.TrimSpace(rawType))
+		if !isKnownEventType(eventType) {
+			return nil, fmt.Errorf("unknown event type %s", eventType)
+		}
+		result = append(result, eventType)
+	}
+	return result, nil
+}
+
+// JoinEventTypes converts event types to the form stored in subscription
+func JoinEventTypes(eventTypes []EventType) string {
+	raw := make([]string, len(eventTypes))
+	for i, eventType := range eventTypes {
+		raw[i] = string(eventType)
+	}
+	return strings.Join(raw, ",")
+}
+
+func isKnownEventType(eventType EventType) bool {
+	for _, known := range EventTypes {
+		if known == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// Types returns event types subscription receives
+func (s *Subscription) Types() []EventType {
+	types, _ := ParseEventTypes(s.EventTypes)
+	return types
+}
+
+// Matches returns true, if subscription must receive the event. Subscriptions only receive
+// events created after they were registered.
+func (s *Subscription) Matches(event Event) bool {
+	if event.CreatedAt.Before(s.CreatedAt) {
+		return false
+	}
+
+	typeMatches := false
+	for _, eventType := range s.Types() {
+		if eventType == event.Type {
+			typeMatches = true
+			break
+		}
+	}
+
+	if !typeMatches {
+		return false
+	}
+
+	if !s.Account.Valid {
+		return true
+	}
+
+	for _, account := range event.Accounts {
+		if account == s.Account.String {
+			return true
+		}
+	}
+	return false
+}
+
+// PagingToken returns cursor of the subscription
+func (s Subscription) PagingToken() string {
+	return strconv.FormatInt(s.ID, 10)
+}
+
+// PagingToken returns cursor of the dead letter
+func (l DeadLetter) PagingToken() string {
+	return strconv.FormatInt(l.ID, 10)
+}
+
+// Sign returns hex encoded HMAC-SHA256 of delivery timestamp and payload joined by dot.
+// Receivers must compare it with X-Webhook-Signature header and reject deliveries with stale timestamps.
+func Sign(secret string, timestamp int64, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks signature of the delivery using its timestamp header and payload
+func Verify(secret string, timestamp string, signature string, payload []byte) bool {
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return false
+	}
+
+	return hmac.Equal([]byte(signature), []byte(Sign(secret, ts, payload)))
+}
@@ -0,0 +1,90 @@
+package webhook
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestWebhook(t *testing.T) {
+	Convey("Sign", t, func() {
+		payload := []byte(`{"id":"1"}`)
+		signature := Sign("secret", 1500000000, payload)
+		So(signature, ShouldHaveLength, 64)
+		So(Verify("secret", "1500000000", signature, payload), ShouldBeTrue)
+		So(Verify("other", "1500000000", signature, payload), ShouldBeFalse)
+		So(Verify("secret", "1500000001", signature, payload), ShouldBeFalse)
+		So(Verify("secret", "1500000000", signature, []byte(`{"id":"2"}`)), ShouldBeFalse)
+		So(Verify("secret", "invalid", signature, payload), ShouldBeFalse)
+	})
+
+	Convey("CheckURL", t, func() {
+		So(CheckURL("ftp://8.8.8.8", false), ShouldNotBeNil)
+		So(CheckURL("/relative", true), ShouldNotBeNil)
+		So(CheckURL("https://8.8.8.8/hook", false), ShouldBeNil)
+
+		for _, private := range []string{
+			"http://127.0.0.1:8000/hook",
+			"http://[::1]/hook",
+			"http://10.1.2.3/hook",
+			"http://192.168.0.1/hook",
+			"http://169.254.169.254/latest/meta-data",
+			"http://0.0.0.0/hook",
+		} {
+			So(CheckURL(private, false), ShouldEqual, ErrPrivateAddress)
+			So(CheckURL(private, true), ShouldBeNil)
+		}
+	})
+
+	Convey("ParseEventTypes", t, func() {
+		eventTypes, err := ParseEventTypes("")
+		So(err, ShouldBeNil)
+		So(eventTypes, ShouldResemble, EventTypes)
+
+		eventTypes, err = ParseEventTypes("payment, admin_operation")
+		So(err, ShouldBeNil)
+		So(eventTypes, ShouldResemble, []EventType{EventPayment, EventAdminOperation})
+		So(JoinEventTypes(eventTypes), ShouldEqual, "payment,admin_operation")
+
+		_, err = ParseEventTypes("payment,ping")
+		So(err, ShouldNotBeNil)
+	})
+
+	Convey("Subscription.Matches", t, func() {
+		now := time.Now()
+		subscription := Subscription{EventTypes: "payment", CreatedAt: now}
+		event := NewPaymentEvent(Payment{
+			Ledger: Ledger{OperationID: "1", ClosedAt: now.Add(time.Second)},
+			From:   "GA",
+			To:     "GB",
+		})
+
+		So(subscription.Matches(event), ShouldBeTrue)
+
+		Convey("filters by account", func() {
+			subscription.Account = sql.NullString{String: "GB", Valid: true}
+			So(subscription.Matches(event), ShouldBeTrue)
+			subscription.Account.String = "GC"
+			So(subscription.Matches(event), ShouldBeFalse)
+		})
+		Convey("filters by event type", func() {
+			subscription.EventTypes = "payment_reversal,transaction_result"
+			So(subscription.Matches(event), ShouldBeFalse)
+		})
+		Convey("skips events created before subscription", func() {
+			event.CreatedAt = now.Add(-time.Second)
+			So(subscription.Matches(event), ShouldBeFalse)
+		})
+	})
+
+	Convey("Dispatcher.Backoff", t, func() {
+		d := &Dispatcher{MinBackoff: time.Second, MaxBackoff: 5 * time.Second}
+		So(d.Backoff(1), ShouldEqual, time.Second)
+		So(d.Backoff(2), ShouldEqual, 2*time.Second)
+		So(d.Backoff(3), ShouldEqual, 4*time.Second)
+		So(d.Backoff(4), ShouldEqual, 5*time.Second)
+		So(d.Backoff(20), ShouldEqual, 5*time.Second)
+	})
+}
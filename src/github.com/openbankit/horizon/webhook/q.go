@@ -0,0 +1,171 @@
+package webhook
+
+import (
+	"encoding/json"
+	"time"
+
+	sq "github.com/lann/squirrel"
+	"github.com/openbankit/horizon/db2"
+)
+
+// Q provides helper methods to query subscriptions, deliveries and dead letters stored in horizon db
+type Q struct {
+	Repo *db2.Repo
+}
+
+// CreateSubscription stores subscription, populating its id and creation time
+func (q *Q) CreateSubscription(subscription *Subscription) error {
+	return q.Repo.GetRaw(subscription, `INSERT INTO webhook_subscriptions (url, secret, account, event_types)
+		VALUES (?, ?, ?, ?) RETURNING *`,
+		subscription.URL, subscription.Secret, subscription.Account, subscription.EventTypes)
+}
+
+// SubscriptionByID loads subscription by id
+func (q *Q) SubscriptionByID(dest *Subscription, id int64) error {
+	return q.Repo.Get(dest, selectSubscription.Where("id = ?", id))
+}
+
+// Subscriptions loads all the subscriptions
+func (q *Q) Subscriptions(dest *[]Subscription) error {
+	return q.Repo.Select(dest, selectSubscription.OrderBy("id asc"))
+}
+
+// SubscriptionsPage loads page of subscriptions
+func (q *Q) SubscriptionsPage(dest *[]Subscription, page db2.PageQuery) error {
+	sql, err := page.ApplyTo(selectSubscription, "id")
+	if err != nil {
+		return err
+	}
+	return q.Repo.Select(dest, sql)
+}
+
+// DeleteSubscription removes subscription and its deliveries. Dead letters are kept.
+// Returns false, if subscription does not exist.
+func (q *Q) DeleteSubscription(id int64) (bool, error) {
+	result, err := q.Repo.Exec(sq.Delete("webhook_subscriptions").Where("id = ?", id))
+	if err != nil {
+		return false, err
+	}
+
+	deleted, err := result.RowsAffected()
+	return deleted > 0, err
+}
+
+// Enqueue stores delivery of the event for each of subscriptions matching it.
+// Event already enqueued for subscription is skipped. Returns number of enqueued deliveries.
+func (q *Q) Enqueue(subscriptions []Subscription, event Event) (int, error) {
+	var payload []byte
+	enqueued := 0
+	for _, subscription := range subscriptions {
+		if !subscription.Matches(event) {
+			continue
+		}
+
+		if payload == nil {
+			var err error
+			payload, err = json.Marshal(event)
+			if err != nil {
+				return enqueued, err
+			}
+		}
+
+		result, err := q.Repo.ExecRaw(`INSERT INTO webhook_deliveries (subscription_id, event_id, event_type, payload, next_attempt_at)
+			SELECT ?, ?, ?, ?, ? WHERE NOT EXISTS (SELECT 1 FROM webhook_deliveries WHERE subscription_id = ? AND event_id = ?)`,
+			subscription.ID, event.ID, string(event.Type), string(payload), event.CreatedAt, subscription.ID, event.ID)
+		if err != nil {
+			return enqueued, err
+		}
+
+		inserted, err := result.RowsAffected()
+		if err != nil {
+			return enqueued, err
+		}
+		enqueued += int(inserted)
+	}
+	return enqueued, nil
+}
+
+// DeliveryByEvent loads delivery of the event to subscription
+func (q *Q) DeliveryByEvent(dest *Delivery, subscriptionID int64, eventID string) error {
+	return q.Repo.Get(dest, selectDelivery.Where("subscription_id = ? AND event_id = ?", subscriptionID, eventID))
+}
+
+// ClaimDeliveries loads up to limit pending deliveries due at now, postponing their next attempt
+// by lease, so that they are not sent by other horizon instances concurrently. If delivery
+// attempt is not finished within lease (e.g. horizon was stopped), delivery is retried.
+func (q *Q) ClaimDeliveries(dest *[]Delivery, now time.Time, lease time.Duration, limit uint64) error {
+	return q.Repo.SelectRaw(dest, `UPDATE webhook_deliveries SET next_attempt_at = ?
+		WHERE id IN (
+			SELECT id FROM webhook_deliveries WHERE status = ? AND next_attempt_at <= ? ORDER BY id LIMIT ?
+		) AND status = ? AND next_attempt_at <= ?
+		RETURNING *`,
+		now.Add(lease), StatusPending, now, limit, StatusPending, now)
+}
+
+// MarkDelivered marks delivery as successfully sent
+func (q *Q) MarkDelivered(delivery *Delivery, now time.Time) error {
+	_, err := q.Repo.Exec(sq.Update("webhook_deliveries").SetMap(map[string]interface{}{
+		"status":       StatusDelivered,
+		"attempts":     delivery.Attempts,
+		"last_error":   "",
+		"delivered_at": now,
+	}).Where("id = ?", delivery.ID))
+	return err
+}
+
+// Reschedule records failed attempt of delivery and schedules the next one
+func (q *Q) Reschedule(delivery *Delivery, nextAttemptAt time.Time) error {
+	_, err := q.Repo.Exec(sq.Update("webhook_deliveries").SetMap(map[string]interface{}{
+		"attempts":        delivery.Attempts,
+		"last_error":      delivery.LastError,
+		"next_attempt_at": nextAttemptAt,
+	}).Where("id = ?", delivery.ID))
+	return err
+}
+
+// MoveToDeadLetters marks delivery as failed and stores it to dead letters
+func (q *Q) MoveToDeadLetters(delivery *Delivery, url string, now time.Time) error {
+	repo := q.Repo.Clone()
+	err := repo.Begin()
+	if err != nil {
+		return err
+	}
+	defer repo.Rollback()
+
+	_, err = repo.Exec(sq.Update("webhook_deliveries").SetMap(map[string]interface{}{
+		"status":     StatusFailed,
+		"attempts":   delivery.Attempts,
+		"last_error": delivery.LastError,
+	}).Where("id = ?", delivery.ID))
+	if err != nil {
+		return err
+	}
+
+	_, err = repo.Exec(sq.Insert("webhook_dead_letters").
+		Columns("delivery_id", "subscription_id", "url", "event_id", "event_type", "payload", "attempts", "last_error", "failed_at").
+		Values(delivery.ID, delivery.SubscriptionID, url, delivery.EventID, delivery.EventType, delivery.Payload,
+			delivery.Attempts, delivery.LastError, now))
+	if err != nil {
+		return err
+	}
+
+	return repo.Commit()
+}
+
+// DeadLettersPage loads page of dead letters. If subscriptionID is not zero, only dead letters of
+// the subscription are loaded.
+func (q *Q) DeadLettersPage(dest *[]DeadLetter, subscriptionID int64, page db2.PageQuery) error {
+	sql, err := page.ApplyTo(selectDeadLetter, "id")
+	if err != nil {
+		return err
+	}
+
+	if subscriptionID != 0 {
+		sql = sql.Where("subscription_id = ?", subscriptionID)
+	}
+	return q.Repo.Select(dest, sql)
+}
+
+var selectSubscription = sq.Select("*").From("webhook_subscriptions")
+var selectDelivery = sq.Select("*").From("webhook_deliveries")
+var selectDeadLetter = sq.Select("*").From("webhook_dead_letters")
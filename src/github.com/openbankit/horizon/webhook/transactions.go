@@ -0,0 +1,58 @@
+package webhook
+
+import (
+	"time"
+
+	"github.com/openbankit/go-base/xdr"
+	"github.com/openbankit/horizon/log"
+	"github.com/openbankit/horizon/txsub"
+	"github.com/openbankit/horizon/txsub/results"
+	"golang.org/x/net/context"
+)
+
+// TransactionResults implements txsub.ResultObserver, enqueuing transaction result events for
+// transactions submitted through horizon. If several horizon instances find the same result,
+// the event is enqueued once.
+type TransactionResults struct {
+	Q *Q
+}
+
+// Finished implements txsub.ResultObserver
+func (t *TransactionResults) Finished(ctx context.Context, r txsub.Result) {
+	logger := log.Ctx(ctx).WithField("hash", r.Hash)
+
+	data := TransactionResult{
+		Hash:        r.Hash,
+		Status:      string(txsub.TransactionApplied),
+		Ledger:      r.LedgerSequence,
+		EnvelopeXDR: r.EnvelopeXDR,
+		ResultXDR:   r.ResultXDR,
+	}
+
+	if r.Err != nil {
+		data.Status = string(txsub.TransactionFailed)
+		if fail, ok := r.Err.(*results.FailedTransactionError); ok && data.ResultXDR == "" {
+			data.ResultXDR = fail.ResultXDR
+		}
+	}
+
+	var env xdr.TransactionEnvelope
+	err := xdr.SafeUnmarshalBase64(r.EnvelopeXDR, &env)
+	if err != nil {
+		logger.WithError(err).Error("Failed to decode envelope of transaction result event")
+		return
+	}
+	data.SourceAccount = env.Tx.SourceAccount.Address()
+
+	var subscriptions []Subscription
+	err = t.Q.Subscriptions(&subscriptions)
+	if err != nil {
+		logger.WithStack(err).WithError(err).Error("Failed to load webhook subscriptions")
+		return
+	}
+
+	_, err = t.Q.Enqueue(subscriptions, NewTransactionResultEvent(data, time.Now()))
+	if err != nil {
+		logger.WithStack(err).WithError(err).Error("Failed to enqueue transaction result event")
+	}
+}
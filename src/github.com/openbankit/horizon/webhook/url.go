@@ -0,0 +1,110 @@
+package webhook
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"time"
+)
+
+// ErrPrivateAddress is returned, when subscription's endpoint resolves to loopback, private or link-local address
+var ErrPrivateAddress = errors.New("must not point to loopback, private or link-local address")
+
+var privateNetworks = mustParseCIDRs(
+	"10.0.0.0/8",
+	"100.64.0.0/10",
+	"172.16.0.0/12",
+	"192.168.0.0/16",
+	"fc00::/7",
+)
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	result := make([]*net.IPNet, len(cidrs))
+	for i, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		result[i] = network
+	}
+	return result
+}
+
+// IsPrivateIP returns true, if ip is not reachable from public network: loopback, private,
+// link-local, multicast or unspecified
+func IsPrivateIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() {
+		return true
+	}
+
+	for _, network := range privateNetworks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckURL checks that rawURL is absolute http or https url. Unless allowPrivate is set, host of the url
+// must resolve to public addresses only.
+func CheckURL(rawURL string, allowPrivate bool) error {
+	endpoint, err := url.Parse(rawURL)
+	if err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" {
+		return errors.New("must be absolute http or https url")
+	}
+
+	if allowPrivate {
+		return nil
+	}
+
+	host := endpoint.Host
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+
+	_, err = lookupPublicIP(host)
+	return err
+}
+
+// lookupPublicIP resolves host and returns its first address. Error is returned, if any of the addresses is private.
+func lookupPublicIP(host string) (net.IP, error) {
+	ips, err := net.LookupIP(host)
+	if err != nil {
+		return nil, err
+	}
+	if len(ips) == 0 {
+		return nil, fmt.Errorf("no addresses found for %s", host)
+	}
+
+	for _, ip := range ips {
+		if IsPrivateIP(ip) {
+			return nil, ErrPrivateAddress
+		}
+	}
+	return ips[0], nil
+}
+
+// publicDialer dials public addresses only. Address is resolved once and dialed directly, so that
+// host can not be rebound to private address between the check and the connection.
+type publicDialer struct {
+	net.Dialer
+}
+
+func (d *publicDialer) Dial(network, address string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+
+	ip, err := lookupPublicIP(host)
+	if err != nil {
+		return nil, err
+	}
+	return d.Dialer.Dial(network, net.JoinHostPort(ip.String(), port))
+}
+
+func newPublicDialer(timeout time.Duration) *publicDialer {
+	return &publicDialer{Dialer: net.Dialer{Timeout: timeout}}
+}